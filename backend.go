@@ -0,0 +1,115 @@
+package ssz
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"os"
+	"strings"
+)
+
+// ErrBackendNotAvailable is returned when the hash backend is not known or not supported by the CPU
+var ErrBackendNotAvailable = fmt.Errorf("hash backend not available")
+
+const (
+	// BackendGeneric is the crypto/sha256 of the standard library
+	BackendGeneric = "generic"
+	// BackendSHA is sha256-simd with the SHA extensions
+	BackendSHA = "sha"
+	// BackendAVX2 is sha256-simd with AVX2
+	BackendAVX2 = "avx2"
+)
+
+// BackendEnv is the environment variable that overrides the hash backend detected at startup
+const BackendEnv = "FASTSSZ_HASH_BACKEND"
+
+// cpuFeatures are the extensions of the CPU for sha256
+type cpuFeatures struct {
+	sha, avx2, avx512 bool
+}
+
+// cpu are the extensions detected at startup
+var cpu = detectFeatures()
+
+// backend is the sha256 implementation in use and newHash creates its hashes
+var backend, newHash = defaultBackend()
+
+func defaultBackend() (string, func() hash.Hash) {
+	name, fn, err := lookupBackend(strings.ToLower(os.Getenv(BackendEnv)))
+	if err != nil {
+		name, fn, _ = lookupBackend("auto")
+	}
+	return name, fn
+}
+
+// cpuBackend returns the fastest backend for the CPU. sha256-simd
+// hashes a single message with AVX2 on the CPUs with AVX-512.
+func cpuBackend() string {
+	if simdNew == nil {
+		return BackendGeneric
+	}
+	if cpu.sha {
+		return BackendSHA
+	}
+	if cpu.avx2 || cpu.avx512 {
+		return BackendAVX2
+	}
+	return BackendGeneric
+}
+
+func lookupBackend(name string) (string, func() hash.Hash, error) {
+	switch name {
+	case "", "auto":
+		name = cpuBackend()
+	case BackendGeneric:
+	default:
+		// sha256-simd always picks the fastest extension of the CPU
+		if name != cpuBackend() {
+			return "", nil, ErrBackendNotAvailable
+		}
+	}
+	if name == BackendGeneric {
+		return name, sha256.New, nil
+	}
+	return name, simdNew, nil
+}
+
+// HashBackend returns the sha256 implementation used to hash the objects
+func HashBackend() string {
+	return backend
+}
+
+// SetHashBackend overrides the hash backend, either "auto" for the one detected at startup,
+// "generic" or the accelerated backend of the CPU. It is not safe to call it while hashing
+// and the Hashers already created, like those of the pools, keep the previous backend.
+func SetHashBackend(name string) error {
+	b, fn, err := lookupBackend(strings.ToLower(name))
+	if err != nil {
+		return err
+	}
+	backend, newHash = b, fn
+	return nil
+}
+
+// CPUFeatures returns the extensions of the CPU for sha256 that were detected at startup
+func CPUFeatures() []string {
+	res := []string{}
+	if cpu.sha {
+		res = append(res, "sha")
+	}
+	if cpu.avx2 {
+		res = append(res, "avx2")
+	}
+	if cpu.avx512 {
+		res = append(res, "avx512")
+	}
+	return res
+}
+
+// sum256 returns the sha256 hash of the data with the hash backend
+func sum256(data []byte) (res [32]byte) {
+	h := newHash()
+	h.Write(data)
+	copy(res[:], h.Sum(nil))
+	return
+}
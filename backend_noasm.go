@@ -0,0 +1,9 @@
+//go:build tinygo || noasm
+// +build tinygo noasm
+
+package ssz
+
+import "hash"
+
+// simdNew is not set without assembly, the hashes always use crypto/sha256
+var simdNew func() hash.Hash
@@ -0,0 +1,9 @@
+//go:build !tinygo && !noasm
+// +build !tinygo,!noasm
+
+package ssz
+
+import simd "github.com/minio/sha256-simd"
+
+// simdNew creates the hashes of sha256-simd
+var simdNew = simd.New
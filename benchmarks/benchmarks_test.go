@@ -0,0 +1,185 @@
+package benchmarks
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/ferranbt/fastssz/spectests"
+	baseSSZ "github.com/prysmaticlabs/go-ssz"
+)
+
+const corpusPath = "../spectests/testdata/synthetic"
+
+type codec interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// object is a representative object of the benchmarks
+type object struct {
+	name string
+	new  func() codec
+	buf  []byte
+}
+
+// stateValidators is the number of validators of the large state
+const stateValidators = 16384
+
+// objects returns the objects of the benchmarks: a small and a medium object of the corpus
+// and a state with many validators
+func objects(b testing.TB) []*object {
+	objs := []*object{
+		{name: "Attestation", new: func() codec { return new(spectests.Attestation) }},
+		{name: "BeaconBlock", new: func() codec { return new(spectests.BeaconBlock) }},
+	}
+	for _, obj := range objs {
+		buf, err := ioutil.ReadFile(filepath.Join(corpusPath, obj.name, "ssz_random", "case_0", "serialized.ssz"))
+		if err != nil {
+			b.Fatal(err)
+		}
+		obj.buf = buf
+	}
+
+	buf, err := ioutil.ReadFile(filepath.Join(corpusPath, "BeaconState", "ssz_random", "case_0", "serialized.ssz"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	state := new(spectests.BeaconState)
+	if err := state.UnmarshalSSZ(buf); err != nil {
+		b.Fatal(err)
+	}
+	state.Validators, state.Balances = nil, nil
+	for i := 0; i < stateValidators; i++ {
+		state.Validators = append(state.Validators, &spectests.Validator{
+			Pubkey:                make([]byte, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      uint64(i),
+		})
+		state.Balances = append(state.Balances, uint64(i))
+	}
+	if buf, err = state.MarshalSSZ(); err != nil {
+		b.Fatal(err)
+	}
+	objs = append(objs, &object{name: "BeaconState", new: func() codec { return new(spectests.BeaconState) }, buf: buf})
+	return objs
+}
+
+// decode returns the object of the benchmark
+func (o *object) decode(b testing.TB) codec {
+	obj := o.new()
+	if err := obj.UnmarshalSSZ(o.buf); err != nil {
+		b.Fatal(err)
+	}
+	return obj
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	for _, o := range objects(b) {
+		obj := o.decode(b)
+
+		b.Run(o.name+"/fastssz", func(b *testing.B) {
+			b.SetBytes(int64(len(o.buf)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := obj.MarshalSSZ(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(o.name+"/fastssz-reuse", func(b *testing.B) {
+			b.SetBytes(int64(len(o.buf)))
+			b.ReportAllocs()
+			buf := make([]byte, 0, len(o.buf))
+			for i := 0; i < b.N; i++ {
+				var err error
+				if buf, err = obj.MarshalSSZTo(buf[:0]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(o.name+"/go-ssz", func(b *testing.B) {
+			b.SetBytes(int64(len(o.buf)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := baseSSZ.Marshal(obj); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	for _, o := range objects(b) {
+		b.Run(o.name+"/fastssz", func(b *testing.B) {
+			b.SetBytes(int64(len(o.buf)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := o.new().UnmarshalSSZ(o.buf); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(o.name+"/fastssz-reuse", func(b *testing.B) {
+			b.SetBytes(int64(len(o.buf)))
+			b.ReportAllocs()
+			obj := o.new()
+			for i := 0; i < b.N; i++ {
+				if err := obj.UnmarshalSSZ(o.buf); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(o.name+"/go-ssz", func(b *testing.B) {
+			b.SetBytes(int64(len(o.buf)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := baseSSZ.Unmarshal(o.buf, o.new()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkHashTreeRoot(b *testing.B) {
+	for _, o := range objects(b) {
+		obj := o.decode(b)
+
+		b.Run(o.name+"/fastssz", func(b *testing.B) {
+			b.SetBytes(int64(len(o.buf)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := obj.HashTreeRoot(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(o.name+"/go-ssz", func(b *testing.B) {
+			b.SetBytes(int64(len(o.buf)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := baseSSZ.HashTreeRoot(obj); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// TestSameEncoding checks that both libraries encode the objects of the benchmarks the same
+func TestSameEncoding(t *testing.T) {
+	for _, o := range objects(t) {
+		buf, err := baseSSZ.Marshal(o.decode(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf, o.buf) {
+			t.Fatalf("different encoding of %s", o.name)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+// Package benchmarks compares the marshal, unmarshal and hash functions generated by fastssz
+// with the reflection based encoding of go-ssz on the same objects. The benchmarks are named
+// 'Benchmark<Op>/<Object>/<Library>' so that the reports of two runs are compared with benchstat:
+//
+//	go test -run=^$ -bench=. -benchmem -count=10 ./benchmarks > new.txt
+//	benchstat old.txt new.txt
+package benchmarks
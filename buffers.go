@@ -0,0 +1,54 @@
+package ssz
+
+import "net"
+
+// buffersThreshold is the size in bytes from which the byte fields are referenced by the Buffers instead of copied
+const buffersThreshold = 1024
+
+// Buffers collects an SSZ encoding as a list of buffers. The byte fields of at least 1024 bytes
+// (i.e. the transactions of a block) reference the memory of the object instead of being copied.
+type Buffers struct {
+	bufs net.Buffers
+}
+
+// BuffersMarshaler is the interface of the types generated with the --buffers option
+type BuffersMarshaler interface {
+	MarshalSSZToBuffers(bufs *Buffers, dst []byte) ([]byte, error)
+}
+
+// MarshalBuffers returns the encoding of obj as a net.Buffers, so that it is written with a vectored
+// write (i.e. a block to a socket) without concatenating the large byte fields first. The buffers
+// reference the memory of obj, which must not change until they are written.
+func MarshalBuffers(obj Marshaler) (net.Buffers, error) {
+	b := new(Buffers)
+	dst, err := b.MarshalTo(obj, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(dst) != 0 {
+		b.bufs = append(b.bufs, dst)
+	}
+	return b.bufs, nil
+}
+
+// MarshalTo appends the encoding of obj to dst, the encoding since the last referenced field.
+// The types without MarshalSSZToBuffers are copied with MarshalSSZTo.
+func (b *Buffers) MarshalTo(obj Marshaler, dst []byte) ([]byte, error) {
+	if m, ok := obj.(BuffersMarshaler); ok {
+		return m.MarshalSSZToBuffers(b, dst)
+	}
+	return obj.MarshalSSZTo(dst)
+}
+
+// Append appends the bytes to dst or, if they are large, references them after dst and returns
+// an empty dst for the rest of the encoding
+func (b *Buffers) Append(dst, buf []byte) []byte {
+	if len(buf) < buffersThreshold {
+		return append(dst, buf...)
+	}
+	if len(dst) != 0 {
+		b.bufs = append(b.bufs, dst)
+	}
+	b.bufs = append(b.bufs, buf)
+	return dst[len(dst):]
+}
@@ -0,0 +1,159 @@
+package ssz
+
+import "fmt"
+
+// ErrInvalidSchema is returned when a schema does not describe a valid SSZ type
+var ErrInvalidSchema = fmt.Errorf("invalid schema")
+
+// ContainerBuilder builds the schema of a container field by field, for the types that
+// are only known at runtime (i.e. read from a config). The schema is used with Value and View.
+type ContainerBuilder struct {
+	name   string
+	fields []*Field
+}
+
+// Container returns a builder of a container schema without fields
+func Container() *ContainerBuilder {
+	return &ContainerBuilder{}
+}
+
+// Named sets the name of the container
+func (b *ContainerBuilder) Named(name string) *ContainerBuilder {
+	b.name = name
+	return b
+}
+
+// Field adds a field with the schema
+func (b *ContainerBuilder) Field(name string, s *Schema) *ContainerBuilder {
+	b.fields = append(b.fields, NewField(name, s))
+	return b
+}
+
+// Uint8 adds an uint8 field
+func (b *ContainerBuilder) Uint8(name string) *ContainerBuilder {
+	return b.Field(name, UintSchema(1))
+}
+
+// Uint16 adds an uint16 field
+func (b *ContainerBuilder) Uint16(name string) *ContainerBuilder {
+	return b.Field(name, UintSchema(2))
+}
+
+// Uint32 adds an uint32 field
+func (b *ContainerBuilder) Uint32(name string) *ContainerBuilder {
+	return b.Field(name, UintSchema(4))
+}
+
+// Uint64 adds an uint64 field
+func (b *ContainerBuilder) Uint64(name string) *ContainerBuilder {
+	return b.Field(name, UintSchema(8))
+}
+
+// Uint256 adds an uint256 field
+func (b *ContainerBuilder) Uint256(name string) *ContainerBuilder {
+	return b.Field(name, UintSchema(32))
+}
+
+// Bool adds a boolean field
+func (b *ContainerBuilder) Bool(name string) *ContainerBuilder {
+	return b.Field(name, BoolSchema())
+}
+
+// ByteVector adds a field of size bytes
+func (b *ContainerBuilder) ByteVector(name string, size uint64) *ContainerBuilder {
+	return b.Field(name, ByteVectorSchema(size))
+}
+
+// ByteList adds a field with a list of at most max bytes
+func (b *ContainerBuilder) ByteList(name string, max uint64) *ContainerBuilder {
+	return b.Field(name, ByteListSchema(max))
+}
+
+// Bitvector adds a field with a bitvector of size bits
+func (b *ContainerBuilder) Bitvector(name string, size uint64) *ContainerBuilder {
+	return b.Field(name, BitvectorSchema(size))
+}
+
+// Bitlist adds a field with a bitlist of at most max bits
+func (b *ContainerBuilder) Bitlist(name string, max uint64) *ContainerBuilder {
+	return b.Field(name, BitlistSchema(max))
+}
+
+// Vector adds a field with a vector of size elements
+func (b *ContainerBuilder) Vector(name string, elem *Schema, size uint64) *ContainerBuilder {
+	return b.Field(name, VectorSchema(elem, size))
+}
+
+// List adds a field with a list of at most max elements
+func (b *ContainerBuilder) List(name string, elem *Schema, max uint64) *ContainerBuilder {
+	return b.Field(name, ListSchema(elem, max))
+}
+
+// ProgressiveList adds a field with a list without limit
+func (b *ContainerBuilder) ProgressiveList(name string, elem *Schema) *ContainerBuilder {
+	return b.Field(name, ProgressiveListSchema(elem))
+}
+
+// ProgressiveBitlist adds a field with a bitlist without limit
+func (b *ContainerBuilder) ProgressiveBitlist(name string) *ContainerBuilder {
+	return b.Field(name, ProgressiveBitlistSchema())
+}
+
+// Build returns the schema of the container. It returns ErrInvalidSchema if any of the
+// types is not valid (i.e. a container without fields, two fields with the same name or an empty vector).
+func (b *ContainerBuilder) Build() (*Schema, error) {
+	s := ContainerSchema(b.name, b.fields...)
+	if err := s.check(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// check returns ErrInvalidSchema if the schema or any of its elements is not a valid SSZ type
+func (s *Schema) check() error {
+	switch s.Kind {
+	case KindUint:
+		if s.Size != 1 && s.Size != 2 && s.Size != 4 && s.Size != 8 && s.Size != 16 && s.Size != 32 {
+			return ErrInvalidSchema
+		}
+	case KindByteVector, KindBitVector:
+		if s.Size == 0 {
+			return ErrInvalidSchema
+		}
+	case KindVector, KindList:
+		if s.Elem == nil || (s.Kind == KindVector && s.Size == 0) {
+			return ErrInvalidSchema
+		}
+		return s.Elem.check()
+	case KindContainer:
+		if len(s.Fields) == 0 {
+			return ErrInvalidSchema
+		}
+		names := map[string]bool{}
+		for _, f := range s.Fields {
+			if f.Name == "" || names[f.Name] || f.Schema == nil {
+				return ErrInvalidSchema
+			}
+			names[f.Name] = true
+			if err := f.Schema.check(); err != nil {
+				return err
+			}
+		}
+	case KindUnion:
+		if len(s.Options) == 0 || len(s.Options) > 128 {
+			return ErrInvalidSchema
+		}
+		for indx, opt := range s.Options {
+			if opt == nil {
+				if indx != 0 {
+					return ErrInvalidSchema
+				}
+				continue
+			}
+			if err := opt.check(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+package ssz
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// RootCache stores the roots of the containers in the vectors and the lists (i.e. the validators of
+// a state) by the hash of their type and their encoding, for HashTreeRootCached
+type RootCache interface {
+	Get(key [32]byte) ([32]byte, bool)
+	Put(key, root [32]byte)
+}
+
+// HashTreeRootWithCache computes the root of the object with the roots of the cache
+// (HashTreeRootCached). The object must implement SchemaProvider.
+func HashTreeRootWithCache(obj Marshaler, cache RootCache) ([32]byte, error) {
+	s, ok := obj.(SchemaProvider)
+	if !ok {
+		return [32]byte{}, ErrNotSupported
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return HashTreeRootCached(s.SchemaSSZ(), buf, cache)
+}
+
+// HashTreeRootCached computes the root of the encoding of a value with the schema. The roots of the
+// containers in its vectors and lists are taken from the cache, the ones that are not found are
+// hashed and added to it. The key of a container hashes its encoding, which is cheaper than hashing
+// its tree, so a persistent cache (FileRootCache) makes the first root of a state after a restart fast.
+func HashTreeRootCached(s *Schema, buf []byte, cache RootCache) ([32]byte, error) {
+	h := &streamHasher{r: bytes.NewReader(buf), p: defaultProfile, hash: defaultProfile.newHash(), cache: cache}
+	return h.hashValue(s, uint64(len(buf)))
+}
+
+// hashCached returns the root of the next size bytes with the cache
+func (h *streamHasher) hashCached(s *Schema, size uint64, layout [32]byte) ([32]byte, error) {
+	buf, err := h.read(size)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	var key [32]byte
+	h.hash.Reset()
+	h.hash.Write(layout[:])
+	h.hash.Write(buf)
+	h.hash.Sum(key[:0])
+	if root, ok := h.cache.Get(key); ok {
+		return root, nil
+	}
+	sub := &streamHasher{r: bytes.NewReader(buf), p: h.p, hash: h.hash, cache: h.cache}
+	root, err := sub.hashValue(s, size)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	h.cache.Put(key, root)
+	return root, nil
+}
+
+// layout returns the SSZ type of the schema with the fields of the containers
+// (i.e. Checkpoint{epoch: uint64, root: Bytes32}), which identifies its tree
+func (s *Schema) layout() string {
+	switch s.Kind {
+	case KindContainer:
+		fields := []string{}
+		for _, f := range s.Fields {
+			fields = append(fields, f.Name+": "+f.Schema.layout())
+		}
+		return s.Name + "{" + strings.Join(fields, ", ") + "}"
+	case KindVector:
+		return fmt.Sprintf("Vector[%s, %d]", s.Elem.layout(), s.Size)
+	case KindList:
+		if s.Progressive {
+			return fmt.Sprintf("ProgressiveList[%s]", s.Elem.layout())
+		}
+		return fmt.Sprintf("List[%s, %d]", s.Elem.layout(), s.Max)
+	case KindUnion:
+		opts := []string{}
+		for _, opt := range s.Options {
+			if opt == nil {
+				opts = append(opts, "None")
+			} else {
+				opts = append(opts, opt.layout())
+			}
+		}
+		return "Union[" + strings.Join(opts, ", ") + "]"
+	default:
+		return s.String()
+	}
+}
+
+// rootCacheMagic is the prefix of the files of the root caches
+var rootCacheMagic = []byte("sszroot1")
+
+// rootRecordSize is the size of a record of a root cache, the key and the root
+const rootRecordSize = 64
+
+// FileRootCache is a RootCache stored in a file, which is loaded in memory when it is opened.
+// The roots added to the cache are appended to the file by Flush. Compact rewrites the file
+// with the roots used since the cache was opened (i.e. the roots of the validators of the last state).
+type FileRootCache struct {
+	lock    sync.Mutex
+	path    string
+	file    *os.File
+	roots   map[[32]byte][32]byte
+	used    map[[32]byte]bool
+	pending []byte
+}
+
+// OpenFileRootCache opens the root cache at the path, the file is created if it does not exist.
+// An incomplete record at the end of the file (i.e. after a crash) is ignored.
+func OpenFileRootCache(path string) (*FileRootCache, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	c := &FileRootCache{path: path, file: file, roots: map[[32]byte][32]byte{}, used: map[[32]byte]bool{}}
+	if err := c.load(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *FileRootCache) load() error {
+	info, err := c.file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() == 0 {
+		_, err := c.file.Write(rootCacheMagic)
+		return err
+	}
+	r := bufio.NewReader(c.file)
+	magic := make([]byte, len(rootCacheMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, rootCacheMagic) {
+		return fmt.Errorf("%s is not a root cache", c.path)
+	}
+	size := int64(len(rootCacheMagic))
+	var record [rootRecordSize]byte
+	for {
+		if _, err := io.ReadFull(r, record[:]); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			return err
+		}
+		var key, root [32]byte
+		copy(key[:], record[:32])
+		copy(root[:], record[32:])
+		c.roots[key] = root
+		size += rootRecordSize
+	}
+	// the next records are appended after the last complete one
+	if err := c.file.Truncate(size); err != nil {
+		return err
+	}
+	_, err = c.file.Seek(size, io.SeekStart)
+	return err
+}
+
+// Get implements the RootCache interface
+func (c *FileRootCache) Get(key [32]byte) ([32]byte, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	root, ok := c.roots[key]
+	if ok {
+		c.used[key] = true
+	}
+	return root, ok
+}
+
+// Put implements the RootCache interface
+func (c *FileRootCache) Put(key, root [32]byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.roots[key]; !ok {
+		c.pending = append(c.pending, key[:]...)
+		c.pending = append(c.pending, root[:]...)
+	}
+	c.roots[key] = root
+	c.used[key] = true
+}
+
+// Len returns the number of roots in the cache
+func (c *FileRootCache) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return len(c.roots)
+}
+
+// Flush appends the roots added since the last flush to the file
+func (c *FileRootCache) Flush() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, err := c.file.Write(c.pending); err != nil {
+		return err
+	}
+	c.pending = c.pending[:0]
+	return c.file.Sync()
+}
+
+// Compact rewrites the file with only the roots used since the cache was opened
+// or last compacted, the others are removed from the cache
+func (c *FileRootCache) Compact() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	tmp := c.path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(file)
+	w.Write(rootCacheMagic)
+	roots := map[[32]byte][32]byte{}
+	for key := range c.used {
+		root := c.roots[key]
+		roots[key] = root
+		w.Write(key[:])
+		w.Write(root[:])
+	}
+	if err := w.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		file.Close()
+		return err
+	}
+	c.file.Close()
+	c.file, c.roots, c.used, c.pending = file, roots, map[[32]byte]bool{}, c.pending[:0]
+	return nil
+}
+
+// Close flushes the roots and closes the file
+func (c *FileRootCache) Close() error {
+	if err := c.Flush(); err != nil {
+		c.file.Close()
+		return err
+	}
+	return c.file.Close()
+}
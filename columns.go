@@ -0,0 +1,144 @@
+package ssz
+
+import (
+	"fmt"
+)
+
+// Column has the values at a path of many objects
+type Column struct {
+	Path   string
+	Schema *Schema
+	// Values are the SSZ encodings of the values, which are slices of the input
+	Values [][]byte
+}
+
+// Uints returns the values of a column of uints
+func (c *Column) Uints() ([]uint64, error) {
+	if c.Schema.Kind != KindUint {
+		return nil, fmt.Errorf("uint values of a %s", c.Schema.Kind)
+	}
+	res := make([]uint64, len(c.Values))
+	for i, buf := range c.Values {
+		val, err := uintValue(buf)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = val
+	}
+	return res, nil
+}
+
+// Extractor reads the values at some paths of the SSZ encodings of a schema without
+// decoding the rest of the object. Only the offsets on the way to the values are
+// read. The paths may select all the elements of a list or vector with '[*]' (i.e.
+// 'body.attestations[*].data.slot'), then a column has any number of values per object.
+type Extractor struct {
+	schema  *Schema
+	paths   []string
+	elems   [][]PathElem
+	schemas []*Schema
+}
+
+// NewExtractor returns an extractor of the paths, which are checked against the schema
+func NewExtractor(s *Schema, paths ...string) (*Extractor, error) {
+	e := &Extractor{schema: s, paths: paths}
+	for _, path := range paths {
+		elems, err := ParsePath(path)
+		if err != nil {
+			return nil, err
+		}
+		schema := s
+		for _, elem := range elems {
+			if elem.IsIndex {
+				if schema.Kind != KindVector && schema.Kind != KindList {
+					return nil, fmt.Errorf("path '%s': index of a %s", path, schema.Kind)
+				}
+				schema = schema.Elem
+				continue
+			}
+			if schema.Kind != KindContainer {
+				return nil, fmt.Errorf("path '%s': field %s of a %s", path, elem.Field, schema.Kind)
+			}
+			indx, ok := schema.FieldIndex(elem.Field)
+			if !ok {
+				return nil, fmt.Errorf("path '%s': field %s not found in %s", path, elem.Field, schema.Name)
+			}
+			schema = schema.Fields[indx].Schema
+		}
+		e.elems = append(e.elems, elems)
+		e.schemas = append(e.schemas, schema)
+	}
+	return e, nil
+}
+
+// Columns returns the empty columns of the paths
+func (e *Extractor) Columns() []*Column {
+	cols := make([]*Column, len(e.paths))
+	for i, path := range e.paths {
+		cols[i] = &Column{Path: path, Schema: e.schemas[i]}
+	}
+	return cols
+}
+
+// Extract appends the values of the object to the columns returned by Columns
+func (e *Extractor) Extract(cols []*Column, buf []byte) error {
+	if len(cols) != len(e.paths) {
+		return fmt.Errorf("expected %d columns but found %d", len(e.paths), len(cols))
+	}
+	for i, elems := range e.elems {
+		values, err := extractPath(e.schema, buf, elems, cols[i].Values)
+		if err != nil {
+			return fmt.Errorf("path '%s': %v", e.paths[i], err)
+		}
+		cols[i].Values = values
+	}
+	return nil
+}
+
+// ExtractColumns returns the columns of the paths for all the objects
+func ExtractColumns(s *Schema, bufs [][]byte, paths ...string) ([]*Column, error) {
+	e, err := NewExtractor(s, paths...)
+	if err != nil {
+		return nil, err
+	}
+	cols := e.Columns()
+	for _, buf := range bufs {
+		if err := e.Extract(cols, buf); err != nil {
+			return nil, err
+		}
+	}
+	return cols, nil
+}
+
+// extractPath appends the encodings of the values at the path
+func extractPath(s *Schema, buf []byte, elems []PathElem, dst [][]byte) ([][]byte, error) {
+	if len(elems) == 0 {
+		return append(dst, buf), nil
+	}
+	elem := elems[0]
+	if !elem.IsIndex {
+		parts, err := s.splitFields(buf)
+		if err != nil {
+			return nil, err
+		}
+		indx, _ := s.FieldIndex(elem.Field)
+		return extractPath(s.Fields[indx].Schema, parts[indx], elems[1:], dst)
+	}
+
+	parts, err := s.splitElems(buf)
+	if err != nil {
+		return nil, err
+	}
+	if !elem.All {
+		if elem.Index >= uint64(len(parts)) {
+			return nil, ErrIndexOutOfRange
+		}
+		parts = parts[elem.Index : elem.Index+1]
+	}
+	for _, part := range parts {
+		if dst, err = extractPath(s.Elem, part, elems[1:], dst); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
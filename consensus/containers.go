@@ -0,0 +1,120 @@
+// Package consensus has the generated encodings of the small containers of the consensus specs
+// that are the same in all the forks and presets (i.e. to compute the domains and the signing
+// roots), so that the packages that only need them do not generate their own copies.
+package consensus
+
+// ForkData is hashed to compute the fork digests and the domains
+type ForkData struct {
+	CurrentVersion        []byte `json:"current_version" ssz-size:"4"`
+	GenesisValidatorsRoot []byte `json:"genesis_validators_root" ssz-size:"32"`
+}
+
+// SigningData has the root of an object and the domain of its signature
+type SigningData struct {
+	ObjectRoot []byte `json:"object_root" ssz-size:"32"`
+	Domain     []byte `json:"domain" ssz-size:"32"`
+}
+
+// Fork is the fork of a beacon state
+type Fork struct {
+	PreviousVersion []byte `json:"previous_version" ssz-size:"4"`
+	CurrentVersion  []byte `json:"current_version" ssz-size:"4"`
+	Epoch           uint64 `json:"epoch"`
+}
+
+// Checkpoint is the root of the block of an epoch
+type Checkpoint struct {
+	Epoch uint64 `json:"epoch"`
+	Root  []byte `json:"root" ssz-size:"32"`
+}
+
+// AttestationData is the vote of an attestation
+type AttestationData struct {
+	Slot            uint64      `json:"slot"`
+	Index           uint64      `json:"index"`
+	BeaconBlockRoot []byte      `json:"beacon_block_root" ssz-size:"32"`
+	Source          *Checkpoint `json:"source"`
+	Target          *Checkpoint `json:"target"`
+}
+
+// BeaconBlockHeader is the header of a beacon block with the root of its body
+type BeaconBlockHeader struct {
+	Slot          uint64 `json:"slot"`
+	ProposerIndex uint64 `json:"proposer_index"`
+	ParentRoot    []byte `json:"parent_root" ssz-size:"32"`
+	StateRoot     []byte `json:"state_root" ssz-size:"32"`
+	BodyRoot      []byte `json:"body_root" ssz-size:"32"`
+}
+
+// SignedBeaconBlockHeader is a signed BeaconBlockHeader
+type SignedBeaconBlockHeader struct {
+	Message   *BeaconBlockHeader `json:"message"`
+	Signature []byte             `json:"signature" ssz-size:"96"`
+}
+
+// Eth1Data is the vote of the deposit contract state
+type Eth1Data struct {
+	DepositRoot  []byte `json:"deposit_root" ssz-size:"32"`
+	DepositCount uint64 `json:"deposit_count"`
+	BlockHash    []byte `json:"block_hash" ssz-size:"32"`
+}
+
+// DepositMessage is the message signed by a deposit
+type DepositMessage struct {
+	Pubkey                []byte `json:"pubkey" ssz-size:"48"`
+	WithdrawalCredentials []byte `json:"withdrawal_credentials" ssz-size:"32"`
+	Amount                uint64 `json:"amount"`
+}
+
+// DepositData is a DepositMessage with its signature
+type DepositData struct {
+	Pubkey                []byte `json:"pubkey" ssz-size:"48"`
+	WithdrawalCredentials []byte `json:"withdrawal_credentials" ssz-size:"32"`
+	Amount                uint64 `json:"amount"`
+	Signature             []byte `json:"signature" ssz-size:"96"`
+}
+
+// Validator is the record of a validator in a beacon state
+type Validator struct {
+	Pubkey                     []byte `json:"pubkey" ssz-size:"48"`
+	WithdrawalCredentials      []byte `json:"withdrawal_credentials" ssz-size:"32"`
+	EffectiveBalance           uint64 `json:"effective_balance"`
+	Slashed                    bool   `json:"slashed"`
+	ActivationEligibilityEpoch uint64 `json:"activation_eligibility_epoch"`
+	ActivationEpoch            uint64 `json:"activation_epoch"`
+	ExitEpoch                  uint64 `json:"exit_epoch"`
+	WithdrawableEpoch          uint64 `json:"withdrawable_epoch"`
+}
+
+// VoluntaryExit is the message of a validator that exits
+type VoluntaryExit struct {
+	Epoch          uint64 `json:"epoch"`
+	ValidatorIndex uint64 `json:"validator_index"`
+}
+
+// SignedVoluntaryExit is a signed VoluntaryExit
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit `json:"message"`
+	Signature []byte         `json:"signature" ssz-size:"96"`
+}
+
+// Withdrawal is a withdrawal of an execution payload (capella)
+type Withdrawal struct {
+	Index          uint64 `json:"index"`
+	ValidatorIndex uint64 `json:"validator_index"`
+	Address        []byte `json:"address" ssz-size:"20"`
+	Amount         uint64 `json:"amount"`
+}
+
+// BLSToExecutionChange is the change of the withdrawal credentials of a validator (capella)
+type BLSToExecutionChange struct {
+	ValidatorIndex     uint64 `json:"validator_index"`
+	FromBLSPubkey      []byte `json:"from_bls_pubkey" ssz-size:"48"`
+	ToExecutionAddress []byte `json:"to_execution_address" ssz-size:"20"`
+}
+
+// SignedBLSToExecutionChange is a signed BLSToExecutionChange
+type SignedBLSToExecutionChange struct {
+	Message   *BLSToExecutionChange `json:"message"`
+	Signature []byte                `json:"signature" ssz-size:"96"`
+}
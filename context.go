@@ -0,0 +1,57 @@
+package ssz
+
+import (
+	"bytes"
+	"context"
+	"io"
+)
+
+// contextReader fails the reads once the context is done. The hasher and the decoder read
+// it through a bufio.Reader, so the context is checked every few kilobytes of input.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
+
+// HashTreeRootContext computes the root of the object like HashTreeRoot and stops with the
+// error of the context (i.e. context.DeadlineExceeded) once it is done, so that the time spent
+// on the root of a big state by a request is bounded. The object must implement SchemaProvider.
+func HashTreeRootContext(ctx context.Context, obj Marshaler) ([32]byte, error) {
+	s, ok := obj.(SchemaProvider)
+	if !ok {
+		return [32]byte{}, ErrNotSupported
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return HashTreeRootReaderContext(ctx, s.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf)))
+}
+
+// HashTreeRootReaderContext is HashTreeRootReader with a context, the input stops being read once it is done
+func HashTreeRootReaderContext(ctx context.Context, s *Schema, r io.Reader, size uint64) ([32]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return [32]byte{}, err
+	}
+	return HashTreeRootReader(s, &contextReader{ctx: ctx, r: r}, size)
+}
+
+// DecodeContext is Decode with a context, the input stops being read once it is done. The
+// decoder should not be used after an error of the context, the value being read is incomplete.
+func (d *Decoder) DecodeContext(ctx context.Context, obj Unmarshaler, size uint64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	d.src.ctx = ctx
+	defer func() {
+		d.src.ctx = context.Background()
+	}()
+	return d.Decode(obj, size)
+}
@@ -0,0 +1,35 @@
+//go:build amd64 && !gccgo && !appengine && !tinygo && !noasm
+// +build amd64,!gccgo,!appengine,!tinygo,!noasm
+
+package ssz
+
+func cpuid(op, op2 uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+// detectFeatures reads the extensions of the CPU used by the sha256 backends
+func detectFeatures() cpuFeatures {
+	var f cpuFeatures
+	if maxID, _, _, _ := cpuid(0, 0); maxID < 7 {
+		return f
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	_, ebx7, _, _ := cpuid(7, 0)
+
+	// the SHA extensions are used along with SSSE3 and SSE4.1
+	f.sha = ebx7&(1<<29) != 0 && ecx1&(1<<9) != 0 && ecx1&(1<<19) != 0
+
+	// the AVX registers have to be enabled by the OS
+	if ecx1&(1<<27) == 0 {
+		return f
+	}
+	xcr0, _ := xgetbv()
+	if xcr0&0x6 == 0x6 {
+		f.avx2 = ebx7&(1<<5) != 0
+	}
+	if xcr0&0xe6 == 0xe6 {
+		// AVX-512 F, DQ, BW and VL
+		f.avx512 = ebx7&(1<<16) != 0 && ebx7&(1<<17) != 0 && ebx7&(1<<30) != 0 && ebx7&(1<<31) != 0
+	}
+	return f
+}
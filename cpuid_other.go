@@ -0,0 +1,9 @@
+//go:build !amd64 || gccgo || appengine || tinygo || noasm
+// +build !amd64 gccgo appengine tinygo noasm
+
+package ssz
+
+// detectFeatures does not detect any extension outside of amd64
+func detectFeatures() cpuFeatures {
+	return cpuFeatures{}
+}
@@ -0,0 +1,69 @@
+package ssz
+
+import (
+	"bufio"
+	"context"
+	"io"
+)
+
+// Decoder reads consecutive values from a stream. The input of the types with a schema
+// is validated as it arrives: the fixed part of each container and its offsets are checked
+// as soon as they are read, so a malformed message is rejected without waiting for the
+// rest of it and the memory held is the part of the input received so far.
+type Decoder struct {
+	r   *bufio.Reader
+	buf decodeBuffer
+	// src checks the context of DecodeContext
+	src *contextReader
+}
+
+// NewDecoder returns a decoder that reads from r
+func NewDecoder(r io.Reader) *Decoder {
+	src := &contextReader{ctx: context.Background(), r: r}
+	return &Decoder{r: bufio.NewReader(src), src: src}
+}
+
+// Decode reads the next size bytes into obj, which must not keep references to the input
+// (the generated code copies the bytes). The input of an object that does not implement
+// SchemaProvider is only decoded once it has been read.
+func (d *Decoder) Decode(obj Unmarshaler, size uint64) error {
+	d.buf = d.buf[:0]
+
+	s, ok := obj.(SchemaProvider)
+	if !ok {
+		buf, err := d.read(size)
+		if err != nil {
+			return err
+		}
+		return TraceUnmarshal(obj, buf)
+	}
+	schema := s.SchemaSSZ()
+	if size < schema.MinSize() || size > schema.MaxSize() {
+		return ErrSize
+	}
+	// the walk of the hasher without hashing validates the input as it is read
+	h := &streamHasher{r: io.TeeReader(d.r, &d.buf), p: defaultProfile}
+	if _, err := h.hashValue(schema, size); err != nil {
+		return err
+	}
+	return TraceUnmarshal(obj, d.buf)
+}
+
+// read reads the next size bytes, the buffer grows with the input instead of with the size
+func (d *Decoder) read(size uint64) ([]byte, error) {
+	if _, err := io.CopyN(&d.buf, d.r, int64(size)); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return d.buf, nil
+}
+
+// decodeBuffer is the input of the value being decoded, it is reused between the values
+type decodeBuffer []byte
+
+func (b *decodeBuffer) Write(p []byte) (int, error) {
+	*b = append(*b, p...)
+	return len(p), nil
+}
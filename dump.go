@@ -0,0 +1,243 @@
+package ssz
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+)
+
+// Dump writes the encoding of a value of the schema as an hexdump annotated with the
+// fields of each byte range, the decoded uints and offsets and the limits of the dynamic
+// sections. The invalid sections are written as raw bytes and the first error is returned
+// once the whole input has been written.
+func Dump(w io.Writer, s *Schema, buf []byte) error {
+	d := &dumper{w: w}
+	d.value(s, buf, 0, "")
+	if d.werr != nil {
+		return d.werr
+	}
+	return d.err
+}
+
+type dumper struct {
+	w io.Writer
+	// err is the first error of the input and werr the first error of the writer
+	err  error
+	werr error
+}
+
+const dumpBytesPerLine = 16
+
+// line writes the bytes that start at pos with the label on the first line
+func (d *dumper) line(pos uint64, buf []byte, label string) {
+	if len(buf) == 0 {
+		d.printf("%08x  %-*s  %s\n", pos, dumpBytesPerLine*3-1, "(empty)", label)
+		return
+	}
+	for i := 0; i < len(buf); i += dumpBytesPerLine {
+		end := i + dumpBytesPerLine
+		if end > len(buf) {
+			end = len(buf)
+		}
+		hex := make([]string, 0, dumpBytesPerLine)
+		for _, b := range buf[i:end] {
+			hex = append(hex, fmt.Sprintf("%02x", b))
+		}
+		line := fmt.Sprintf("%08x  %-*s  %s", pos+uint64(i), dumpBytesPerLine*3-1, strings.Join(hex, " "), label)
+		d.printf("%s\n", strings.TrimRight(line, " "))
+		label = ""
+	}
+}
+
+func (d *dumper) printf(format string, args ...interface{}) {
+	if d.werr != nil {
+		return
+	}
+	_, d.werr = fmt.Fprintf(d.w, format, args...)
+}
+
+// invalid writes the bytes of a section that cannot be decoded
+func (d *dumper) invalid(pos uint64, buf []byte, path string, err error) {
+	if d.err == nil {
+		d.err = fmt.Errorf("%s: %v", dumpName(path), err)
+	}
+	d.line(pos, buf, fmt.Sprintf("%s: invalid (%v)", dumpName(path), err))
+}
+
+func dumpName(path string) string {
+	if path == "" {
+		return "<root>"
+	}
+	return path
+}
+
+// section writes the limits of a dynamic section before its contents
+func (d *dumper) section(s *Schema, buf []byte, pos uint64, path string) {
+	d.printf("%08x  -- %s [0x%x, 0x%x) %d bytes --\n", pos, path, pos, pos+uint64(len(buf)), len(buf))
+	d.value(s, buf, pos, path)
+}
+
+func (d *dumper) value(s *Schema, buf []byte, pos uint64, path string) {
+	name := dumpName(path)
+
+	switch s.Kind {
+	case KindUint:
+		if uint64(len(buf)) != s.Size {
+			d.invalid(pos, buf, path, ErrSize)
+			return
+		}
+		// the big ints are big endian
+		num := make([]byte, len(buf))
+		for i := range buf {
+			num[len(buf)-1-i] = buf[i]
+		}
+		d.line(pos, buf, fmt.Sprintf("%s: uint%d = %s", name, 8*s.Size, new(big.Int).SetBytes(num)))
+
+	case KindBool:
+		if len(buf) != 1 || buf[0] > 1 {
+			d.invalid(pos, buf, path, ErrInvalidBool)
+			return
+		}
+		d.line(pos, buf, fmt.Sprintf("%s: bool = %v", name, buf[0] == 1))
+
+	case KindByteVector, KindByteList, KindBitVector, KindBitList:
+		if _, err := s.nodeFromSSZ(buf); err != nil {
+			d.invalid(pos, buf, path, err)
+			return
+		}
+		label := fmt.Sprintf("%s: %s", name, s.String())
+		if s.Kind == KindBitList {
+			_, size := parseBitlist(nil, buf)
+			label += fmt.Sprintf(" with %d bits", size)
+		} else if s.Kind == KindByteList {
+			label += fmt.Sprintf(" with %d bytes", len(buf))
+		}
+		d.line(pos, buf, label)
+
+	case KindVector, KindList:
+		parts, err := s.splitElems(buf)
+		if err != nil {
+			d.invalid(pos, buf, path, err)
+			return
+		}
+		if s.Kind == KindList && len(parts) == 0 {
+			d.line(pos, buf, fmt.Sprintf("%s: %s with 0 elements", name, s.String()))
+			return
+		}
+		if s.Elem.IsFixed() {
+			for i, part := range parts {
+				d.value(s.Elem, part, pos+uint64(i)*s.Elem.FixedSize(), fmt.Sprintf("%s[%d]", path, i))
+			}
+			return
+		}
+		for i := range parts {
+			offset := ReadOffset(buf[i*bytesPerLengthOffset:])
+			d.line(pos+uint64(i*bytesPerLengthOffset), buf[i*bytesPerLengthOffset:(i+1)*bytesPerLengthOffset], fmt.Sprintf("%s[%d]: offset = %d (0x%x)", path, i, offset, pos+offset))
+		}
+		for i, part := range parts {
+			start := ReadOffset(buf[i*bytesPerLengthOffset:])
+			d.section(s.Elem, part, pos+start, fmt.Sprintf("%s[%d]", path, i))
+		}
+
+	case KindContainer:
+		fixed := uint64(0)
+		for _, f := range s.Fields {
+			fixed += f.Schema.FixedSize()
+		}
+		parts, err := s.splitFields(buf)
+		if err != nil && uint64(len(buf)) < fixed {
+			d.invalid(pos, buf, path, err)
+			return
+		}
+		fieldPath := func(f *Field) string {
+			if path == "" {
+				return f.Name
+			}
+			return path + "." + f.Name
+		}
+		dynamic, starts := []int{}, []uint64{}
+		offset := uint64(0)
+		for i, f := range s.Fields {
+			if f.Schema.IsFixed() {
+				d.value(f.Schema, buf[offset:offset+f.Schema.FixedSize()], pos+offset, fieldPath(f))
+			} else {
+				start := ReadOffset(buf[offset:])
+				d.line(pos+offset, buf[offset:offset+bytesPerLengthOffset], fmt.Sprintf("%s: offset = %d (0x%x)", fieldPath(f), start, pos+start))
+				dynamic, starts = append(dynamic, i), append(starts, start)
+			}
+			offset += f.Schema.FixedSize()
+		}
+		if err != nil {
+			// the fixed part is written even if the offsets are not valid
+			d.invalid(pos+fixed, buf[fixed:], path, err)
+			return
+		}
+		for j, i := range dynamic {
+			d.section(s.Fields[i].Schema, parts[i], pos+starts[j], fieldPath(s.Fields[i]))
+		}
+
+	case KindUnion:
+		if len(buf) == 0 {
+			d.invalid(pos, buf, path, ErrSize)
+			return
+		}
+		opt, err := s.option(uint64(buf[0]))
+		if err != nil {
+			d.invalid(pos, buf, path, err)
+			return
+		}
+		if opt == nil {
+			if len(buf) != 1 {
+				d.invalid(pos, buf, path, ErrSize)
+				return
+			}
+			d.line(pos, buf, fmt.Sprintf("%s: selector = 0 (None)", name))
+			return
+		}
+		d.line(pos, buf[:1], fmt.Sprintf("%s: selector = %d (%s)", name, buf[0], opt.String()))
+		d.value(opt, buf[1:], pos+1, path)
+	}
+}
+
+// String returns the SSZ type of the schema in the notation of the specs (i.e. List[uint64, 16])
+func (s *Schema) String() string {
+	switch s.Kind {
+	case KindUint:
+		return fmt.Sprintf("uint%d", 8*s.Size)
+	case KindBool:
+		return "boolean"
+	case KindByteVector:
+		return fmt.Sprintf("Bytes%d", s.Size)
+	case KindByteList:
+		return fmt.Sprintf("ByteList[%d]", s.Max)
+	case KindBitVector:
+		return fmt.Sprintf("Bitvector[%d]", s.Size)
+	case KindBitList:
+		if s.Progressive {
+			return "ProgressiveBitlist"
+		}
+		return fmt.Sprintf("Bitlist[%d]", s.Max)
+	case KindVector:
+		return fmt.Sprintf("Vector[%s, %d]", s.Elem.String(), s.Size)
+	case KindList:
+		if s.Progressive {
+			return fmt.Sprintf("ProgressiveList[%s]", s.Elem.String())
+		}
+		return fmt.Sprintf("List[%s, %d]", s.Elem.String(), s.Max)
+	case KindContainer:
+		return s.Name
+	case KindUnion:
+		opts := []string{}
+		for _, opt := range s.Options {
+			if opt == nil {
+				opts = append(opts, "None")
+			} else {
+				opts = append(opts, opt.String())
+			}
+		}
+		return "Union[" + strings.Join(opts, ", ") + "]"
+	default:
+		return s.Kind.String()
+	}
+}
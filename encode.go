@@ -3,6 +3,23 @@ package ssz
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
+	"math/bits"
+)
+
+var (
+	// ErrBitlistNoLengthBit is returned when a bitlist does not have the length bit set
+	ErrBitlistNoLengthBit = fmt.Errorf("bitlist has no length bit")
+	// ErrBitlistTooBig is returned when a bitlist has more bits than its limit
+	ErrBitlistTooBig = fmt.Errorf("bitlist too big")
+	// ErrListTooBig is returned when a dynamic list has more elements than its limit
+	ErrListTooBig = fmt.Errorf("list too big")
+	// ErrOffset is returned when the offsets of a dynamic list are out of bounds or not in order
+	ErrOffset = fmt.Errorf("incorrect offset")
+	// ErrOffsetOverflow is returned when an offset is larger than the 4 bytes of the encoded offset
+	ErrOffsetOverflow = fmt.Errorf("offset overflows 4 bytes")
+	// ErrBitvectorPadding is returned when a bitvector has bits set after its length
+	ErrBitvectorPadding = fmt.Errorf("bitvector has non-zero padding bits")
 )
 
 // ---- Unmarshal functions ----
@@ -35,8 +52,61 @@ func UnmarshalBool(src []byte) bool {
 	return false
 }
 
+// ValidateBitlist validates that the encoded bitlist has the length bit set (the most
+// significant bit of the last byte) and that its number of bits is not higher than bitLimit.
+// A bitLimit of zero only validates the length bit.
+func ValidateBitlist(buf []byte, bitLimit uint64) error {
+	byteLen := len(buf)
+	if byteLen == 0 || buf[byteLen-1] == 0 {
+		return ErrBitlistNoLengthBit
+	}
+	if bitLimit == 0 {
+		return nil
+	}
+	// the length bit is not part of the bitlist
+	numBits := uint64(8*(byteLen-1) + bits.Len8(buf[byteLen-1]) - 1)
+	if numBits > bitLimit {
+		return ErrBitlistTooBig
+	}
+	return nil
+}
+
+// ValidateBitvector validates that the encoded bitvector has bitLen bits and that
+// the padding bits of the last byte are zero.
+func ValidateBitvector(buf []byte, bitLen uint64) error {
+	if uint64(len(buf)) != (bitLen+7)/8 {
+		return fmt.Errorf("expected bitvector size %d but found %d", (bitLen+7)/8, len(buf))
+	}
+	if rem := bitLen % 8; rem != 0 && buf[len(buf)-1]>>rem != 0 {
+		return ErrBitvectorPadding
+	}
+	return nil
+}
+
+// ValidateBools validates that the encoded list or vector of booleans only has 0 and 1 bytes
+func ValidateBools(buf []byte) error {
+	for _, b := range buf {
+		if b > 1 {
+			return ErrInvalidBool
+		}
+	}
+	return nil
+}
+
 // ---- Marshal functions ----
 
+// DerefBytes returns the bytes of a *[]byte field, a nil pointer is the zero value of
+// the field: no bytes for the dynamic bytes or size zero bytes for the fixed bytes
+func DerefBytes(buf *[]byte, size int) []byte {
+	if buf == nil {
+		if size == 0 {
+			return nil
+		}
+		return make([]byte, size)
+	}
+	return *buf
+}
+
 // MarshalFixedBytes marshals buf of fixed size to dst
 func MarshalFixedBytes(dst []byte, buf []byte, size int) ([]byte, error) {
 	if len(buf) != size {
@@ -93,6 +163,14 @@ func WriteOffset(dst []byte, i int) []byte {
 	return MarshalUint32(dst, uint32(i))
 }
 
+// SafeWriteOffset writes an offset to dst and fails if it does not fit in the 4 bytes of the offset
+func SafeWriteOffset(dst []byte, i int) ([]byte, error) {
+	if uint64(i) > math.MaxUint32 {
+		return nil, ErrOffsetOverflow
+	}
+	return MarshalUint32(dst, uint32(i)), nil
+}
+
 // ReadOffset reads an offset from buf
 func ReadOffset(buf []byte) uint64 {
 	return uint64(binary.LittleEndian.Uint32(buf))
@@ -100,7 +178,7 @@ func ReadOffset(buf []byte) uint64 {
 
 func safeReadOffset(buf []byte) (uint64, []byte, error) {
 	if len(buf) < 4 {
-		return 0, nil, fmt.Errorf("")
+		return 0, nil, ErrOffset
 	}
 	offset := ReadOffset(buf)
 	return offset, buf[4:], nil
@@ -126,6 +204,24 @@ func ExtendUint16(b []uint16, needLen int) []uint16 {
 	return b[:needLen]
 }
 
+// ExtendUint32 extends a uint32 buffer to a given size
+func ExtendUint32(b []uint32, needLen int) []uint32 {
+	b = b[:cap(b)]
+	if n := needLen - cap(b); n > 0 {
+		b = append(b, make([]uint32, n)...)
+	}
+	return b[:needLen]
+}
+
+// ExtendUint8 extends a uint8 buffer to a given size
+func ExtendUint8(b []uint8, needLen int) []uint8 {
+	b = b[:cap(b)]
+	if n := needLen - cap(b); n > 0 {
+		b = append(b, make([]uint8, n)...)
+	}
+	return b[:needLen]
+}
+
 // ---- unmarshal dynami content ----
 
 const bytesPerLengthOffset = 4
@@ -139,17 +235,36 @@ func DecodeDynamicLength(buf []byte, maxSize int) (int, error) {
 		return 0, fmt.Errorf("not enough data")
 	}
 	offset := binary.LittleEndian.Uint32(buf[:4])
+	// the offset is checked before the conversion to int, which has 32 bits on some targets
+	if uint64(offset) > uint64(len(buf)) {
+		return 0, ErrOffset
+	}
+	// the first offset points to the end of the offsets
 	length, ok := DivideInt(int(offset), bytesPerLengthOffset)
-	if !ok {
-		return 0, fmt.Errorf("bad")
+	if !ok || length == 0 {
+		return 0, ErrOffset
 	}
 	if length > maxSize {
-		return 0, fmt.Errorf("too big for the list")
+		return 0, ErrListTooBig
 	}
 	return length, nil
 }
 
-// UnmarshalDynamic unmarshals the dynamic items from the input
+// maxListPrealloc is the number of elements preallocated at most by ListCapacity
+const maxListPrealloc = 1024
+
+// ListCapacity returns the initial capacity of a list of num dynamic elements. The number
+// of elements comes from the first offset of the input, the list grows as its elements are
+// decoded so that an invalid input does not allocate the elements it does not have.
+func ListCapacity(num int) int {
+	if num > maxListPrealloc {
+		return maxListPrealloc
+	}
+	return num
+}
+
+// UnmarshalDynamic unmarshals the dynamic items from the input. The first offset must point to
+// the end of the length offsets and the offsets must be in order and within the input.
 func UnmarshalDynamic(src []byte, length int, f func(indx int, b []byte) error) error {
 	var err error
 	if length == 0 {
@@ -157,12 +272,18 @@ func UnmarshalDynamic(src []byte, length int, f func(indx int, b []byte) error)
 	}
 
 	size := uint64(len(src))
+	if length < 0 || uint64(length)*bytesPerLengthOffset > size {
+		return ErrOffset
+	}
 
 	indx := 0
 	dst := src
 
 	var offset, endOffset uint64
 	offset, dst = ReadOffset(src), dst[4:]
+	if offset != uint64(length)*bytesPerLengthOffset {
+		return ErrOffset
+	}
 
 	for {
 		if length != 1 {
@@ -173,11 +294,8 @@ func UnmarshalDynamic(src []byte, length int, f func(indx int, b []byte) error)
 		} else {
 			endOffset = uint64(len(src))
 		}
-		if offset > endOffset {
-			return fmt.Errorf("four")
-		}
-		if endOffset > size {
-			return fmt.Errorf("five")
+		if offset > endOffset || endOffset > size {
+			return ErrOffset
 		}
 
 		err := f(indx, src[offset:endOffset])
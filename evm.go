@@ -0,0 +1,72 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// EVMProof is a proof in the layout of the Solidity verifiers (i.e. the consumers of the beacon
+// block roots of EIP-4788): 32 bytes words, with the generalized index as a big endian uint256
+type EVMProof struct {
+	Gindex [32]byte
+	Leaf   [32]byte
+	// Branch are the roots of the siblings from the leaf up to the root, as in the proof
+	Branch [][32]byte
+}
+
+// EVM returns the proof in the layout of the EVM verifiers
+func (p *Proof) EVM() (*EVMProof, error) {
+	if p.Index == 0 || len(p.Leaf) != 32 {
+		return nil, ErrInvalidProof
+	}
+	e := &EVMProof{Branch: make([][32]byte, len(p.Hashes))}
+	binary.BigEndian.PutUint64(e.Gindex[24:], p.Index)
+	copy(e.Leaf[:], p.Leaf)
+	for indx, h := range p.Hashes {
+		if len(h) != 32 {
+			return nil, ErrInvalidProof
+		}
+		copy(e.Branch[indx][:], h)
+	}
+	return e, nil
+}
+
+// ABIEncode returns the ABI encoding of (uint256 gindex, bytes32 leaf, bytes32[] branch), the arguments
+// of a verifier like 'function verify(uint256 gindex, bytes32 leaf, bytes32[] calldata branch)' after the selector
+func (e *EVMProof) ABIEncode() []byte {
+	dst := make([]byte, 0, 32*(4+len(e.Branch)))
+	dst = append(dst, e.Gindex[:]...)
+	dst = append(dst, e.Leaf[:]...)
+	// the offset of the branch from the start of the arguments and its length
+	dst = appendWord(dst, 32*3)
+	dst = appendWord(dst, uint64(len(e.Branch)))
+	for _, h := range e.Branch {
+		dst = append(dst, h[:]...)
+	}
+	return dst
+}
+
+// appendWord appends the 32 bytes big endian word of an uint
+func appendWord(dst []byte, i uint64) []byte {
+	var word [32]byte
+	binary.BigEndian.PutUint64(word[24:], i)
+	return append(dst, word[:]...)
+}
+
+// MarshalJSON encodes the proof with the 0x prefixed hex words used by the
+// Ethereum tooling: {"gindex": "0x...", "leaf": "0x...", "branch": ["0x..."]}
+func (e *EVMProof) MarshalJSON() ([]byte, error) {
+	word := func(w [32]byte) string {
+		return "0x" + hex.EncodeToString(w[:])
+	}
+	branch := make([]string, len(e.Branch))
+	for indx, h := range e.Branch {
+		branch[indx] = word(h)
+	}
+	return json.Marshal(map[string]interface{}{
+		"gindex": word(e.Gindex),
+		"leaf":   word(e.Leaf),
+		"branch": branch,
+	})
+}
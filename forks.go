@@ -0,0 +1,115 @@
+package ssz
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ErrUnknownFork is returned when a type is not registered for a fork version or digest
+var ErrUnknownFork = fmt.Errorf("unknown fork")
+
+// Object is the interface implemented by the generated types
+type Object interface {
+	MarshalUnmarshaler
+	HashRoot
+}
+
+// Forks maps the fork versions to the variants of the types (i.e. the Phase0, Altair
+// and Bellatrix blocks), so that a payload is decoded with the type of its fork.
+type Forks struct {
+	lock  sync.RWMutex
+	types map[string]map[[4]byte]func() Object
+	// digests are the versions of the digests with each genesis validators root
+	digests map[[32]byte]map[[4]byte][4]byte
+}
+
+// NewForks creates an empty set of forks
+func NewForks() *Forks {
+	return &Forks{
+		types:   map[string]map[[4]byte]func() Object{},
+		digests: map[[32]byte]map[[4]byte][4]byte{},
+	}
+}
+
+// DefaultForks are the forks of RegisterFork
+var DefaultForks = NewForks()
+
+// RegisterFork registers the type of name in the fork version in DefaultForks, it is meant to be called at init
+func RegisterFork(name string, version [4]byte, newObj func() Object) {
+	DefaultForks.Register(name, version, newObj)
+}
+
+// Register sets newObj as the constructor of the type of name in the fork version
+func (f *Forks) Register(name string, version [4]byte, newObj func() Object) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.types[name] == nil {
+		f.types[name] = map[[4]byte]func() Object{}
+	}
+	f.types[name][version] = newObj
+	// the digests are computed again with the new version
+	f.digests = map[[32]byte]map[[4]byte][4]byte{}
+}
+
+// New returns an empty object of the type of name in the fork version
+func (f *Forks) New(name string, version [4]byte) (Object, error) {
+	f.lock.RLock()
+	newObj, ok := f.types[name][version]
+	f.lock.RUnlock()
+	if !ok {
+		return nil, ErrUnknownFork
+	}
+	return newObj(), nil
+}
+
+// Version returns the registered fork version with the digest in the chain of the genesis validators root
+func (f *Forks) Version(digest [4]byte, genesisValidatorsRoot [32]byte) ([4]byte, error) {
+	f.lock.RLock()
+	versions, ok := f.digests[genesisValidatorsRoot]
+	f.lock.RUnlock()
+
+	if !ok {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+
+		versions = map[[4]byte][4]byte{}
+		for _, types := range f.types {
+			for version := range types {
+				d, err := ComputeForkDigest(version, genesisValidatorsRoot)
+				if err != nil {
+					return [4]byte{}, err
+				}
+				versions[d] = version
+			}
+		}
+		f.digests[genesisValidatorsRoot] = versions
+	}
+	version, ok := versions[digest]
+	if !ok {
+		return [4]byte{}, ErrUnknownFork
+	}
+	return version, nil
+}
+
+// DecodeVersion decodes the payload with the type of name in the fork version
+func (f *Forks) DecodeVersion(name string, version [4]byte, buf []byte) (Object, error) {
+	obj, err := f.New(name, version)
+	if err != nil {
+		return nil, err
+	}
+	if err := TraceUnmarshal(obj, buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// DecodeDigest decodes the payload with the type of name in the fork of the digest (i.e.
+// the context bytes of a req/resp chunk) in the chain of the genesis validators root
+func (f *Forks) DecodeDigest(name string, digest [4]byte, genesisValidatorsRoot [32]byte, buf []byte) (Object, error) {
+	version, err := f.Version(digest, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	return f.DecodeVersion(name, version, buf)
+}
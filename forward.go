@@ -0,0 +1,107 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// SplitUnknownFields splits the encoding of a newer version of the container of the schema, with more
+// fields after the known ones, in the encoding of the known fields and the tail of the unknown fields:
+// their fixed part followed by their variable part, with the offsets of the newer encoding. The encodings
+// of the schema have an empty tail.
+//
+// If the known fields have a variable part, the last known variable field ends at the first offset of the
+// unknown fixed part for which it is valid, or at the end of the input if the unknown fields are all fixed.
+// The nested containers have to be known.
+func SplitUnknownFields(s *Schema, buf []byte) ([]byte, []byte, error) {
+	if s.Kind != KindContainer {
+		return nil, nil, ErrInvalidSchema
+	}
+	size := uint64(len(buf))
+	fixed := uint64(0)
+	for _, f := range s.Fields {
+		fixed += f.Schema.FixedSize()
+	}
+	if size < fixed {
+		return nil, nil, ErrSize
+	}
+	if s.IsFixed() {
+		return buf[:fixed], buf[fixed:], nil
+	}
+
+	// the offsets of the variable fields, the first one is the end of the fixed part of the newer version
+	offsets := []uint64{}
+	var last *Schema
+	pos := uint64(0)
+	for _, f := range s.Fields {
+		if f.Schema.IsFixed() {
+			pos += f.Schema.FixedSize()
+			continue
+		}
+		offset := ReadOffset(buf[pos : pos+bytesPerLengthOffset])
+		if offset > size || (len(offsets) == 0 && offset < fixed) || (len(offsets) != 0 && offset < offsets[len(offsets)-1]) {
+			return nil, nil, ErrOffset
+		}
+		offsets = append(offsets, offset)
+		last = f.Schema
+		pos += bytesPerLengthOffset
+	}
+	fixedTail := buf[fixed:offsets[0]]
+
+	// the first offset of an unknown variable field is the end of the last known one
+	start, end := offsets[len(offsets)-1], size
+	for p := 0; p+bytesPerLengthOffset <= len(fixedTail); p++ {
+		offset := ReadOffset(fixedTail[p : p+bytesPerLengthOffset])
+		if offset >= start && offset <= size && validate(last, buf[start:offset]) == nil {
+			end = offset
+			break
+		}
+	}
+
+	// the known fields with their offsets shifted to the end of the known fixed part
+	shift := uint64(len(fixedTail))
+	known := make([]byte, 0, fixed+end-offsets[0])
+	known = append(known, buf[:fixed]...)
+	pos = 0
+	for _, f := range s.Fields {
+		if f.Schema.IsFixed() {
+			pos += f.Schema.FixedSize()
+			continue
+		}
+		offset := ReadOffset(buf[pos:pos+bytesPerLengthOffset]) - shift
+		binary.LittleEndian.PutUint32(known[pos:], uint32(offset))
+		pos += bytesPerLengthOffset
+	}
+	known = append(known, buf[offsets[0]:end]...)
+
+	tail := make([]byte, 0, shift+size-end)
+	tail = append(tail, fixedTail...)
+	tail = append(tail, buf[end:]...)
+	return known, tail, nil
+}
+
+// UnmarshalForward unmarshals the known fields of the encoding of a newer version of the type of obj
+// (i.e. a container of the next fork with more fields at the end) and returns the tail of the unknown
+// fields (SplitUnknownFields), so that the services parse the fields they know during a rollout.
+// obj must implement SchemaProvider, UnmarshalSSZ rejects the encodings with unknown fields.
+func UnmarshalForward(obj Unmarshaler, buf []byte) ([]byte, error) {
+	s, ok := obj.(SchemaProvider)
+	if !ok {
+		return nil, ErrNotSupported
+	}
+	known, tail, err := SplitUnknownFields(s.SchemaSSZ(), buf)
+	if err != nil {
+		return nil, err
+	}
+	if err := TraceUnmarshal(obj, known); err != nil {
+		return nil, err
+	}
+	return tail, nil
+}
+
+// validate checks the encoding of a value with the walk of the hasher without hashing
+func validate(s *Schema, buf []byte) error {
+	h := &streamHasher{r: bytes.NewReader(buf), p: defaultProfile}
+	_, err := h.hashValue(s, uint64(len(buf)))
+	return err
+}
@@ -0,0 +1,106 @@
+package fuzz
+
+import (
+	"bytes"
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+	goSSZ "github.com/prysmaticlabs/go-ssz"
+)
+
+// Reference is an alternative SSZ implementation used to check the generated code
+type Reference interface {
+	Marshal(obj interface{}) ([]byte, error)
+	Unmarshal(buf []byte, obj interface{}) error
+	HashTreeRoot(obj interface{}) ([32]byte, error)
+}
+
+// GoSSZ is the reflection based implementation of github.com/prysmaticlabs/go-ssz
+var GoSSZ Reference = &goSSZRef{}
+
+type goSSZRef struct{}
+
+func (g *goSSZRef) Marshal(obj interface{}) ([]byte, error) {
+	return goSSZ.Marshal(obj)
+}
+
+func (g *goSSZRef) Unmarshal(buf []byte, obj interface{}) error {
+	return goSSZ.Unmarshal(buf, obj)
+}
+
+func (g *goSSZRef) HashTreeRoot(obj interface{}) ([32]byte, error) {
+	return goSSZ.HashTreeRoot(obj)
+}
+
+// Divergence is the error returned when the generated code and the reference disagree on an input
+type Divergence struct {
+	Reason string
+	Input  []byte
+}
+
+func (d *Divergence) Error() string {
+	return fmt.Sprintf("divergence: %s (input 0x%x)", d.Reason, d.Input)
+}
+
+// Differential decodes buf with the generated code of the object returned by newObj and
+// with the reference implementation. It returns a *Divergence if only one of them accepts
+// the input or if the decoded objects have different encodings or roots.
+func Differential(ref Reference, newObj func() ssz.MarshalUnmarshaler, buf []byte) error {
+	fast, refObj := newObj(), newObj()
+
+	fastErr := fast.UnmarshalSSZ(buf)
+	refErr := safeCall(func() error {
+		return ref.Unmarshal(buf, refObj)
+	})
+	if (fastErr == nil) != (refErr == nil) {
+		return &Divergence{
+			Reason: fmt.Sprintf("unmarshal: fastssz error '%v', reference error '%v'", fastErr, refErr),
+			Input:  buf,
+		}
+	}
+	if fastErr != nil {
+		// both rejected the input
+		return nil
+	}
+
+	fastBuf, err := fast.MarshalSSZ()
+	if err != nil {
+		return &Divergence{Reason: fmt.Sprintf("fastssz failed to marshal the decoded object: %v", err), Input: buf}
+	}
+	var refBuf []byte
+	if err := safeCall(func() (err error) {
+		refBuf, err = ref.Marshal(refObj)
+		return err
+	}); err != nil {
+		return &Divergence{Reason: fmt.Sprintf("reference failed to marshal the decoded object: %v", err), Input: buf}
+	}
+	if !bytes.Equal(fastBuf, refBuf) {
+		return &Divergence{Reason: fmt.Sprintf("marshal: fastssz 0x%x, reference 0x%x", fastBuf, refBuf), Input: buf}
+	}
+
+	var fastRoot, refRoot [32]byte
+	if err := safeCall(func() (err error) {
+		if fastRoot, err = ref.HashTreeRoot(fast); err != nil {
+			return err
+		}
+		refRoot, err = ref.HashTreeRoot(refObj)
+		return err
+	}); err != nil {
+		return &Divergence{Reason: fmt.Sprintf("failed to compute the roots: %v", err), Input: buf}
+	}
+	if fastRoot != refRoot {
+		return &Divergence{Reason: fmt.Sprintf("root: fastssz 0x%x, reference 0x%x", fastRoot, refRoot), Input: buf}
+	}
+	return nil
+}
+
+// safeCall runs f and converts a panic into an error since the
+// reference implementations might panic with malformed inputs.
+func safeCall(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return f()
+}
@@ -56,8 +56,8 @@ type fuzzerContext struct {
 	failed bool
 }
 
-func convertNum(str string) int {
-	num, err := strconv.Atoi(str)
+func convertNum(str string) uint64 {
+	num, err := strconv.ParseUint(str, 10, 64)
 	if err != nil {
 		panic(err)
 	}
@@ -69,11 +69,12 @@ func (f *Fuzzer) getShoudlFail() bool {
 }
 
 func (fc *fuzzerContext) getRandomNum(maxStr string, isMax bool) int {
-	max := convertNum(maxStr)
-	if max > 5000 {
+	limit := convertNum(maxStr)
+	if limit > 5000 {
 		// hard cap for long values in Beacon state
 		return 1000
 	}
+	max := int(limit)
 	if !fc.failed {
 		if fc.fuzzer.getShoudlFail() {
 			fc.failed = true
@@ -131,6 +132,40 @@ func (fc *fuzzerContext) genElementCount(tag reflect.StructTag) (reflect.StructT
 	panic("BUG: Tags not expected")
 }
 
+// genBitlist returns a valid bitlist with a random number of bits up to the ssz-max tag
+func (fc *fuzzerContext) genBitlist(tag reflect.StructTag) []byte {
+	max := uint64(64)
+	if maxStr := tag.Get("ssz-max"); maxStr != "" {
+		max = convertNum(maxStr)
+	}
+	if max > 5000 {
+		// hard cap for long values
+		max = 1000
+	}
+	numBits := fc.fuzzer.r.Intn(int(max) + 1)
+
+	buf := make([]byte, numBits/8+1)
+	fc.fuzzer.r.Read(buf)
+
+	// clear the bits after the last one and set the length bit
+	last := numBits % 8
+	buf[len(buf)-1] &= byte(1<<uint(last)) - 1
+	buf[len(buf)-1] |= byte(1 << uint(last))
+	return buf
+}
+
+// bitvectorLen returns the number of bits of a go-bitfield bitvector type (i.e. Bitvector4)
+func bitvectorLen(name string) (int, bool) {
+	if !strings.HasPrefix(name, "Bitvector") {
+		return 0, false
+	}
+	num, err := strconv.Atoi(strings.TrimPrefix(name, "Bitvector"))
+	if err != nil || num <= 0 {
+		return 0, false
+	}
+	return num, true
+}
+
 func (fc *fuzzerContext) doFuzz(v reflect.Value, tag reflect.StructTag) {
 	if !v.CanSet() {
 		return
@@ -152,11 +187,22 @@ func (fc *fuzzerContext) doFuzz(v reflect.Value, tag reflect.StructTag) {
 		return
 
 	case reflect.Slice:
+		if tag.Get("ssz") == "bitlist" {
+			v.Set(reflect.ValueOf(fc.genBitlist(tag)).Convert(v.Type()))
+			return
+		}
 		subTag, n := fc.genElementCount(tag)
 		v.Set(reflect.MakeSlice(v.Type(), n, n))
 		for i := 0; i < n; i++ {
 			fc.doFuzz(v.Index(i), subTag)
 		}
+		if bitLen, ok := bitvectorLen(v.Type().Name()); ok && n != 0 {
+			// clear the padding bits of go-bitfield/BitvectorN
+			if rem := bitLen % 8; rem != 0 {
+				last := v.Index(n - 1)
+				last.SetUint(last.Uint() & (1<<uint(rem) - 1))
+			}
+		}
 
 	case reflect.Struct:
 		typ := v.Type()
@@ -0,0 +1,31 @@
+//go:build go1.18
+// +build go1.18
+
+package ssz
+
+// The generic functions are the entry points for the generated types with Go 1.18 or later.
+// They check the methods at compile time, unlike Marshal, Unmarshal and HashTreeRoot which
+// keep the signatures of go-ssz, and use the pooled hashers of DefaultHasherPool.
+
+// HashTreeRootOf returns the hash tree root of the object with a Hasher of DefaultHasherPool
+func HashTreeRootOf[T HashRoot](obj T) ([32]byte, error) {
+	return HashWithDefaultHasher(obj)
+}
+
+// MarshalOf returns the encoding of the object in a buffer of its size, so that it is
+// allocated once. MarshalToWriter encodes it with a pooled buffer instead.
+func MarshalOf[T Marshaler](obj T) ([]byte, error) {
+	return obj.MarshalSSZTo(make([]byte, 0, obj.SizeSSZ()))
+}
+
+// UnmarshalOf decodes the encoding into a new object of the type (i.e. UnmarshalOf[BeaconBlock](buf))
+func UnmarshalOf[T any, P interface {
+	*T
+	Unmarshaler
+}](buf []byte) (*T, error) {
+	obj := P(new(T))
+	if err := TraceUnmarshal(obj, buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
@@ -0,0 +1,152 @@
+package ssz
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// ErrGindexDiverges is returned when a generalized index has no equivalent in the other version of a type
+var ErrGindexDiverges = fmt.Errorf("generalized index diverges between the versions")
+
+// GindexMapping is the generalized index of a field in two versions of a container
+type GindexMapping struct {
+	// Path of the field (i.e. body.execution_payload.withdrawals)
+	Path string
+	// From and To are the generalized indices of the field in each version, zero if the field is not in the version
+	From, To uint64
+	// Same is set if the field has the same type in both versions, then the
+	// generalized indices of its subtree change like the one of the field
+	Same bool
+}
+
+// Stable returns true if the field and its subtree have the same generalized indices in both versions
+func (m GindexMapping) Stable() bool {
+	return m.Same && m.From == m.To
+}
+
+// MapGindices returns the generalized indices of the fields of two versions of a container (i.e. the
+// states of two forks), matched by name, and of the nested fields of the containers that change.
+// The fields of from come first in order, followed by the ones that are only in to.
+func MapGindices(from, to *Schema) []GindexMapping {
+	return mapFields(from, to, 1, 1, "")
+}
+
+func mapFields(from, to *Schema, fromGindex, toGindex uint64, prefix string) []GindexMapping {
+	res := []GindexMapping{}
+	for indx, f := range from.Fields {
+		m := GindexMapping{Path: prefix + f.Name, From: fromGindex<<from.depth() | uint64(indx)}
+		j, ok := to.FieldIndex(f.Name)
+		if !ok {
+			res = append(res, m)
+			continue
+		}
+		other := to.Fields[j].Schema
+		m.To = toGindex<<to.depth() | uint64(j)
+		m.Same = f.Schema.Equal(other)
+		res = append(res, m)
+		if !m.Same && f.Schema.Kind == KindContainer && other.Kind == KindContainer {
+			res = append(res, mapFields(f.Schema, other, m.From, m.To, m.Path+".")...)
+		}
+	}
+	for indx, f := range to.Fields {
+		if _, ok := from.FieldIndex(f.Name); !ok {
+			res = append(res, GindexMapping{Path: prefix + f.Name, To: toGindex<<to.depth() | uint64(indx)})
+		}
+	}
+	return res
+}
+
+// MapGindex returns the generalized index in the to version of a type of the node at gindex in the from
+// version. The fields are matched by name and the elements of the vectors and lists by index. It returns
+// ErrGindexDiverges if the node is not in to: a removed field, an element beyond the limit of to or an inner
+// node of a tree that changes (i.e. the branches of the fields of a container with more fields in to).
+// The node of a value whose type changes maps to the node of the value in to, even if its root differs.
+func MapGindex(from, to *Schema, gindex uint64) (uint64, error) {
+	if gindex == 0 {
+		return 0, ErrInvalidGindex
+	}
+	depth := uint8(bits.Len64(gindex) - 1)
+	// next returns the next steps of the path from the root to the node
+	next := func(steps uint8) uint64 {
+		depth -= steps
+		return gindex >> depth & (1<<steps - 1)
+	}
+
+	res := uint64(1)
+	for depth != 0 {
+		if from.Equal(to) {
+			return childGindex(res, depth, next(depth))
+		}
+		var indx uint64
+		var err error
+		switch {
+		case from.Kind == KindContainer && to.Kind == KindContainer:
+			if depth < from.depth() {
+				return 0, ErrGindexDiverges
+			}
+			if indx = next(from.depth()); indx >= uint64(len(from.Fields)) {
+				return 0, ErrGindexDiverges
+			}
+			f := from.Fields[indx]
+			j, ok := to.FieldIndex(f.Name)
+			if !ok {
+				return 0, ErrGindexDiverges
+			}
+			if res, err = childGindex(res, to.depth(), uint64(j)); err != nil {
+				return 0, err
+			}
+			from, to = f.Schema, to.Fields[j].Schema
+
+		case sameElems(from, to):
+			if from.hasMixin() {
+				if next(1) == 1 {
+					// the length is a leaf
+					if depth != 0 {
+						return 0, ErrInvalidGindex
+					}
+					return childGindex(res, 1, 1)
+				}
+				res <<= 1
+			}
+			if depth < from.depth() {
+				return 0, ErrGindexDiverges
+			}
+			if indx = next(from.depth()); indx >= to.ChunkCount() {
+				return 0, ErrGindexDiverges
+			}
+			if res, err = childGindex(res, to.depth(), indx); err != nil {
+				return 0, err
+			}
+			if (from.Kind != KindVector && from.Kind != KindList) || from.isPacked() {
+				// the chunks are leaves
+				if depth != 0 {
+					return 0, ErrInvalidGindex
+				}
+				return res, nil
+			}
+			from, to = from.Elem, to.Elem
+
+		default:
+			return 0, ErrGindexDiverges
+		}
+	}
+	return res, nil
+}
+
+// sameElems returns true if the chunks of the contents of both sequences hold the same elements, only the size
+// or the limit changes. The elements of the vectors and lists of composite types are matched even if they change.
+func sameElems(from, to *Schema) bool {
+	if from.Kind != to.Kind || from.Progressive || to.Progressive {
+		return false
+	}
+	switch from.Kind {
+	case KindByteVector, KindByteList, KindBitVector, KindBitList:
+		return true
+	case KindVector, KindList:
+		if from.isPacked() || to.isPacked() {
+			return from.Elem.Equal(to.Elem)
+		}
+		return true
+	}
+	return false
+}
@@ -12,7 +12,7 @@ require (
 	github.com/google/gofuzz v1.1.0
 	github.com/grpc-ecosystem/grpc-gateway v1.13.0
 	github.com/minio/highwayhash v1.0.0 // indirect
-	github.com/minio/sha256-simd v0.1.1 // indirect
+	github.com/minio/sha256-simd v0.1.1
 	github.com/pkg/errors v0.9.1 // indirect
 	github.com/protolambda/zssz v0.1.3
 	github.com/prysmaticlabs/go-bitfield v0.0.0-20191017011753-53b773adde52
@@ -0,0 +1,365 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"math/bits"
+	"sync"
+)
+
+var (
+	// ErrBytesLength is returned when a fixed bytes value does not have the expected size
+	ErrBytesLength = fmt.Errorf("incorrect bytes length")
+	// ErrVectorLength is returned when a vector does not have the expected number of elements
+	ErrVectorLength = fmt.Errorf("incorrect vector length")
+)
+
+var zeroBytes = make([]byte, 32)
+
+// zeroHashes are the roots of the trees of depth i with all the leaves set to zero
+var zeroHashes [65][32]byte
+
+func init() {
+	tmp := make([]byte, 64)
+	for i := 0; i < 64; i++ {
+		copy(tmp[:32], zeroHashes[i][:])
+		copy(tmp[32:], zeroHashes[i][:])
+		zeroHashes[i+1] = sum256(tmp)
+	}
+}
+
+// HashRoot is the interface implemented by types that can compute their SSZ hash tree root
+type HashRoot interface {
+	HashTreeRoot() ([32]byte, error)
+	HashTreeRootWith(hh *Hasher) error
+}
+
+// HashFunc is a HashRoot with the function that appends the root of an object to
+// a Hasher (i.e. the HashTreeRootWithAltair method of a type with fork fields)
+type HashFunc func(hh *Hasher) error
+
+// HashTreeRootWith implements the HashRoot interface
+func (f HashFunc) HashTreeRootWith(hh *Hasher) error {
+	return f(hh)
+}
+
+// HashTreeRoot implements the HashRoot interface
+func (f HashFunc) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(f)
+}
+
+// DefaultMaxRetained is the size in bytes of the buffers that the Hashers of the DefaultHasherPool
+// keep between the calls, enough for the states of the beacon chain
+const DefaultMaxRetained = 64 << 20
+
+// HasherPool is a pool of Hashers to reuse their buffers between the calls to HashTreeRoot
+type HasherPool struct {
+	// MaxRetained is the size in bytes of the buffers of the Hashers kept by the pool, the
+	// Hashers that grow beyond it (i.e. after hashing a large state) are released. Zero for no cap.
+	MaxRetained int
+
+	pool sync.Pool
+}
+
+// Get acquires a Hasher from the pool
+func (hh *HasherPool) Get() *Hasher {
+	h := hh.pool.Get()
+	if h == nil {
+		return NewHasher()
+	}
+	return h.(*Hasher)
+}
+
+// Put releases the Hasher to the pool
+func (hh *HasherPool) Put(h *Hasher) {
+	h.Reset()
+	if hh.MaxRetained != 0 && h.Retained() > hh.MaxRetained {
+		return
+	}
+	hh.pool.Put(h)
+}
+
+// DefaultHasherPool is the pool used by the generated HashTreeRoot functions
+var DefaultHasherPool = HasherPool{MaxRetained: DefaultMaxRetained}
+
+// HashWithDefaultHasher computes the root of the object with a Hasher from the DefaultHasherPool
+func HashWithDefaultHasher(v HashRoot) ([32]byte, error) {
+	hh := DefaultHasherPool.Get()
+	defer DefaultHasherPool.Put(hh)
+	return hashWith(hh, v)
+}
+
+// HashWithNewHasher computes the root of the object with a new Hasher
+func HashWithNewHasher(v HashRoot) ([32]byte, error) {
+	return hashWith(NewHasher(), v)
+}
+
+func hashWith(hh *Hasher, v HashRoot) ([32]byte, error) {
+	if err := v.HashTreeRootWith(hh); err != nil {
+		return [32]byte{}, err
+	}
+	return hh.HashRoot()
+}
+
+// Hasher computes the hash tree root of the objects. The roots of the fields are
+// appended to the buffer as 32 bytes chunks and merkleized when the object is done.
+type Hasher struct {
+	// buffer with the chunks
+	buf []byte
+	// tmp buffer for the basic types and the bitlists
+	tmp  []byte
+	hash hash.Hash
+	// maxRetained is the size in bytes of the buffers kept by Reset, zero for no cap
+	maxRetained int
+}
+
+// NewHasher creates a new Hasher
+func NewHasher() *Hasher {
+	return &Hasher{
+		tmp:  make([]byte, 32),
+		hash: newHash(),
+	}
+}
+
+// Reset resets the Hasher so that it can be used with another object. The buffers
+// are released if they are larger than the cap of SetMaxRetained.
+func (h *Hasher) Reset() {
+	if h.maxRetained != 0 && h.Retained() > h.maxRetained {
+		h.buf, h.tmp = nil, make([]byte, 32)
+	}
+	h.buf = h.buf[:0]
+	h.tmp = h.tmp[:32]
+	h.hash.Reset()
+}
+
+// SetMaxRetained sets the size in bytes of the buffers kept by Reset, zero for no cap.
+// The buffers still grow to the size of the object while it is hashed.
+func (h *Hasher) SetMaxRetained(size int) {
+	h.maxRetained = size
+}
+
+// Retained returns the size in bytes of the buffers of the Hasher
+func (h *Hasher) Retained() int {
+	return cap(h.buf) + cap(h.tmp)
+}
+
+// Index returns the position in the buffer where the next chunk starts
+func (h *Hasher) Index() int {
+	return len(h.buf)
+}
+
+// HashRoot returns the root once all the values have been merkleized
+func (h *Hasher) HashRoot() (res [32]byte, err error) {
+	if len(h.buf) != 32 {
+		err = fmt.Errorf("expected 32 bytes in the hasher but found %d", len(h.buf))
+		return
+	}
+	copy(res[:], h.buf)
+	return
+}
+
+func (h *Hasher) appendBytes32(b []byte) {
+	h.buf = append(h.buf, b...)
+	h.FillUpTo32()
+}
+
+// PutUint64 appends an uint64 chunk
+func (h *Hasher) PutUint64(i uint64) {
+	binary.LittleEndian.PutUint64(h.tmp[:8], i)
+	h.appendBytes32(h.tmp[:8])
+}
+
+// PutUint32 appends an uint32 chunk
+func (h *Hasher) PutUint32(i uint32) {
+	binary.LittleEndian.PutUint32(h.tmp[:4], i)
+	h.appendBytes32(h.tmp[:4])
+}
+
+// PutUint16 appends an uint16 chunk
+func (h *Hasher) PutUint16(i uint16) {
+	binary.LittleEndian.PutUint16(h.tmp[:2], i)
+	h.appendBytes32(h.tmp[:2])
+}
+
+// PutUint8 appends an uint8 chunk
+func (h *Hasher) PutUint8(i uint8) {
+	h.tmp = h.tmp[:1]
+	h.tmp[0] = i
+	h.appendBytes32(h.tmp)
+}
+
+// PutBool appends a bool chunk
+func (h *Hasher) PutBool(b bool) {
+	h.tmp = h.tmp[:1]
+	if b {
+		h.tmp[0] = 1
+	} else {
+		h.tmp[0] = 0
+	}
+	h.appendBytes32(h.tmp)
+}
+
+// PutBytes appends the root of fixed bytes. The bytes up to 32 are a single chunk.
+func (h *Hasher) PutBytes(b []byte) {
+	if len(b) <= 32 {
+		h.appendBytes32(b)
+		return
+	}
+	indx := h.Index()
+	h.appendBytes32(b)
+	h.Merkleize(indx)
+}
+
+// PutBitlist appends the root of a bitlist with at most maxSize bits
+func (h *Hasher) PutBitlist(bb []byte, maxSize uint64) {
+	var size uint64
+	h.tmp, size = parseBitlist(h.tmp[:0], bb)
+
+	indx := h.Index()
+	h.appendBytes32(h.tmp)
+	h.MerkleizeWithMixin(indx, size, (maxSize+255)/256)
+}
+
+// parseBitlist appends to dst the bits of the bitlist without the length bit
+// and the trailing zero bytes. It returns the number of bits of the bitlist.
+func parseBitlist(dst, buf []byte) ([]byte, uint64) {
+	if len(buf) == 0 {
+		return dst, 0
+	}
+	msb := uint8(bits.Len8(buf[len(buf)-1]))
+	if msb == 0 {
+		// no length bit, the bitlist is not valid
+		msb = 1
+	}
+	msb--
+	size := uint64(8*(len(buf)-1) + int(msb))
+
+	dst = append(dst, buf...)
+	dst[len(dst)-1] &^= uint8(1 << msb)
+
+	newLen := len(dst)
+	for i := len(dst) - 1; i >= 0; i-- {
+		if dst[i] != 0x00 {
+			break
+		}
+		newLen = i
+	}
+	return dst[:newLen], size
+}
+
+// Append appends the bytes without padding, FillUpTo32 has to be called after them
+func (h *Hasher) Append(b []byte) {
+	h.buf = append(h.buf, b...)
+}
+
+// AppendUint64 appends an uint64 that is packed with the next basic values
+func (h *Hasher) AppendUint64(i uint64) {
+	h.buf = MarshalUint64(h.buf, i)
+}
+
+// AppendUint32 appends an uint32 that is packed with the next basic values
+func (h *Hasher) AppendUint32(i uint32) {
+	h.buf = MarshalUint32(h.buf, i)
+}
+
+// AppendUint16 appends an uint16 that is packed with the next basic values
+func (h *Hasher) AppendUint16(i uint16) {
+	h.buf = MarshalUint16(h.buf, i)
+}
+
+// AppendUint8 appends an uint8 that is packed with the next basic values
+func (h *Hasher) AppendUint8(i uint8) {
+	h.buf = MarshalUint8(h.buf, i)
+}
+
+// AppendBool appends a bool that is packed with the next basic values
+func (h *Hasher) AppendBool(b bool) {
+	h.buf = MarshalBool(h.buf, b)
+}
+
+// FillUpTo32 pads the buffer with zeros to a multiple of 32 bytes
+func (h *Hasher) FillUpTo32() {
+	if rest := len(h.buf) % 32; rest != 0 {
+		h.buf = append(h.buf, zeroBytes[:32-rest]...)
+	}
+}
+
+// Merkleize replaces the chunks after indx with their root
+func (h *Hasher) Merkleize(indx int) {
+	input := h.buf[indx:]
+	input = h.merkleizeImpl(input[:0], input, 0)
+	h.buf = append(h.buf[:indx], input...)
+}
+
+// MerkleizeWithMixin replaces the chunks after indx with the root of a list
+// with num elements and the limit of chunks.
+func (h *Hasher) MerkleizeWithMixin(indx int, num, limit uint64) {
+	input := h.buf[indx:]
+	input = h.merkleizeImpl(input[:0], input, limit)
+
+	// mix in the length
+	output := h.tmp[:32]
+	copy(output, zeroBytes)
+	binary.LittleEndian.PutUint64(output[:8], num)
+	input = h.doHash(input, input[:32], output)
+
+	h.buf = append(h.buf[:indx], input...)
+}
+
+func (h *Hasher) doHash(dst []byte, a []byte, b []byte) []byte {
+	h.hash.Write(a)
+	h.hash.Write(b)
+	dst = h.hash.Sum(dst[:0])
+	h.hash.Reset()
+	return dst
+}
+
+// merkleizeImpl appends to dst the root of the tree with the input chunks as leaves. The
+// tree has enough leaves for limit chunks, if limit is 0 the number of chunks is used.
+func (h *Hasher) merkleizeImpl(dst []byte, input []byte, limit uint64) []byte {
+	count := uint64(len(input) / 32)
+	if limit == 0 {
+		limit = count
+	} else if count > limit {
+		panic(fmt.Sprintf("BUG: count '%d' higher than limit '%d'", count, limit))
+	}
+
+	if limit == 0 {
+		return append(dst, zeroBytes...)
+	}
+	if limit == 1 {
+		if count == 1 {
+			return append(dst, input[:32]...)
+		}
+		return append(dst, zeroBytes...)
+	}
+
+	depth := getDepth(limit)
+	if len(input) == 0 {
+		return append(dst, zeroHashes[depth][:]...)
+	}
+
+	for i := uint8(0); i < depth; i++ {
+		layerLen := len(input) / 32
+		if layerLen%2 == 1 {
+			input = append(input, zeroHashes[i][:]...)
+			layerLen++
+		}
+
+		// the parents are written over the first half of the layer
+		for j := 0; j < layerLen/2; j++ {
+			h.doHash(input[j*32:j*32], input[j*64:j*64+32], input[j*64+32:j*64+64])
+		}
+		input = input[:layerLen/2*32]
+	}
+	return append(dst, input...)
+}
+
+// getDepth returns the depth of the tree with d leaves
+func getDepth(d uint64) uint8 {
+	if d <= 1 {
+		return 0
+	}
+	return uint8(bits.Len64(d - 1))
+}
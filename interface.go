@@ -11,3 +11,9 @@ type Marshaler interface {
 type Unmarshaler interface {
 	UnmarshalSSZ(buf []byte) error
 }
+
+// MarshalUnmarshaler is the interface implemented by types that can marshal and unmarshal themselves
+type MarshalUnmarshaler interface {
+	Marshaler
+	Unmarshaler
+}
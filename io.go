@@ -0,0 +1,72 @@
+package ssz
+
+import (
+	"io"
+	"sync"
+)
+
+// maxPooledBuffer is the capacity above which the buffers are not returned to the pool,
+// so that the encoding of a single big state is not held in memory afterwards
+const maxPooledBuffer = 16 * 1024 * 1024
+
+// bufferPool holds the buffers used to marshal to a writer and to unmarshal from a reader
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 1024)
+		return &buf
+	},
+}
+
+func getBuffer(size uint64) *[]byte {
+	buf := bufferPool.Get().(*[]byte)
+	if uint64(cap(*buf)) < size {
+		*buf = make([]byte, 0, size)
+	}
+	return buf
+}
+
+func putBuffer(buf *[]byte) {
+	if cap(*buf) > maxPooledBuffer {
+		return
+	}
+	*buf = (*buf)[:0]
+	bufferPool.Put(buf)
+}
+
+// MarshalToWriter writes the encoding of the object to the writer with a pooled buffer
+func MarshalToWriter(w io.Writer, obj Marshaler) error {
+	buf := getBuffer(uint64(obj.SizeSSZ()))
+	defer putBuffer(buf)
+
+	dst, err := obj.MarshalSSZTo((*buf)[:0])
+	if err != nil {
+		return err
+	}
+	*buf = dst
+	_, err = w.Write(dst)
+	return err
+}
+
+// UnmarshalFromReader reads the next size bytes of the reader into a pooled buffer and decodes
+// them into the object, which must not keep references to the buffer (the generated code copies
+// the bytes). If the object implements SchemaProvider, a size that is not valid for its schema
+// returns ErrSize before the input is read.
+func UnmarshalFromReader(r io.Reader, obj Unmarshaler, size uint64) error {
+	if s, ok := obj.(SchemaProvider); ok {
+		schema := s.SchemaSSZ()
+		if size < schema.MinSize() || size > schema.MaxSize() {
+			return ErrSize
+		}
+	}
+	buf := getBuffer(size)
+	defer putBuffer(buf)
+
+	*buf = (*buf)[:size]
+	if _, err := io.ReadFull(r, *buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return TraceUnmarshal(obj, *buf)
+}
@@ -0,0 +1,264 @@
+package ssz
+
+import (
+	"fmt"
+	"io"
+)
+
+// LazyValue is a value of a schema stored in a SSZ encoding that is read on demand
+// (i.e. an *os.File or a memory mapped file). Only the offsets on the path to the fields
+// and elements are read, then huge values can be inspected without loading them in memory.
+// The encoding is not validated beyond the offsets and the sizes that are read.
+type LazyValue struct {
+	schema *Schema
+	r      io.ReaderAt
+	// offset and size of the encoding of the value in the source
+	offset uint64
+	size   uint64
+}
+
+// NewLazyValue returns the value of the schema encoded in the size bytes of the source
+func NewLazyValue(s *Schema, r io.ReaderAt, size int64) *LazyValue {
+	return &LazyValue{schema: s, r: r, size: uint64(size)}
+}
+
+// Schema returns the schema of the value
+func (l *LazyValue) Schema() *Schema {
+	return l.schema
+}
+
+// Size returns the size of the encoding of the value
+func (l *LazyValue) Size() uint64 {
+	return l.size
+}
+
+func (l *LazyValue) read(pos, size uint64) ([]byte, error) {
+	if pos+size > l.size {
+		return nil, ErrSize
+	}
+	buf := make([]byte, size)
+	if _, err := l.r.ReadAt(buf, int64(l.offset+pos)); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (l *LazyValue) readOffset(pos uint64) (uint64, error) {
+	buf, err := l.read(pos, bytesPerLengthOffset)
+	if err != nil {
+		return 0, err
+	}
+	return ReadOffset(buf), nil
+}
+
+func (l *LazyValue) section(s *Schema, pos, size uint64) *LazyValue {
+	return &LazyValue{schema: s, r: l.r, offset: l.offset + pos, size: size}
+}
+
+// dynamicSection returns the section of the dynamic part that starts at the offset
+// stored at pos. It ends at the offset stored at next or at the end of the value.
+func (l *LazyValue) dynamicSection(s *Schema, pos, next uint64, last bool) (*LazyValue, error) {
+	start, err := l.readOffset(pos)
+	if err != nil {
+		return nil, err
+	}
+	end := l.size
+	if !last {
+		if end, err = l.readOffset(next); err != nil {
+			return nil, err
+		}
+	}
+	if start > end || end > l.size {
+		return nil, ErrOffset
+	}
+	return l.section(s, start, end-start), nil
+}
+
+// Field returns the value of a field of a container
+func (l *LazyValue) Field(name string) (*LazyValue, error) {
+	if l.schema.Kind != KindContainer {
+		return nil, fmt.Errorf("field %s of a %s", name, l.schema.Kind)
+	}
+	indx, ok := l.schema.FieldIndex(name)
+	if !ok {
+		return nil, fmt.Errorf("field %s not found in %s", name, l.schema.Name)
+	}
+	pos := uint64(0)
+	for _, f := range l.schema.Fields[:indx] {
+		pos += f.Schema.FixedSize()
+	}
+	field := l.schema.Fields[indx].Schema
+	if field.IsFixed() {
+		if pos+field.FixedSize() > l.size {
+			return nil, ErrSize
+		}
+		return l.section(field, pos, field.FixedSize()), nil
+	}
+
+	// the dynamic part of the field ends where the one of the next dynamic field starts
+	next, last := pos+bytesPerLengthOffset, true
+	for _, f := range l.schema.Fields[indx+1:] {
+		if !f.Schema.IsFixed() {
+			last = false
+			break
+		}
+		next += f.Schema.FixedSize()
+	}
+	return l.dynamicSection(field, pos, next, last)
+}
+
+// Len returns the number of elements of a vector or a list, the bytes of
+// a byte vector or a byte list and the bits of a bitvector or a bitlist
+func (l *LazyValue) Len() (uint64, error) {
+	switch l.schema.Kind {
+	case KindVector, KindByteVector, KindBitVector:
+		return l.schema.Size, nil
+	case KindByteList:
+		return l.size, nil
+	case KindBitList:
+		if l.size == 0 {
+			return 0, ErrSize
+		}
+		buf, err := l.read(l.size-1, 1)
+		if err != nil {
+			return 0, err
+		}
+		if buf[0] == 0 {
+			return 0, ErrSize
+		}
+		_, size := parseBitlist(nil, buf)
+		return 8*(l.size-1) + size, nil
+	case KindList:
+		if l.schema.Elem.IsFixed() {
+			return l.size / l.schema.Elem.FixedSize(), nil
+		}
+		if l.size == 0 {
+			return 0, nil
+		}
+		first, err := l.readOffset(0)
+		if err != nil {
+			return 0, err
+		}
+		if first%bytesPerLengthOffset != 0 || first > l.size {
+			return 0, ErrOffset
+		}
+		return first / bytesPerLengthOffset, nil
+	default:
+		return 0, fmt.Errorf("length of a %s", l.schema.Kind)
+	}
+}
+
+// Index returns the value of an element of a vector or a list
+func (l *LazyValue) Index(i uint64) (*LazyValue, error) {
+	if l.schema.Kind != KindVector && l.schema.Kind != KindList {
+		return nil, fmt.Errorf("index of a %s", l.schema.Kind)
+	}
+	size, err := l.Len()
+	if err != nil {
+		return nil, err
+	}
+	if i >= size {
+		return nil, ErrIndexOutOfRange
+	}
+	elem := l.schema.Elem
+	if elem.IsFixed() {
+		elemSize := elem.FixedSize()
+		if (i+1)*elemSize > l.size {
+			return nil, ErrSize
+		}
+		return l.section(elem, i*elemSize, elemSize), nil
+	}
+	pos := i * bytesPerLengthOffset
+	return l.dynamicSection(elem, pos, pos+bytesPerLengthOffset, i == size-1)
+}
+
+// Variant returns the selector of an union and the value of the selected option, which is nil for None
+func (l *LazyValue) Variant() (uint8, *LazyValue, error) {
+	if l.schema.Kind != KindUnion {
+		return 0, nil, fmt.Errorf("variant of a %s", l.schema.Kind)
+	}
+	buf, err := l.read(0, 1)
+	if err != nil {
+		return 0, nil, err
+	}
+	opt, err := l.schema.option(uint64(buf[0]))
+	if err != nil {
+		return 0, nil, err
+	}
+	if opt == nil {
+		if l.size != 1 {
+			return 0, nil, ErrSize
+		}
+		return 0, nil, nil
+	}
+	return buf[0], l.section(opt, 1, l.size-1), nil
+}
+
+// Path returns the value at the path (i.e. 'validators[12345].effective_balance')
+func (l *LazyValue) Path(path string) (*LazyValue, error) {
+	elems, err := parseSinglePath(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range elems {
+		if elem.IsIndex {
+			l, err = l.Index(elem.Index)
+		} else {
+			l, err = l.Field(elem.Field)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("path '%s': %v", path, err)
+		}
+	}
+	return l, nil
+}
+
+// Bytes returns the SSZ encoding of the value
+func (l *LazyValue) Bytes() ([]byte, error) {
+	return l.read(0, l.size)
+}
+
+// Uint returns the value of an uint
+func (l *LazyValue) Uint() (uint64, error) {
+	if l.schema.Kind != KindUint {
+		return 0, fmt.Errorf("uint value of a %s", l.schema.Kind)
+	}
+	buf, err := l.read(0, l.schema.Size)
+	if err != nil {
+		return 0, err
+	}
+	return uintValue(buf)
+}
+
+// Bool returns the value of a bool
+func (l *LazyValue) Bool() (bool, error) {
+	if l.schema.Kind != KindBool {
+		return false, fmt.Errorf("bool value of a %s", l.schema.Kind)
+	}
+	buf, err := l.read(0, 1)
+	if err != nil {
+		return false, err
+	}
+	if buf[0] > 1 {
+		return false, ErrInvalidBool
+	}
+	return buf[0] == 1, nil
+}
+
+// View reads the value into a view
+func (l *LazyValue) View() (*View, error) {
+	buf, err := l.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return NewViewFromSSZ(l.schema, buf)
+}
+
+// Decode reads the value into the object (i.e. a generated struct)
+func (l *LazyValue) Decode(obj Unmarshaler) error {
+	buf, err := l.Bytes()
+	if err != nil {
+		return err
+	}
+	return obj.UnmarshalSSZ(buf)
+}
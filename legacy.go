@@ -0,0 +1,69 @@
+package ssz
+
+import (
+	"fmt"
+)
+
+// ErrNotSupported is returned by the legacy functions when the value does not implement the fastssz interfaces
+var ErrNotSupported = fmt.Errorf("type does not implement the fastssz interfaces")
+
+// The functions below have the signatures of the reflection based API of prysmaticlabs/go-ssz
+// so that its callers can move to the generated code by only changing the import. They
+// dispatch to the generated methods and return ErrNotSupported for any other type.
+
+// Marshal returns the SSZ encoding of the value
+func Marshal(val interface{}) ([]byte, error) {
+	obj, ok := val.(Marshaler)
+	if !ok {
+		return nil, ErrNotSupported
+	}
+	return obj.MarshalSSZ()
+}
+
+// Unmarshal decodes the SSZ encoding into the value, which must be a pointer
+func Unmarshal(input []byte, val interface{}) error {
+	obj, ok := val.(Unmarshaler)
+	if !ok {
+		return ErrNotSupported
+	}
+	return TraceUnmarshal(obj, input)
+}
+
+// HashTreeRoot returns the hash tree root of the value
+func HashTreeRoot(val interface{}) ([32]byte, error) {
+	obj, ok := val.(HashRoot)
+	if !ok {
+		return [32]byte{}, ErrNotSupported
+	}
+	return obj.HashTreeRoot()
+}
+
+// SigningRoot returns the root of the container without its last field (i.e. the signature),
+// as computed by go-ssz. The value must also describe its schema (SchemaProvider).
+func SigningRoot(val interface{}) ([32]byte, error) {
+	obj, ok := val.(interface {
+		Marshaler
+		SchemaProvider
+	})
+	if !ok {
+		return [32]byte{}, ErrNotSupported
+	}
+	schema := obj.SchemaSSZ()
+	if schema.Kind != KindContainer || len(schema.Fields) == 0 {
+		return [32]byte{}, fmt.Errorf("signing root of a %s without fields", schema.Kind)
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	node, err := schema.nodeFromSSZ(buf)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	count := uint64(len(schema.Fields) - 1)
+	nodes, err := node.nodesAt(schema.depth(), count)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return treeFromNodes(nodes, getDepth(count)).Root(), nil
+}
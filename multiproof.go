@@ -0,0 +1,120 @@
+package ssz
+
+import "sort"
+
+// multiproofLimit is the maximum number of leaves and of hashes of a multiproof
+const multiproofLimit = 1 << 20
+
+// Multiproof is a Merkle proof of the nodes at several generalized indices, the
+// siblings shared by their branches are only included once (as in the consensus specs)
+type Multiproof struct {
+	Indices []uint64
+	Leaves  [][]byte
+	// Hashes are the roots of the helper nodes in the order of helperIndices
+	Hashes [][]byte
+}
+
+// helperIndices returns the generalized indices of the nodes that are needed to compute the root
+// from the nodes at the indices: the siblings of their paths that are not in any path, in descending order
+func helperIndices(indices []uint64) []uint64 {
+	paths := map[uint64]bool{}
+	for _, gindex := range indices {
+		for ; gindex > 1; gindex >>= 1 {
+			paths[gindex] = true
+		}
+	}
+	helpers := map[uint64]bool{}
+	for gindex := range paths {
+		if !paths[gindex^1] {
+			helpers[gindex^1] = true
+		}
+	}
+	res := make([]uint64, 0, len(helpers))
+	for gindex := range helpers {
+		res = append(res, gindex)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] > res[j]
+	})
+	return res
+}
+
+// ProveMulti returns the multiproof of the nodes at the generalized indices
+func (n *Node) ProveMulti(indices []uint64) (*Multiproof, error) {
+	if len(indices) > multiproofLimit {
+		return nil, ErrListTooBig
+	}
+	m := &Multiproof{Indices: append([]uint64{}, indices...)}
+	for _, gindex := range indices {
+		node, err := n.Get(gindex)
+		if err != nil {
+			return nil, err
+		}
+		root := node.Root()
+		m.Leaves = append(m.Leaves, root[:])
+	}
+	for _, gindex := range helperIndices(indices) {
+		node, err := n.Get(gindex)
+		if err != nil {
+			return nil, err
+		}
+		root := node.Root()
+		m.Hashes = append(m.Hashes, root[:])
+	}
+	return m, nil
+}
+
+// ProveMulti returns the multiproof of the values at the paths against the root of the tree of the view
+func (v *View) ProveMulti(paths ...string) (*Multiproof, error) {
+	indices := make([]uint64, 0, len(paths))
+	for _, path := range paths {
+		sub, err := v.Path(path)
+		if err != nil {
+			return nil, err
+		}
+		indices = append(indices, sub.gindex)
+	}
+	return v.tree.root.ProveMulti(indices)
+}
+
+// Root returns the root of the tree computed with the leaves and the hashes of the multiproof
+func (m *Multiproof) Root() ([32]byte, error) {
+	if len(m.Indices) == 0 || len(m.Leaves) != len(m.Indices) {
+		return [32]byte{}, ErrInvalidProof
+	}
+	helpers := helperIndices(m.Indices)
+	if len(m.Hashes) != len(helpers) {
+		return [32]byte{}, ErrInvalidProof
+	}
+	nodes := newProofNodes()
+	for indx, gindex := range m.Indices {
+		if gindex == 0 || len(m.Leaves[indx]) != 32 {
+			return [32]byte{}, ErrInvalidProof
+		}
+		if err := nodes.set(gindex, m.Leaves[indx]); err != nil {
+			return [32]byte{}, err
+		}
+		nodes.addPath(gindex)
+	}
+	for indx, gindex := range helpers {
+		if len(m.Hashes[indx]) != 32 {
+			return [32]byte{}, ErrInvalidProof
+		}
+		if err := nodes.set(gindex, m.Hashes[indx]); err != nil {
+			return [32]byte{}, err
+		}
+	}
+	return nodes.root()
+}
+
+// VerifyMultiproof checks that the multiproof matches the root
+func VerifyMultiproof(root [32]byte, m *Multiproof) error {
+	res, err := m.Root()
+	if err != nil {
+		return err
+	}
+	if res != root {
+		return ErrInvalidProof
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package ssz
+
+// UnmarshalerNoCopy is the interface of the types generated with the --no-copy option
+type UnmarshalerNoCopy interface {
+	UnmarshalSSZNoCopy(buf []byte) error
+}
+
+// UnmarshalNoCopy unmarshals obj with the byte fields (i.e. the roots and the signatures) that alias
+// the input instead of copying it. The input must not be modified while obj is in use, and obj keeps
+// the whole input in memory. The generated CopySSZ returns a copy of obj that does not share its memory.
+// UnmarshalSSZ reuses the byte fields of the object it decodes, so an object decoded by UnmarshalNoCopy
+// must not be decoded again (i.e. recycled by an ObjectPool) while its input is in use. The types
+// without UnmarshalSSZNoCopy are unmarshaled with UnmarshalSSZ.
+func UnmarshalNoCopy(obj Unmarshaler, buf []byte) error {
+	if n, ok := obj.(UnmarshalerNoCopy); ok {
+		return n.UnmarshalSSZNoCopy(buf)
+	}
+	return obj.UnmarshalSSZ(buf)
+}
+
+// Alias returns the bytes with their length as capacity, so that an append to a
+// field decoded by UnmarshalSSZNoCopy does not overwrite the rest of the input
+func Alias(buf []byte) []byte {
+	return buf[:len(buf):len(buf)]
+}
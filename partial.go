@@ -0,0 +1,137 @@
+package ssz
+
+import (
+	"fmt"
+)
+
+// ErrIncompleteProofs is returned when the proofs do not cover all the branches of the partial tree
+var ErrIncompleteProofs = fmt.Errorf("proofs do not cover the tree")
+
+// ProveValue returns the proofs of all the leaves of the value against the root of the tree
+// of the view it comes from. The subtrees with only zeros (i.e. the unused part of a list)
+// are proved as a single node.
+func (v *View) ProveValue() ([]*Proof, error) {
+	node, err := v.Node()
+	if err != nil {
+		return nil, err
+	}
+	gindexes := []uint64{}
+	var walk func(node *Node, gindex uint64) error
+	walk = func(node *Node, gindex uint64) error {
+		if _, ok := zeroDepth(node); ok || node.IsLeaf() {
+			gindexes = append(gindexes, gindex)
+			return nil
+		}
+		left, right, err := node.children()
+		if err != nil {
+			return err
+		}
+		if err := walk(left, gindex*2); err != nil {
+			return err
+		}
+		return walk(right, gindex*2+1)
+	}
+	if err := walk(node, v.gindex); err != nil {
+		return nil, err
+	}
+
+	proofs := make([]*Proof, len(gindexes))
+	for i, gindex := range gindexes {
+		if proofs[i], err = v.tree.root.Prove(gindex); err != nil {
+			return nil, err
+		}
+	}
+	return proofs, nil
+}
+
+// ProvePaths returns the proofs of all the leaves of the values at the paths
+func (v *View) ProvePaths(paths ...string) ([]*Proof, error) {
+	res := []*Proof{}
+	for _, path := range paths {
+		elem, err := v.Path(path)
+		if err != nil {
+			return nil, err
+		}
+		proofs, err := elem.ProveValue()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, proofs...)
+	}
+	return res, nil
+}
+
+// NewPartialTree returns the tree with the leaves and the hashes of the proofs. The
+// subtrees that are not covered by the proofs are leaves with their root, then any
+// access to them fails with ErrInvalidGindex.
+func NewPartialTree(proofs []*Proof) (*Node, error) {
+	if len(proofs) == 0 {
+		return nil, ErrIncompleteProofs
+	}
+	// roots of the nodes that are known and the branches on the paths to them
+	nodes := map[uint64][]byte{}
+	branches := map[uint64]bool{}
+
+	for _, p := range proofs {
+		if _, err := p.Root(); err != nil {
+			return nil, err
+		}
+		nodes[p.Index] = p.Leaf
+		gindex := p.Index
+		for _, h := range p.Hashes {
+			if _, ok := nodes[gindex^1]; !ok {
+				nodes[gindex^1] = h
+			}
+			gindex /= 2
+			branches[gindex] = true
+		}
+	}
+
+	var build func(gindex uint64) (*Node, error)
+	build = func(gindex uint64) (*Node, error) {
+		if branches[gindex] {
+			left, err := build(gindex * 2)
+			if err != nil {
+				return nil, err
+			}
+			right, err := build(gindex*2 + 1)
+			if err != nil {
+				return nil, err
+			}
+			return BranchNode(left, right), nil
+		}
+		root, ok := nodes[gindex]
+		if !ok {
+			return nil, ErrIncompleteProofs
+		}
+		return LeafNode(root), nil
+	}
+	return build(1)
+}
+
+// NewPartialView returns a view of the value with the schema built from the proofs, which are
+// verified against the trusted root. Only the values covered by the proofs can be accessed.
+func NewPartialView(s *Schema, root [32]byte, proofs []*Proof) (*View, error) {
+	for _, p := range proofs {
+		if err := VerifyProof(root, p); err != nil {
+			return nil, err
+		}
+	}
+	node, err := NewPartialTree(proofs)
+	if err != nil {
+		return nil, err
+	}
+	if node.Root() != root {
+		return nil, ErrInvalidProof
+	}
+	return NewViewFromNode(s, node), nil
+}
+
+// Decode unmarshals the value into the object (i.e. a generated struct)
+func (v *View) Decode(obj Unmarshaler) error {
+	buf, err := v.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return obj.UnmarshalSSZ(buf)
+}
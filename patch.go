@@ -0,0 +1,150 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// ErrInvalidPatch is returned when a patch cannot be decoded or applied
+var ErrInvalidPatch = fmt.Errorf("invalid patch")
+
+// PatchEntry replaces the node at a generalized index, relative to the root of the
+// value, with either a leaf or a subtree of zeros of the given depth
+type PatchEntry struct {
+	Gindex uint64
+	Leaf   []byte
+	// Zero is set for the subtrees of zeros (i.e. the elements removed from a list)
+	Zero  bool
+	Depth uint8
+}
+
+// Patch is the list of nodes that changed between two values of the same type
+type Patch []*PatchEntry
+
+// Diff returns the patch that changes the value 'a' into the value 'b'
+func Diff(a, b *View) (Patch, error) {
+	if !a.schema.Equal(b.schema) {
+		return nil, fmt.Errorf("diff of a %s and a %s", a.schema.Kind, b.schema.Kind)
+	}
+	if a.packed || b.packed {
+		return nil, fmt.Errorf("diff of a packed %s", a.schema.Kind)
+	}
+	nodeA, err := a.Node()
+	if err != nil {
+		return nil, err
+	}
+	nodeB, err := b.Node()
+	if err != nil {
+		return nil, err
+	}
+
+	patch := Patch{}
+	var walk func(a, b *Node, gindex uint64) error
+	walk = func(a, b *Node, gindex uint64) error {
+		if a != nil && (a == b || a.Root() == b.Root()) {
+			return nil
+		}
+		if depth, ok := zeroDepth(b); ok {
+			patch = append(patch, &PatchEntry{Gindex: gindex, Zero: true, Depth: depth})
+			return nil
+		}
+		if b.IsLeaf() {
+			patch = append(patch, &PatchEntry{Gindex: gindex, Leaf: append([]byte{}, b.value...)})
+			return nil
+		}
+		leftB, rightB, err := b.children()
+		if err != nil {
+			return err
+		}
+		var leftA, rightA *Node
+		if a != nil && !a.IsLeaf() {
+			if leftA, rightA, err = a.children(); err != nil {
+				return err
+			}
+		}
+		if err := walk(leftA, leftB, gindex*2); err != nil {
+			return err
+		}
+		return walk(rightA, rightB, gindex*2+1)
+	}
+	if err := walk(nodeA, nodeB, 1); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// Apply applies the patch to the value. The patch must come from a Diff with the same value.
+func (p Patch) Apply(v *View) error {
+	if v.packed {
+		return fmt.Errorf("patch of a packed %s", v.schema.Kind)
+	}
+	node, err := v.Node()
+	if err != nil {
+		return err
+	}
+	for _, entry := range p {
+		var elem *Node
+		if entry.Zero {
+			if int(entry.Depth) >= len(zeroNodes) {
+				return ErrInvalidPatch
+			}
+			elem = zeroNodes[entry.Depth]
+		} else {
+			if len(entry.Leaf) != 32 {
+				return ErrInvalidPatch
+			}
+			elem = LeafNode(entry.Leaf)
+		}
+		// the leaves of a shorter list are replaced with the whole elements of the longer one
+		if node, err = node.set(entry.Gindex, elem, true); err != nil {
+			return err
+		}
+	}
+	return v.setNode(node)
+}
+
+// MarshalSSZ encodes the patch. Each entry is the generalized index followed
+// by either a zero byte and the leaf or a one and the depth of the zeros.
+func (p Patch) MarshalSSZ() ([]byte, error) {
+	dst := []byte{}
+	for _, entry := range p {
+		dst = MarshalUint64(dst, entry.Gindex)
+		if entry.Zero {
+			dst = append(dst, 1, entry.Depth)
+			continue
+		}
+		if len(entry.Leaf) != 32 {
+			return nil, ErrInvalidPatch
+		}
+		dst = append(dst, 0)
+		dst = append(dst, entry.Leaf...)
+	}
+	return dst, nil
+}
+
+// UnmarshalSSZ decodes a patch encoded with MarshalSSZ
+func (p *Patch) UnmarshalSSZ(buf []byte) error {
+	res := Patch{}
+	for len(buf) != 0 {
+		if len(buf) < 10 {
+			return ErrInvalidPatch
+		}
+		entry := &PatchEntry{Gindex: binary.LittleEndian.Uint64(buf[0:8])}
+		switch buf[8] {
+		case 0:
+			if len(buf) < 41 {
+				return ErrInvalidPatch
+			}
+			entry.Leaf = append([]byte{}, buf[9:41]...)
+			buf = buf[41:]
+		case 1:
+			entry.Zero, entry.Depth = true, buf[9]
+			buf = buf[10:]
+		default:
+			return ErrInvalidPatch
+		}
+		res = append(res, entry)
+	}
+	*p = res
+	return nil
+}
@@ -0,0 +1,180 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// ErrInvalidTree is returned when a stored tree cannot be read
+var ErrInvalidTree = fmt.Errorf("invalid stored tree")
+
+// treeMagic is the prefix of the stored trees
+var treeMagic = []byte("ssztree1")
+
+const (
+	recordLeaf byte = iota
+	recordBranch
+	recordZero
+)
+
+const (
+	leafRecordSize   = 1 + 32
+	branchRecordSize = 1 + 32 + 8 + 8
+	zeroRecordSize   = 1 + 1
+)
+
+// WriteTree stores the tree. The nodes are written once even if they are shared, the
+// branches with their root so that the tree can be loaded without hashing it again.
+// The format is the magic prefix, the records of the nodes with the children before
+// their parents and the offset of the record of the root.
+func WriteTree(w io.Writer, root *Node) error {
+	tw := &treeWriter{w: w, offsets: map[*Node]uint64{}}
+	if err := tw.write(treeMagic); err != nil {
+		return err
+	}
+	offset, err := tw.writeNode(root)
+	if err != nil {
+		return err
+	}
+	return tw.write(MarshalUint64(nil, offset))
+}
+
+type treeWriter struct {
+	w       io.Writer
+	pos     uint64
+	offsets map[*Node]uint64
+}
+
+func (t *treeWriter) write(b []byte) error {
+	if _, err := t.w.Write(b); err != nil {
+		return err
+	}
+	t.pos += uint64(len(b))
+	return nil
+}
+
+// writeNode writes the record of the node, after the ones of its children, and returns its offset
+func (t *treeWriter) writeNode(n *Node) (uint64, error) {
+	if offset, ok := t.offsets[n]; ok {
+		return offset, nil
+	}
+	var record []byte
+	if depth, ok := zeroDepth(n); ok {
+		record = []byte{recordZero, depth}
+	} else if n.IsLeaf() {
+		record = append([]byte{recordLeaf}, n.value...)
+	} else {
+		left, right, err := n.children()
+		if err != nil {
+			return 0, err
+		}
+		leftOffset, err := t.writeNode(left)
+		if err != nil {
+			return 0, err
+		}
+		rightOffset, err := t.writeNode(right)
+		if err != nil {
+			return 0, err
+		}
+		root := n.Root()
+		record = append([]byte{recordBranch}, root[:]...)
+		record = MarshalUint64(record, leftOffset)
+		record = MarshalUint64(record, rightOffset)
+	}
+	offset := t.pos
+	if err := t.write(record); err != nil {
+		return 0, err
+	}
+	t.offsets[n] = offset
+	return offset, nil
+}
+
+// ReadTree loads a tree stored with WriteTree. Only the root is read, the rest of
+// the nodes are read from the source the first time they are accessed, which
+// must remain open for as long as the tree is used.
+func ReadTree(r io.ReaderAt, size int64) (*Node, error) {
+	if size < int64(len(treeMagic))+8 {
+		return nil, ErrInvalidTree
+	}
+	buf := make([]byte, len(treeMagic))
+	if _, err := r.ReadAt(buf, 0); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(buf, treeMagic) {
+		return nil, ErrInvalidTree
+	}
+	buf = make([]byte, 8)
+	if _, err := r.ReadAt(buf, size-8); err != nil {
+		return nil, err
+	}
+	src := &treeReader{r: r, end: uint64(size - 8)}
+	return src.readNode(binary.LittleEndian.Uint64(buf))
+}
+
+type treeReader struct {
+	r io.ReaderAt
+	// end is the offset after the last record
+	end uint64
+}
+
+// lazyNode is a reference to the children of a stored branch
+type lazyNode struct {
+	src         *treeReader
+	left, right uint64
+}
+
+func (l *lazyNode) load() (*Node, *Node, error) {
+	left, err := l.src.readNode(l.left)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := l.src.readNode(l.right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+func (t *treeReader) readNode(offset uint64) (*Node, error) {
+	if offset < uint64(len(treeMagic)) || offset+zeroRecordSize > t.end {
+		return nil, ErrInvalidTree
+	}
+	size := uint64(branchRecordSize)
+	if offset+size > t.end {
+		size = t.end - offset
+	}
+	buf := make([]byte, size)
+	if _, err := t.r.ReadAt(buf, int64(offset)); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch buf[0] {
+	case recordZero:
+		if int(buf[1]) >= len(zeroNodes) {
+			return nil, ErrInvalidTree
+		}
+		return zeroNodes[buf[1]], nil
+
+	case recordLeaf:
+		if size < leafRecordSize {
+			return nil, ErrInvalidTree
+		}
+		return LeafNode(buf[1:33]), nil
+
+	case recordBranch:
+		if size < branchRecordSize {
+			return nil, ErrInvalidTree
+		}
+		left, right := binary.LittleEndian.Uint64(buf[33:41]), binary.LittleEndian.Uint64(buf[41:49])
+		// the children are always written before the parent
+		if left >= offset || right >= offset {
+			return nil, ErrInvalidTree
+		}
+		return &Node{hash: append([]byte{}, buf[1:33]...), lazy: &lazyNode{src: t, left: left, right: right}}, nil
+
+	default:
+		return nil, ErrInvalidTree
+	}
+}
@@ -0,0 +1,93 @@
+package ssz
+
+import (
+	"bytes"
+	"io"
+)
+
+// pipeChunk is the number of bytes read from the input before they are passed to the hasher
+const pipeChunk = 64 * 1024
+
+// UnmarshalAndHash decodes the input into obj while the root is computed from the encoding
+// with the schema of obj in another goroutine. Then, the root of a big object is ready about
+// when the object is decoded instead of hashing it once decoded. The object must implement
+// SchemaProvider, otherwise it returns ErrNotSupported.
+func UnmarshalAndHash(obj Unmarshaler, buf []byte) ([32]byte, error) {
+	s, ok := obj.(SchemaProvider)
+	if !ok {
+		return [32]byte{}, ErrNotSupported
+	}
+	done := make(chan hashResult, 1)
+	go func() {
+		root, err := HashTreeRootReader(s.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf)))
+		done <- hashResult{root, err}
+	}()
+
+	err := TraceUnmarshal(obj, buf)
+	res := <-done
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return res.root, res.err
+}
+
+// UnmarshalAndHashReader reads the next size bytes of the reader and decodes them into obj. The
+// input is hashed as it arrives, so only the decoding is left once the last byte has been read.
+func UnmarshalAndHashReader(obj Unmarshaler, r io.Reader, size uint64) ([32]byte, error) {
+	s, ok := obj.(SchemaProvider)
+	if !ok {
+		return [32]byte{}, ErrNotSupported
+	}
+	schema := s.SchemaSSZ()
+	if size < schema.MinSize() || size > schema.MaxSize() {
+		// the buffer is not allocated for a size that cannot be valid
+		return [32]byte{}, ErrSize
+	}
+	pr, pw := io.Pipe()
+	done := make(chan hashResult, 1)
+	go func() {
+		root, err := HashTreeRootReader(schema, pr, size)
+		// unblock the writes if the hasher fails early
+		pr.CloseWithError(err)
+		done <- hashResult{root, err}
+	}()
+
+	buf := make([]byte, 0, size)
+	for uint64(len(buf)) < size {
+		n := size - uint64(len(buf))
+		if n > pipeChunk {
+			n = pipeChunk
+		}
+		chunk := buf[len(buf) : uint64(len(buf))+n]
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			pw.CloseWithError(err)
+			<-done
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return [32]byte{}, err
+		}
+		buf = buf[:uint64(len(buf))+n]
+		if _, err := pw.Write(chunk); err != nil {
+			// the hasher already failed
+			if res := <-done; res.err != nil {
+				return [32]byte{}, res.err
+			}
+			return [32]byte{}, ErrSize
+		}
+	}
+	pw.Close()
+
+	// the hasher finishes the last chunks while the object is decoded
+	err := TraceUnmarshal(obj, buf)
+	res := <-done
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return res.root, res.err
+}
+
+type hashResult struct {
+	root [32]byte
+	err  error
+}
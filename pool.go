@@ -0,0 +1,49 @@
+package ssz
+
+import (
+	"reflect"
+	"sync"
+)
+
+// ObjectPool provides the nested objects of the UnmarshalSSZWithPool functions (i.e. the *Validator
+// of the validators of a state), so that the repeated decodes recycle them instead of allocating them
+type ObjectPool interface {
+	// Get returns an object of the type of the nil pointer typ (i.e. (*Validator)(nil)) or nil
+	// to allocate a new one. The decode overwrites all the fields of the object.
+	Get(typ interface{}) interface{}
+}
+
+// UnmarshalerWithPool is the interface of the types generated with the --object-pool option
+type UnmarshalerWithPool interface {
+	UnmarshalSSZWithPool(buf []byte, pool ObjectPool) error
+}
+
+// UnmarshalWithPool unmarshals obj with the nested objects of the pool. The types without
+// UnmarshalSSZWithPool are unmarshaled with UnmarshalSSZ.
+func UnmarshalWithPool(obj Unmarshaler, buf []byte, pool ObjectPool) error {
+	if p, ok := obj.(UnmarshalerWithPool); ok && pool != nil {
+		return p.UnmarshalSSZWithPool(buf, pool)
+	}
+	return obj.UnmarshalSSZ(buf)
+}
+
+// TypePool is an ObjectPool with a sync.Pool for each type, keyed by the types of the nil pointers
+// of Get. The objects that are no longer used (i.e. the validators of an old state) are released with Put.
+type TypePool struct {
+	pools sync.Map
+}
+
+// Get implements the ObjectPool interface
+func (p *TypePool) Get(typ interface{}) interface{} {
+	pool, ok := p.pools.Load(reflect.TypeOf(typ))
+	if !ok {
+		return nil
+	}
+	return pool.(*sync.Pool).Get()
+}
+
+// Put releases the object to the pool of its type
+func (p *TypePool) Put(obj interface{}) {
+	pool, _ := p.pools.LoadOrStore(reflect.TypeOf(obj), new(sync.Pool))
+	pool.(*sync.Pool).Put(obj)
+}
@@ -0,0 +1,84 @@
+package ssz
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// ErrInvalidProfile is returned when the parameters of a merkleization profile are not valid
+var ErrInvalidProfile = fmt.Errorf("invalid merkleization profile")
+
+// Padding is the rule to complete the trees whose number of leaves is not a power of two
+type Padding int
+
+const (
+	// PadZero pads the leaves with zero chunks up to the limit of the type, as in SSZ
+	PadZero Padding = iota
+	// PadNone does not pad the leaves, the last node of a layer without a sibling
+	// is moved up to the next layer. The limits of the lists are only checked.
+	PadNone
+)
+
+// Profile are the parameters of the merkleization, for the protocols that use the SSZ
+// encoding with different trees. The profiles hash the encoding of the values with their
+// schema, the generated HashTreeRoot functions always follow the SSZ merkleization.
+type Profile struct {
+	// ChunkSize is the size of the chunks in which the basic values and the bytes are packed,
+	// a multiple of 32 bytes. The roots of the fields and the elements are the leaves of the
+	// trees of the containers, the vectors and the lists.
+	ChunkSize uint64
+	// Padding is the rule for the trees that are not complete
+	Padding Padding
+	// Leaf encodes the chunks as the leaves of the trees (i.e. with a domain separation
+	// prefix). It is required for the chunks bigger than 32 bytes, by default the chunks are the leaves.
+	Leaf func(chunk []byte) [32]byte
+	// Hash returns the hash of the branches, by default SHA-256 with the selected backend
+	Hash func() hash.Hash
+
+	// zeroHashes are the roots of the trees of depth i with all the chunks set to zero
+	zeroHashes [65][32]byte
+}
+
+// defaultProfile is the SSZ merkleization
+var defaultProfile, _ = NewProfile(Profile{})
+
+// NewProfile returns the profile with the parameters, the zero values are the SSZ ones
+func NewProfile(p Profile) (*Profile, error) {
+	if p.ChunkSize == 0 {
+		p.ChunkSize = 32
+	}
+	if p.ChunkSize%32 != 0 || (p.ChunkSize != 32 && p.Leaf == nil) {
+		return nil, ErrInvalidProfile
+	}
+	if p.Padding != PadZero && p.Padding != PadNone {
+		return nil, ErrInvalidProfile
+	}
+	h := &streamHasher{p: &p, hash: p.newHash()}
+	p.zeroHashes[0] = h.leaf(make([]byte, p.ChunkSize))
+	for i := 1; i < len(p.zeroHashes); i++ {
+		p.zeroHashes[i] = h.hashPair(p.zeroHashes[i-1], p.zeroHashes[i-1])
+	}
+	return &p, nil
+}
+
+func (p *Profile) newHash() hash.Hash {
+	if p.Hash != nil {
+		return p.Hash()
+	}
+	return newHash()
+}
+
+// HashTreeRoot computes the root of the encoding of a value with the schema
+func (p *Profile) HashTreeRoot(s *Schema, buf []byte) ([32]byte, error) {
+	return p.HashTreeRootReader(s, bytes.NewReader(buf), uint64(len(buf)))
+}
+
+// HashTreeRootReader computes the root of the value with the schema encoded in the next size
+// bytes of the reader as it is read, like the package function HashTreeRootReader
+func (p *Profile) HashTreeRootReader(s *Schema, r io.Reader, size uint64) ([32]byte, error) {
+	h := &streamHasher{r: bufio.NewReader(r), p: p, hash: p.newHash()}
+	return h.hashValue(s, size)
+}
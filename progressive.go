@@ -0,0 +1,172 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// The progressive lists and bitlists (EIP-7916) have no limit. Their contents are merkleized
+// as a chain of subtrees of 1, 4, 16... chunks: the root of the chunks is the hash of the
+// root of the rest of the chunks, starting with the next subtree, and the root of the first
+// subtree. The root without chunks is zero. Their encoding is the same as the lists.
+
+// MerkleizeProgressive replaces the chunks after indx with their progressive root
+func (h *Hasher) MerkleizeProgressive(indx int) {
+	root := h.merkleizeProgressiveImpl(h.buf[indx:])
+	h.buf = append(h.buf[:indx], root...)
+}
+
+// MerkleizeProgressiveWithMixin replaces the chunks after indx with the root of
+// a progressive list with num elements
+func (h *Hasher) MerkleizeProgressiveWithMixin(indx int, num uint64) {
+	h.MerkleizeProgressive(indx)
+
+	// mix in the length
+	output := h.tmp[:32]
+	copy(output, zeroBytes)
+	binary.LittleEndian.PutUint64(output[:8], num)
+	input := h.doHash(h.buf[indx:], h.buf[indx:indx+32], output)
+	h.buf = append(h.buf[:indx], input...)
+}
+
+// PutProgressiveBitlist appends the root of a progressive bitlist
+func (h *Hasher) PutProgressiveBitlist(bb []byte) {
+	var size uint64
+	h.tmp, size = parseBitlist(h.tmp[:0], bb)
+
+	indx := h.Index()
+	h.appendBytes32(h.tmp)
+	h.MerkleizeProgressiveWithMixin(indx, size)
+}
+
+func (h *Hasher) merkleizeProgressiveImpl(input []byte) []byte {
+	count := uint64(len(input) / 32)
+
+	// the roots of the subtrees, the chunks of each one are copied since
+	// merkleizeImpl pads the input with zero chunks
+	roots := [][]byte{}
+	var scratch []byte
+	for start, leaves := uint64(0), uint64(1); start < count; start, leaves = start+leaves, leaves*4 {
+		end := start + leaves
+		if end > count {
+			end = count
+		}
+		scratch = append(scratch[:0], input[start*32:end*32]...)
+		roots = append(roots, h.merkleizeImpl(nil, scratch, leaves))
+	}
+
+	root := make([]byte, 32)
+	for i := len(roots) - 1; i >= 0; i-- {
+		root = h.doHash(root, root, roots[i])
+	}
+	return root
+}
+
+// progressiveLimit is the limit of the progressive lists in the checks of the number of elements
+const progressiveLimit = uint64(math.MaxInt64)
+
+// limit returns the maximum number of elements of a list
+func (s *Schema) limit() uint64 {
+	if s.Progressive {
+		return progressiveLimit
+	}
+	return s.Max
+}
+
+// contentsTree returns the tree of the contents of a vector or
+// a list with the nodes as leaves, the rest of the leaves are zero
+func (s *Schema) contentsTree(nodes []*Node) *Node {
+	if s.Progressive {
+		return progressiveTree(nodes, 1)
+	}
+	return treeFromNodes(nodes, s.depth())
+}
+
+// contentsNodes returns the first count leaves of the tree of the contents of a vector or a list
+func (s *Schema) contentsNodes(contents *Node, count uint64) ([]*Node, error) {
+	if !s.Progressive {
+		return contents.nodesAt(s.depth(), count)
+	}
+	res := make([]*Node, 0, count)
+	for leaves := uint64(1); uint64(len(res)) < count; leaves *= 4 {
+		if contents.IsLeaf() {
+			return nil, ErrInvalidGindex
+		}
+		rest, subtree, err := contents.children()
+		if err != nil {
+			return nil, err
+		}
+		n := count - uint64(len(res))
+		if n > leaves {
+			n = leaves
+		}
+		nodes, err := subtree.nodesAt(getDepth(leaves), n)
+		if err != nil {
+			return nil, err
+		}
+		res, contents = append(res, nodes...), rest
+	}
+	return res, nil
+}
+
+// chunkGindex returns the generalized index of a leaf of the tree of the contents at gindex
+func (s *Schema) chunkGindex(gindex uint64, chunk uint64) (uint64, error) {
+	if !s.Progressive {
+		return childGindex(gindex, s.depth(), chunk)
+	}
+	// the subtree k is the right child after k left children
+	k, leaves := uint8(0), uint64(1)
+	for chunk >= leaves {
+		chunk -= leaves
+		k, leaves = k+1, leaves*4
+	}
+	gindex, err := childGindex(gindex, k+1, 1)
+	if err != nil {
+		return 0, err
+	}
+	return childGindex(gindex, 2*k, chunk)
+}
+
+// progressiveTree returns the progressive tree of the nodes with a first subtree of the given leaves
+func progressiveTree(nodes []*Node, leaves uint64) *Node {
+	if len(nodes) == 0 {
+		return zeroNodes[0]
+	}
+	n := uint64(len(nodes))
+	if n > leaves {
+		n = leaves
+	}
+	return BranchNode(progressiveTree(nodes[n:], leaves*4), treeFromNodes(nodes[:n], getDepth(leaves)))
+}
+
+// progressiveMerkleizer computes the progressive root of the leaves in order
+type progressiveMerkleizer struct {
+	h      *streamHasher
+	cur    *merkleizer
+	leaves uint64
+	roots  [][32]byte
+}
+
+func newProgressiveMerkleizer(h *streamHasher) *progressiveMerkleizer {
+	return &progressiveMerkleizer{h: h, cur: &merkleizer{h: h}, leaves: 1}
+}
+
+func (p *progressiveMerkleizer) add(chunk [32]byte) {
+	p.cur.add(chunk)
+	if p.cur.count == p.leaves {
+		p.roots = append(p.roots, p.cur.root(getDepth(p.leaves)))
+		p.cur, p.leaves = &merkleizer{h: p.h}, p.leaves*4
+	}
+}
+
+func (p *progressiveMerkleizer) root() [32]byte {
+	roots := p.roots
+	if p.cur.count != 0 {
+		roots = append(roots, p.cur.root(getDepth(p.leaves)))
+	}
+	var root [32]byte
+	for i := len(roots) - 1; i >= 0; i-- {
+		root = p.h.hashPair(root, roots[i])
+	}
+	return root
+}
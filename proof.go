@@ -0,0 +1,182 @@
+package ssz
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+// ErrInvalidProof is returned when a proof does not match the root
+var ErrInvalidProof = fmt.Errorf("invalid proof")
+
+// Proof is a Merkle proof of the node at a generalized index
+type Proof struct {
+	Index uint64
+	Leaf  []byte
+	// Hashes are the roots of the siblings from the node up to the root
+	Hashes [][]byte
+}
+
+// Prove returns the proof of the node at the generalized index
+func (n *Node) Prove(gindex uint64) (*Proof, error) {
+	if gindex == 0 {
+		return nil, ErrInvalidGindex
+	}
+	depth := uint(bits.Len64(gindex) - 1)
+	hashes := make([][]byte, depth)
+
+	node := n
+	for i := depth; i > 0; i-- {
+		if node.IsLeaf() {
+			return nil, ErrInvalidGindex
+		}
+		left, right, err := node.children()
+		if err != nil {
+			return nil, err
+		}
+		var sibling *Node
+		if gindex>>(i-1)&1 == 1 {
+			node, sibling = right, left
+		} else {
+			node, sibling = left, right
+		}
+		root := sibling.Root()
+		hashes[i-1] = root[:]
+	}
+	leaf := node.Root()
+	return &Proof{Index: gindex, Leaf: leaf[:], Hashes: hashes}, nil
+}
+
+// Root returns the root of the tree computed with the leaf and the hashes of the proof
+func (p *Proof) Root() ([32]byte, error) {
+	var res [32]byte
+	if p.Index == 0 || len(p.Leaf) != 32 || len(p.Hashes) != bits.Len64(p.Index)-1 {
+		return res, ErrInvalidProof
+	}
+	copy(res[:], p.Leaf)
+
+	tmp := make([]byte, 64)
+	for i, h := range p.Hashes {
+		if len(h) != 32 {
+			return res, ErrInvalidProof
+		}
+		if p.Index>>uint(i)&1 == 1 {
+			copy(tmp[:32], h)
+			copy(tmp[32:], res[:])
+		} else {
+			copy(tmp[:32], res[:])
+			copy(tmp[32:], h)
+		}
+		res = sum256(tmp)
+	}
+	return res, nil
+}
+
+// VerifyProof checks that the proof matches the root
+func VerifyProof(root [32]byte, p *Proof) error {
+	res, err := p.Root()
+	if err != nil {
+		return err
+	}
+	if res != root {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// VerifyProofs checks that all the proofs match the root. The nodes shared by the proofs (i.e. the
+// branches of the validators of a state) are hashed once, so it is faster than VerifyProof for each
+// proof. The proofs that disagree on the hash of a node are invalid.
+func VerifyProofs(root [32]byte, proofs []*Proof) error {
+	if len(proofs) == 0 {
+		return nil
+	}
+	nodes := newProofNodes()
+	for _, p := range proofs {
+		if p.Index == 0 || len(p.Leaf) != 32 || len(p.Hashes) != bits.Len64(p.Index)-1 {
+			return ErrInvalidProof
+		}
+		if err := nodes.set(p.Index, p.Leaf); err != nil {
+			return err
+		}
+		gindex := p.Index
+		for _, h := range p.Hashes {
+			if len(h) != 32 {
+				return ErrInvalidProof
+			}
+			if err := nodes.set(gindex^1, h); err != nil {
+				return err
+			}
+			gindex >>= 1
+		}
+		nodes.addPath(p.Index)
+	}
+	res, err := nodes.root()
+	if err != nil {
+		return err
+	}
+	if res != root {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// proofNodes are the nodes given by a set of proofs and the ones computed from them by generalized index
+type proofNodes struct {
+	nodes map[uint64][32]byte
+	// paths are the nodes on the paths from the leaves to the root
+	paths map[uint64]struct{}
+}
+
+func newProofNodes() *proofNodes {
+	return &proofNodes{nodes: map[uint64][32]byte{}, paths: map[uint64]struct{}{}}
+}
+
+// set sets the hash of a node, the proofs that disagree on a node are invalid
+func (p *proofNodes) set(gindex uint64, hash []byte) error {
+	var node [32]byte
+	copy(node[:], hash)
+	if prev, ok := p.nodes[gindex]; ok && prev != node {
+		return ErrInvalidProof
+	}
+	p.nodes[gindex] = node
+	return nil
+}
+
+// addPath adds the nodes from the leaf up to the root, without the root
+func (p *proofNodes) addPath(gindex uint64) {
+	for ; gindex > 1; gindex >>= 1 {
+		p.paths[gindex] = struct{}{}
+	}
+}
+
+// root hashes the nodes of the paths up to the root, each parent is hashed once
+func (p *proofNodes) root() ([32]byte, error) {
+	// the children have bigger indices than their parents
+	order := make([]uint64, 0, len(p.paths))
+	for gindex := range p.paths {
+		order = append(order, gindex)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return order[i] > order[j]
+	})
+
+	tmp := make([]byte, 64)
+	done := map[uint64]bool{}
+	for _, gindex := range order {
+		parent := gindex >> 1
+		if done[parent] {
+			// the sibling is on another path
+			continue
+		}
+		left, right := p.nodes[gindex&^1], p.nodes[gindex|1]
+		copy(tmp[:32], left[:])
+		copy(tmp[32:], right[:])
+		hash := sum256(tmp)
+		if err := p.set(parent, hash[:]); err != nil {
+			return [32]byte{}, err
+		}
+		done[parent] = true
+	}
+	return p.nodes[1], nil
+}
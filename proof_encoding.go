@@ -0,0 +1,374 @@
+package ssz
+
+// The SSZ encodings of the proofs, with the code that sszgen generates for them:
+//
+//	class Proof(Container):
+//	    gindex: uint64
+//	    leaf: Bytes32
+//	    branch: List[Bytes32, 64]
+//
+//	class Multiproof(Container):
+//	    indices: List[uint64, 2**20]
+//	    leaves: List[Bytes32, 2**20]
+//	    hashes: List[Bytes32, 2**20]
+
+// MarshalSSZ ssz marshals the Proof object
+func (p *Proof) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, p.SizeSSZ())
+	return p.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Proof object to a target array
+func (p *Proof) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(44)
+
+	// Field (0) 'Index'
+	dst = MarshalUint64(dst, p.Index)
+
+	// Field (1) 'Leaf'
+	if dst, err = MarshalFixedBytes(dst, p.Leaf, 32); err != nil {
+		return nil, ErrBytesLength
+	}
+
+	// Offset (2) 'Hashes'
+	if dst, err = SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(p.Hashes) * 32
+
+	// Field (2) 'Hashes'
+	if len(p.Hashes) > 64 {
+		return nil, ErrListTooBig
+	}
+	for ii := 0; ii < len(p.Hashes); ii++ {
+		if dst, err = MarshalFixedBytes(dst, p.Hashes[ii], 32); err != nil {
+			return nil, ErrBytesLength
+		}
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Proof object
+func (p *Proof) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 44 {
+		return ErrSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'Index'
+	p.Index = UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Leaf'
+	p.Leaf = append(p.Leaf[:0], buf[8:40]...)
+
+	// Offset (2) 'Hashes'
+	if o2 = ReadOffset(buf[40:44]); o2 > size || o2 != 44 {
+		return ErrOffset
+	}
+
+	// Field (2) 'Hashes'
+	{
+		buf = tail[o2:]
+		num, ok := DivideInt(len(buf), 32)
+		if !ok {
+			return ErrSize
+		}
+		if num > 64 {
+			return ErrListTooBig
+		}
+		p.Hashes = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			p.Hashes[ii] = append(p.Hashes[ii][:0], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Proof object
+func (p *Proof) SizeSSZ() (size int) {
+	size = 44
+
+	// Field (2) 'Hashes'
+	size += len(p.Hashes) * 32
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Proof object
+func (p *Proof) MaxSizeSSZ() uint64 {
+	return 2092
+}
+
+// HashTreeRoot ssz hashes the Proof object
+func (p *Proof) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the Proof object with a hasher
+func (p *Proof) HashTreeRootWith(hh *Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(p.Index)
+
+	// Field (1) 'Leaf'
+	if len(p.Leaf) != 32 {
+		return ErrBytesLength
+	}
+	hh.PutBytes(p.Leaf)
+
+	// Field (2) 'Hashes'
+	{
+		if len(p.Hashes) > 64 {
+			return ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(p.Hashes); ii++ {
+			if len(p.Hashes[ii]) != 32 {
+				return ErrBytesLength
+			}
+			hh.PutBytes(p.Hashes[ii])
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(p.Hashes)), 64)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Proof object
+func (p *Proof) SchemaSSZ() *Schema {
+	return ContainerSchema("Proof",
+		NewField("gindex", UintSchema(8)),
+		NewField("leaf", ByteVectorSchema(32)),
+		NewField("branch", ListSchema(ByteVectorSchema(32), 64)),
+	)
+}
+
+// MarshalSSZ ssz marshals the Multiproof object
+func (m *Multiproof) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, m.SizeSSZ())
+	return m.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Multiproof object to a target array
+func (m *Multiproof) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(12)
+
+	// Offset (0) 'Indices'
+	if dst, err = SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(m.Indices) * 8
+
+	// Offset (1) 'Leaves'
+	if dst, err = SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(m.Leaves) * 32
+
+	// Offset (2) 'Hashes'
+	if dst, err = SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(m.Hashes) * 32
+
+	// Field (0) 'Indices'
+	if len(m.Indices) > multiproofLimit {
+		return nil, ErrListTooBig
+	}
+	for ii := 0; ii < len(m.Indices); ii++ {
+		dst = MarshalUint64(dst, m.Indices[ii])
+	}
+
+	// Field (1) 'Leaves'
+	if len(m.Leaves) > multiproofLimit {
+		return nil, ErrListTooBig
+	}
+	for ii := 0; ii < len(m.Leaves); ii++ {
+		if dst, err = MarshalFixedBytes(dst, m.Leaves[ii], 32); err != nil {
+			return nil, ErrBytesLength
+		}
+	}
+
+	// Field (2) 'Hashes'
+	if len(m.Hashes) > multiproofLimit {
+		return nil, ErrListTooBig
+	}
+	for ii := 0; ii < len(m.Hashes); ii++ {
+		if dst, err = MarshalFixedBytes(dst, m.Hashes[ii], 32); err != nil {
+			return nil, ErrBytesLength
+		}
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Multiproof object
+func (m *Multiproof) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2 uint64
+
+	// Offset (0) 'Indices'
+	if o0 = ReadOffset(buf[0:4]); o0 > size || o0 != 12 {
+		return ErrOffset
+	}
+
+	// Offset (1) 'Leaves'
+	if o1 = ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ErrOffset
+	}
+
+	// Offset (2) 'Hashes'
+	if o2 = ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ErrOffset
+	}
+
+	// Field (0) 'Indices'
+	{
+		buf = tail[o0:o1]
+		num, ok := DivideInt(len(buf), 8)
+		if !ok {
+			return ErrSize
+		}
+		if num > multiproofLimit {
+			return ErrListTooBig
+		}
+		m.Indices = ExtendUint64(m.Indices, num)
+		for ii := 0; ii < num; ii++ {
+			m.Indices[ii] = UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (1) 'Leaves'
+	{
+		buf = tail[o1:o2]
+		num, ok := DivideInt(len(buf), 32)
+		if !ok {
+			return ErrSize
+		}
+		if num > multiproofLimit {
+			return ErrListTooBig
+		}
+		m.Leaves = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			m.Leaves[ii] = append(m.Leaves[ii][:0], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (2) 'Hashes'
+	{
+		buf = tail[o2:]
+		num, ok := DivideInt(len(buf), 32)
+		if !ok {
+			return ErrSize
+		}
+		if num > multiproofLimit {
+			return ErrListTooBig
+		}
+		m.Hashes = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			m.Hashes[ii] = append(m.Hashes[ii][:0], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Multiproof object
+func (m *Multiproof) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (0) 'Indices'
+	size += len(m.Indices) * 8
+
+	// Field (1) 'Leaves'
+	size += len(m.Leaves) * 32
+
+	// Field (2) 'Hashes'
+	size += len(m.Hashes) * 32
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Multiproof object
+func (m *Multiproof) MaxSizeSSZ() uint64 {
+	return 75497484
+}
+
+// HashTreeRoot ssz hashes the Multiproof object
+func (m *Multiproof) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(m)
+}
+
+// HashTreeRootWith ssz hashes the Multiproof object with a hasher
+func (m *Multiproof) HashTreeRootWith(hh *Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Indices'
+	{
+		if len(m.Indices) > multiproofLimit {
+			return ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(m.Indices); ii++ {
+			hh.AppendUint64(m.Indices[ii])
+		}
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(subIndx, uint64(len(m.Indices)), (multiproofLimit*8+31)/32)
+	}
+
+	// Field (1) 'Leaves'
+	{
+		if len(m.Leaves) > multiproofLimit {
+			return ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(m.Leaves); ii++ {
+			if len(m.Leaves[ii]) != 32 {
+				return ErrBytesLength
+			}
+			hh.PutBytes(m.Leaves[ii])
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(m.Leaves)), multiproofLimit)
+	}
+
+	// Field (2) 'Hashes'
+	{
+		if len(m.Hashes) > multiproofLimit {
+			return ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(m.Hashes); ii++ {
+			if len(m.Hashes[ii]) != 32 {
+				return ErrBytesLength
+			}
+			hh.PutBytes(m.Hashes[ii])
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(m.Hashes)), multiproofLimit)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Multiproof object
+func (m *Multiproof) SchemaSSZ() *Schema {
+	return ContainerSchema("Multiproof",
+		NewField("indices", ListSchema(UintSchema(8), multiproofLimit)),
+		NewField("leaves", ListSchema(ByteVectorSchema(32), multiproofLimit)),
+		NewField("hashes", ListSchema(ByteVectorSchema(32), multiproofLimit)),
+	)
+}
@@ -0,0 +1,158 @@
+package ssz
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PathElem is a step of a path, either the name of a field or the index of an element
+type PathElem struct {
+	Field   string
+	Index   uint64
+	IsIndex bool
+	// All is set for the '[*]' steps that select all the elements
+	All bool
+}
+
+func (p PathElem) String() string {
+	if p.All {
+		return "[*]"
+	}
+	if p.IsIndex {
+		return "[" + strconv.FormatUint(p.Index, 10) + "]"
+	}
+	return p.Field
+}
+
+// ParsePath parses a path of fields separated by dots and indexes between brackets
+// (i.e. 'validators[12345].effective_balance'). The index '*' selects all the elements,
+// which is only valid for the functions that return more than one value.
+func ParsePath(path string) ([]PathElem, error) {
+	res := []PathElem{}
+	rest := path
+	for rest != "" {
+		if rest[0] == '[' {
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("path '%s': bracket not closed", path)
+			}
+			if rest[1:end] == "*" {
+				res = append(res, PathElem{IsIndex: true, All: true})
+				rest = rest[end+1:]
+				continue
+			}
+			indx, err := strconv.ParseUint(rest[1:end], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("path '%s': incorrect index '%s'", path, rest[1:end])
+			}
+			res = append(res, PathElem{Index: indx, IsIndex: true})
+			rest = rest[end+1:]
+		} else {
+			if len(res) != 0 {
+				// the fields after the first one start with a dot
+				if rest[0] != '.' {
+					return nil, fmt.Errorf("path '%s': expected a dot before '%s'", path, rest)
+				}
+				rest = rest[1:]
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("path '%s': empty field", path)
+			}
+			res = append(res, PathElem{Field: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+	return res, nil
+}
+
+// parseSinglePath parses a path without '[*]' steps
+func parseSinglePath(path string) ([]PathElem, error) {
+	elems, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range elems {
+		if elem.All {
+			return nil, fmt.Errorf("path '%s': the index '*' selects more than one value", path)
+		}
+	}
+	return elems, nil
+}
+
+// Gindex returns the generalized index of the value at the path and its schema. The
+// indexes of the lists are only checked against their limit. For the uint and bool elements
+// of the vectors and lists, the generalized index is the one of the chunk with the value.
+func (s *Schema) Gindex(path string) (uint64, *Schema, error) {
+	elems, err := parseSinglePath(path)
+	if err != nil {
+		return 0, nil, err
+	}
+	v := &View{schema: s, gindex: 1}
+	for _, elem := range elems {
+		if !elem.IsIndex {
+			if v, err = v.Field(elem.Field); err != nil {
+				return 0, nil, err
+			}
+			continue
+		}
+		if v.schema.Kind != KindVector && v.schema.Kind != KindList {
+			return 0, nil, fmt.Errorf("index of a %s", v.schema.Kind)
+		}
+		if (v.schema.Kind == KindVector && elem.Index >= v.schema.Size) || (v.schema.Kind == KindList && elem.Index >= v.schema.limit()) {
+			return 0, nil, ErrIndexOutOfRange
+		}
+		if v, err = v.elem(elem.Index); err != nil {
+			return 0, nil, err
+		}
+	}
+	return v.gindex, v.schema, nil
+}
+
+// Path returns the view of the value at the path
+func (v *View) Path(path string) (*View, error) {
+	elems, err := parseSinglePath(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range elems {
+		if elem.IsIndex {
+			v, err = v.Index(elem.Index)
+		} else {
+			v, err = v.Field(elem.Field)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("path '%s': %v", path, err)
+		}
+	}
+	return v, nil
+}
+
+// Prove returns the proof of the node of the value against the root of the tree of the view
+// it comes from. For the packed uint and bool elements, the leaf is the chunk with the value.
+func (v *View) Prove() (*Proof, error) {
+	return v.tree.root.Prove(v.gindex)
+}
+
+// Query decodes the SSZ encoding of a value with the schema and returns the view at the path
+func Query(s *Schema, buf []byte, path string) (*View, error) {
+	view, err := NewViewFromSSZ(s, buf)
+	if err != nil {
+		return nil, err
+	}
+	return view.Path(path)
+}
+
+// QueryProof decodes the SSZ encoding of a value with the schema and returns
+// the proof of the value at the path against the root of the value
+func QueryProof(s *Schema, buf []byte, path string) (*Proof, error) {
+	view, err := Query(s, buf, path)
+	if err != nil {
+		return nil, err
+	}
+	return view.Prove()
+}
@@ -0,0 +1,60 @@
+package ssz
+
+import "math/rand"
+
+// maxRandomLength caps the length of the random lists, bytes and bitlists with a larger limit
+const maxRandomLength = 1024
+
+// RandomLength returns a random length for a list with the given limit. The empty
+// and the full lists are returned more often since those are the usual edge cases.
+// A limit of 0 means there is no limit (i.e. the progressive lists).
+func RandomLength(rng *rand.Rand, max uint64) int {
+	if max == 0 || max > maxRandomLength {
+		max = maxRandomLength
+	}
+	switch rng.Intn(4) {
+	case 0:
+		return 0
+	case 1:
+		return int(max)
+	default:
+		return rng.Intn(int(max) + 1)
+	}
+}
+
+// RandomBytes returns n random bytes
+func RandomBytes(rng *rand.Rand, n int) []byte {
+	buf := make([]byte, n)
+	rng.Read(buf)
+	return buf
+}
+
+// RandomBitvector returns a random bitvector of bitLen bits with the padding bits unset.
+// Some of the bitvectors have all the bits unset.
+func RandomBitvector(rng *rand.Rand, bitLen uint64) []byte {
+	buf := make([]byte, (bitLen+7)/8)
+	if rng.Intn(4) != 0 {
+		rng.Read(buf)
+	}
+	if rem := bitLen % 8; rem != 0 {
+		buf[len(buf)-1] &= 1<<rem - 1
+	}
+	return buf
+}
+
+// RandomBitlist returns a random bitlist with at most bitLimit bits. A bitLimit
+// of 0 means there is no limit. Some of the bitlists have all the bits unset.
+func RandomBitlist(rng *rand.Rand, bitLimit uint64) []byte {
+	if bitLimit == 0 {
+		bitLimit = maxRandomLength
+	}
+	bitLen := uint(RandomLength(rng, bitLimit))
+	buf := make([]byte, bitLen/8+1)
+	if rng.Intn(4) != 0 {
+		rng.Read(buf)
+	}
+	// unset the bits after the length and set the length bit
+	buf[len(buf)-1] &= 1<<(bitLen%8) - 1
+	buf[len(buf)-1] |= 1 << (bitLen % 8)
+	return buf
+}
@@ -0,0 +1,158 @@
+package reqresp
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// The chunks of the ssz_snappy encoding of the consensus p2p req/resp protocols
+// (https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/p2p-interface.md).
+// A request is the length of the SSZ encoding as an uvarint and the snappy framed encoding.
+// A response is any number of chunks with a result byte followed by an encoded payload.
+
+// Result is the first byte of a response chunk
+type Result byte
+
+const (
+	// ResultSuccess is the result of the chunks with a response
+	ResultSuccess Result = 0
+	// ResultInvalidRequest is returned when the request cannot be decoded or is not valid
+	ResultInvalidRequest Result = 1
+	// ResultServerError is returned when the responder fails to process a valid request
+	ResultServerError Result = 2
+	// ResultResourceUnavailable is returned when the responder does not have the resource
+	ResultResourceUnavailable Result = 3
+)
+
+// maxErrorMessage is the limit of the error messages (List[byte, 256])
+const maxErrorMessage = 256
+
+var (
+	// ErrChunkTooLarge is returned when the length of a payload is larger than the limit
+	ErrChunkTooLarge = fmt.Errorf("payload larger than the limit")
+	// ErrIncorrectLength is returned when a payload does not decode to the expected length
+	ErrIncorrectLength = fmt.Errorf("incorrect payload length")
+)
+
+// ResponseError is a response chunk with a result other than ResultSuccess
+type ResponseError struct {
+	Result  Result
+	Message string
+}
+
+func (r *ResponseError) Error() string {
+	return fmt.Sprintf("response error %d: %s", r.Result, r.Message)
+}
+
+func writePayload(w io.Writer, buf []byte) error {
+	var tmp [binary.MaxVarintLen64]byte
+	if _, err := w.Write(tmp[:binary.PutUvarint(tmp[:], uint64(len(buf)))]); err != nil {
+		return err
+	}
+	return writeFramed(w, buf)
+}
+
+// WriteRequest writes the request
+func WriteRequest(w io.Writer, obj ssz.Marshaler) error {
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return writePayload(w, buf)
+}
+
+// WriteResponse writes a chunk with a successful response
+func WriteResponse(w io.Writer, obj ssz.Marshaler) error {
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte{byte(ResultSuccess)}); err != nil {
+		return err
+	}
+	return writePayload(w, buf)
+}
+
+// WriteError writes a chunk with an error result and message, which is truncated to 256 bytes
+func WriteError(w io.Writer, result Result, msg string) error {
+	if result == ResultSuccess {
+		return fmt.Errorf("error chunk with a success result")
+	}
+	buf := []byte(msg)
+	if len(buf) > maxErrorMessage {
+		buf = buf[:maxErrorMessage]
+	}
+	if _, err := w.Write([]byte{byte(result)}); err != nil {
+		return err
+	}
+	return writePayload(w, buf)
+}
+
+// Reader reads the requests and the response chunks of a stream
+type Reader struct {
+	r *bufio.Reader
+	// maxSize is the limit of the length of the payloads
+	maxSize uint64
+}
+
+// NewReader returns a reader of the stream with the limit of the length
+// of the payloads (i.e. MAX_CHUNK_SIZE or the maximum size of the type)
+func NewReader(r io.Reader, maxSize uint64) *Reader {
+	return &Reader{r: bufio.NewReader(r), maxSize: maxSize}
+}
+
+func (r *Reader) readPayload(maxSize uint64) ([]byte, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxSize {
+		return nil, ErrChunkTooLarge
+	}
+	buf, err := readFramed(r.r, size)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(buf)) != size {
+		return nil, ErrIncorrectLength
+	}
+	return buf, nil
+}
+
+// ReadRequest reads a request into the object
+func (r *Reader) ReadRequest(obj ssz.Unmarshaler) error {
+	buf, err := r.readPayload(r.maxSize)
+	if err != nil {
+		return err
+	}
+	return ssz.TraceUnmarshal(obj, buf)
+}
+
+// ReadResponse reads the next response chunk into the object. It returns io.EOF
+// if the stream is closed before the next chunk and a *ResponseError for the chunks
+// that are not successful.
+func (r *Reader) ReadResponse(obj ssz.Unmarshaler) error {
+	result, err := r.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if Result(result) != ResultSuccess {
+		buf, err := r.readPayload(maxErrorMessage)
+		if err != nil {
+			return err
+		}
+		return &ResponseError{Result: Result(result), Message: string(buf)}
+	}
+	buf, err := r.readPayload(r.maxSize)
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return ssz.TraceUnmarshal(obj, buf)
+}
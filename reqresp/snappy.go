@@ -0,0 +1,300 @@
+package reqresp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// The snappy framing format (https://github.com/google/snappy/blob/master/framing_format.txt)
+// with the block format compression.
+
+var (
+	errSnappyCorrupt  = fmt.Errorf("snappy: corrupt input")
+	errSnappyChecksum = fmt.Errorf("snappy: invalid checksum")
+	errSnappyTooLarge = fmt.Errorf("snappy: decoded payload larger than expected")
+)
+
+const (
+	chunkCompressed   = 0x00
+	chunkUncompressed = 0x01
+	chunkPadding      = 0xfe
+	chunkStreamID     = 0xff
+
+	// maxBlockSize is the maximum size of the uncompressed data of a chunk
+	maxBlockSize = 65536
+	// maxEncodedBlockSize is the maximum size of a compressed block of maxBlockSize bytes
+	maxEncodedBlockSize = 32 + maxBlockSize + maxBlockSize/6
+)
+
+var streamID = []byte{chunkStreamID, 0x06, 0x00, 0x00, 's', 'N', 'a', 'P', 'p', 'Y'}
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+func maskedCRC(b []byte) uint32 {
+	c := crc32.Checksum(b, crcTable)
+	return (c>>15 | c<<17) + 0xa282ead8
+}
+
+// writeFramed writes the data in the snappy framing format
+func writeFramed(w io.Writer, data []byte) error {
+	if _, err := w.Write(streamID); err != nil {
+		return err
+	}
+	for len(data) != 0 {
+		block := data
+		if len(block) > maxBlockSize {
+			block = block[:maxBlockSize]
+		}
+		data = data[len(block):]
+
+		kind, body := byte(chunkUncompressed), block
+		// the compressed block is only used if it saves at least 12.5%
+		if compressed := encodeBlock(nil, block); len(compressed) < len(block)-len(block)/8 {
+			kind, body = chunkCompressed, compressed
+		}
+		header := make([]byte, 8)
+		size := len(body) + 4
+		header[0], header[1], header[2], header[3] = kind, byte(size), byte(size>>8), byte(size>>16)
+		binary.LittleEndian.PutUint32(header[4:], maskedCRC(block))
+		if _, err := w.Write(header); err != nil {
+			return err
+		}
+		if _, err := w.Write(body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFramed reads a snappy framed stream with exactly size bytes of uncompressed data.
+// It does not read anything after the last chunk of the data.
+func readFramed(r io.Reader, size uint64) ([]byte, error) {
+	res := make([]byte, 0, size)
+	header := make([]byte, 4)
+	first := true
+	for first || uint64(len(res)) < size {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		kind := header[0]
+		length := int(header[1]) | int(header[2])<<8 | int(header[3])<<16
+		if first && kind != chunkStreamID {
+			return nil, errSnappyCorrupt
+		}
+		first = false
+
+		switch {
+		case kind == chunkCompressed || kind == chunkUncompressed:
+			if length < 4 || length > 4+maxEncodedBlockSize {
+				return nil, errSnappyCorrupt
+			}
+		case kind == chunkStreamID:
+			if length != len(streamID)-4 {
+				return nil, errSnappyCorrupt
+			}
+		case kind < 0x80:
+			// reserved unskippable chunk
+			return nil, errSnappyCorrupt
+		}
+		body := make([]byte, length)
+		if _, err := io.ReadFull(r, body); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+
+		var block []byte
+		switch kind {
+		case chunkStreamID:
+			if string(body) != string(streamID[4:]) {
+				return nil, errSnappyCorrupt
+			}
+			continue
+		case chunkCompressed:
+			var err error
+			if block, err = decodeBlock(body[4:], size-uint64(len(res))); err != nil {
+				return nil, err
+			}
+		case chunkUncompressed:
+			block = body[4:]
+		default:
+			// padding and skippable chunks
+			continue
+		}
+		if len(block) > maxBlockSize {
+			return nil, errSnappyCorrupt
+		}
+		if binary.LittleEndian.Uint32(body[:4]) != maskedCRC(block) {
+			return nil, errSnappyChecksum
+		}
+		if uint64(len(res)+len(block)) > size {
+			return nil, errSnappyTooLarge
+		}
+		res = append(res, block...)
+	}
+	return res, nil
+}
+
+// decodeBlock decodes a snappy block with at most max bytes of decoded data
+func decodeBlock(src []byte, max uint64) ([]byte, error) {
+	length, n := binary.Uvarint(src)
+	if n <= 0 || length > maxBlockSize {
+		return nil, errSnappyCorrupt
+	}
+	if length > max {
+		return nil, errSnappyTooLarge
+	}
+	src = src[n:]
+	dst := make([]byte, 0, length)
+
+	for len(src) != 0 {
+		tag := src[0]
+		switch tag & 0x03 {
+		case 0x00:
+			// literal
+			x := uint64(tag >> 2)
+			src = src[1:]
+			if x >= 60 {
+				num := int(x - 59)
+				if len(src) < num {
+					return nil, errSnappyCorrupt
+				}
+				x = 0
+				for i := 0; i < num; i++ {
+					x |= uint64(src[i]) << (8 * uint(i))
+				}
+				src = src[num:]
+			}
+			size := x + 1
+			if uint64(len(src)) < size || uint64(len(dst))+size > length {
+				return nil, errSnappyCorrupt
+			}
+			dst = append(dst, src[:size]...)
+			src = src[size:]
+			continue
+
+		case 0x01:
+			if len(src) < 2 {
+				return nil, errSnappyCorrupt
+			}
+			size := 4 + int(tag>>2&0x07)
+			offset := int(tag&0xe0)<<3 | int(src[1])
+			src = src[2:]
+			if err := copyMatch(&dst, offset, size, length); err != nil {
+				return nil, err
+			}
+
+		case 0x02:
+			if len(src) < 3 {
+				return nil, errSnappyCorrupt
+			}
+			size := 1 + int(tag>>2)
+			offset := int(binary.LittleEndian.Uint16(src[1:3]))
+			src = src[3:]
+			if err := copyMatch(&dst, offset, size, length); err != nil {
+				return nil, err
+			}
+
+		case 0x03:
+			if len(src) < 5 {
+				return nil, errSnappyCorrupt
+			}
+			size := 1 + int(tag>>2)
+			offset := int(binary.LittleEndian.Uint32(src[1:5]))
+			src = src[5:]
+			if err := copyMatch(&dst, offset, size, length); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if uint64(len(dst)) != length {
+		return nil, errSnappyCorrupt
+	}
+	return dst, nil
+}
+
+// copyMatch appends the size bytes that start offset bytes before the end, which may overlap
+func copyMatch(dst *[]byte, offset, size int, length uint64) error {
+	d := *dst
+	if offset <= 0 || offset > len(d) || uint64(len(d)+size) > length {
+		return errSnappyCorrupt
+	}
+	for i := 0; i < size; i++ {
+		d = append(d, d[len(d)-offset])
+	}
+	*dst = d
+	return nil
+}
+
+// encodeBlock appends the snappy block encoding of the src, which has at most maxBlockSize bytes
+func encodeBlock(dst, src []byte) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	dst = append(dst, tmp[:binary.PutUvarint(tmp[:], uint64(len(src)))]...)
+
+	const tableBits = 14
+	// positions plus one of the last seen 4 bytes sequences
+	var table [1 << tableBits]int32
+	hash := func(u uint32) uint32 {
+		return (u * 0x1e35a7bd) >> (32 - tableBits)
+	}
+	load := func(i int) uint32 {
+		return binary.LittleEndian.Uint32(src[i:])
+	}
+
+	lit, s := 0, 0
+	for s+4 <= len(src) {
+		h := hash(load(s))
+		cand := int(table[h]) - 1
+		table[h] = int32(s + 1)
+		if cand < 0 || s-cand > 65535 || load(cand) != load(s) {
+			s++
+			continue
+		}
+		dst = emitLiteral(dst, src[lit:s])
+		offset, size := s-cand, 4
+		for s+size < len(src) && src[s+size] == src[cand+size] {
+			size++
+		}
+		dst = emitCopy(dst, offset, size)
+		s += size
+		lit = s
+	}
+	return emitLiteral(dst, src[lit:])
+}
+
+func emitLiteral(dst, lit []byte) []byte {
+	if len(lit) == 0 {
+		return dst
+	}
+	n := len(lit) - 1
+	switch {
+	case n < 60:
+		dst = append(dst, byte(n)<<2)
+	case n < 1<<8:
+		dst = append(dst, 60<<2, byte(n))
+	case n < 1<<16:
+		dst = append(dst, 61<<2, byte(n), byte(n>>8))
+	default:
+		dst = append(dst, 62<<2, byte(n), byte(n>>8), byte(n>>16))
+	}
+	return append(dst, lit...)
+}
+
+// emitCopy appends copies with 2 bytes offsets of at most 64 bytes
+func emitCopy(dst []byte, offset, length int) []byte {
+	for length > 0 {
+		size := length
+		if size > 64 {
+			size = 64
+		}
+		dst = append(dst, byte(size-1)<<2|0x02, byte(offset), byte(offset>>8))
+		length -= size
+	}
+	return dst
+}
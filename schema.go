@@ -0,0 +1,441 @@
+package ssz
+
+import (
+	"fmt"
+	"math"
+)
+
+// ErrInvalidSelector is returned when the selector of an union is not one of its options
+var ErrInvalidSelector = fmt.Errorf("invalid union selector")
+
+// Kind is the kind of SSZ type described by a Schema
+type Kind int
+
+const (
+	// KindUint is an uint of Size bytes
+	KindUint Kind = iota
+	// KindBool is a boolean
+	KindBool
+	// KindByteVector is a fixed array of Size bytes
+	KindByteVector
+	// KindByteList is a list of at most Max bytes
+	KindByteList
+	// KindBitVector is a bitvector of Size bits
+	KindBitVector
+	// KindBitList is a bitlist of at most Max bits
+	KindBitList
+	// KindVector is a vector of Size elements
+	KindVector
+	// KindList is a list of at most Max elements
+	KindList
+	// KindContainer is a container with Fields
+	KindContainer
+	// KindUnion is an union of the Options
+	KindUnion
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindUint:
+		return "uint"
+	case KindBool:
+		return "bool"
+	case KindByteVector:
+		return "byte vector"
+	case KindByteList:
+		return "byte list"
+	case KindBitVector:
+		return "bitvector"
+	case KindBitList:
+		return "bitlist"
+	case KindVector:
+		return "vector"
+	case KindList:
+		return "list"
+	case KindContainer:
+		return "container"
+	case KindUnion:
+		return "union"
+	default:
+		panic(fmt.Errorf("kind %d not found", k))
+	}
+}
+
+// Schema describes a SSZ type at runtime. It is used to work with SSZ values without
+// their generated Go structs (i.e. the views of a backing tree).
+type Schema struct {
+	Kind Kind
+	// Size is the number of bytes of an uint or a byte vector, the number of
+	// elements of a vector or the number of bits of a bitvector
+	Size uint64
+	// Max is the limit of a list, a byte list or a bitlist
+	Max uint64
+	// Elem is the schema of the elements of a vector or a list
+	Elem *Schema
+	// Name of a container
+	Name string
+	// Fields of a container
+	Fields []*Field
+	// Options of an union, the first one is nil for the None option
+	Options []*Schema
+	// Progressive is set for the lists and bitlists without a limit
+	// whose contents are merkleized progressively (EIP-7916)
+	Progressive bool
+}
+
+// Field is a field of a container schema
+type Field struct {
+	Name   string
+	Schema *Schema
+}
+
+// SchemaProvider is the interface implemented by the types that describe their SSZ schema
+type SchemaProvider interface {
+	SchemaSSZ() *Schema
+}
+
+// FieldInfo is the layout of a field of a container
+type FieldInfo struct {
+	// Name is the name of the field in the specs
+	Name string
+	// Type is the SSZ type of the field (i.e. List[uint64, 16])
+	Type string
+	// Size is the size of the field in the fixed part, which is an offset for the variable fields
+	Size uint64
+	// Variable is set for the fields whose size is not fixed
+	Variable bool
+	// Limit is the limit of a list, a byte list or a bitlist, zero if there is none
+	Limit uint64
+	// Offset is the position of the field in the fixed part
+	Offset uint64
+	// Gindex is the generalized index of the field in the tree of the container
+	Gindex uint64
+}
+
+// FieldsProvider is the interface implemented by the generated types that describe the layout of their fields
+type FieldsProvider interface {
+	SSZFields() []FieldInfo
+}
+
+// UintSchema returns the schema of an uint of size bytes (1, 2, 4 or 8)
+func UintSchema(size uint64) *Schema {
+	return &Schema{Kind: KindUint, Size: size}
+}
+
+// BoolSchema returns the schema of a boolean
+func BoolSchema() *Schema {
+	return &Schema{Kind: KindBool, Size: 1}
+}
+
+// ByteVectorSchema returns the schema of a fixed array of size bytes
+func ByteVectorSchema(size uint64) *Schema {
+	return &Schema{Kind: KindByteVector, Size: size}
+}
+
+// ByteListSchema returns the schema of a list of at most max bytes
+func ByteListSchema(max uint64) *Schema {
+	return &Schema{Kind: KindByteList, Max: max}
+}
+
+// BitvectorSchema returns the schema of a bitvector of size bits
+func BitvectorSchema(size uint64) *Schema {
+	return &Schema{Kind: KindBitVector, Size: size}
+}
+
+// BitlistSchema returns the schema of a bitlist of at most max bits
+func BitlistSchema(max uint64) *Schema {
+	return &Schema{Kind: KindBitList, Max: max}
+}
+
+// VectorSchema returns the schema of a vector of size elements
+func VectorSchema(elem *Schema, size uint64) *Schema {
+	return &Schema{Kind: KindVector, Elem: elem, Size: size}
+}
+
+// ListSchema returns the schema of a list of at most max elements
+func ListSchema(elem *Schema, max uint64) *Schema {
+	return &Schema{Kind: KindList, Elem: elem, Max: max}
+}
+
+// ProgressiveListSchema returns the schema of a list without limit that is merkleized progressively
+func ProgressiveListSchema(elem *Schema) *Schema {
+	return &Schema{Kind: KindList, Elem: elem, Progressive: true}
+}
+
+// ProgressiveBitlistSchema returns the schema of a bitlist without limit that is merkleized progressively
+func ProgressiveBitlistSchema() *Schema {
+	return &Schema{Kind: KindBitList, Progressive: true}
+}
+
+// ContainerSchema returns the schema of a container
+func ContainerSchema(name string, fields ...*Field) *Schema {
+	return &Schema{Kind: KindContainer, Name: name, Fields: fields}
+}
+
+// UnionSchema returns the schema of an union with the options. The first option may be nil for None.
+func UnionSchema(options ...*Schema) *Schema {
+	return &Schema{Kind: KindUnion, Options: options}
+}
+
+// NewField returns a field of a container schema
+func NewField(name string, schema *Schema) *Field {
+	return &Field{Name: name, Schema: schema}
+}
+
+// IsBasic returns true if the schema is an uint or a boolean
+func (s *Schema) IsBasic() bool {
+	return s.Kind == KindUint || s.Kind == KindBool
+}
+
+// IsFixed returns true if the encoding of the type has always the same size
+func (s *Schema) IsFixed() bool {
+	switch s.Kind {
+	case KindByteList, KindBitList, KindList, KindUnion:
+		return false
+	case KindVector:
+		return s.Elem.IsFixed()
+	case KindContainer:
+		for _, f := range s.Fields {
+			if !f.Schema.IsFixed() {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+// FixedSize returns the size of the type in the fixed part of its parent,
+// the size of the encoding for the fixed types or an offset for the dynamic ones.
+func (s *Schema) FixedSize() uint64 {
+	if !s.IsFixed() {
+		return bytesPerLengthOffset
+	}
+	switch s.Kind {
+	case KindUint, KindBool, KindByteVector:
+		return s.Size
+	case KindBitVector:
+		return (s.Size + 7) / 8
+	case KindVector:
+		return s.Size * s.Elem.FixedSize()
+	case KindContainer:
+		size := uint64(0)
+		for _, f := range s.Fields {
+			size += f.Schema.FixedSize()
+		}
+		return size
+	default:
+		panic(fmt.Errorf("fixed size not implemented for %s", s.Kind))
+	}
+}
+
+// FieldIndex returns the position of the field of a container
+func (s *Schema) FieldIndex(name string) (int, bool) {
+	for indx, f := range s.Fields {
+		if f.Name == name {
+			return indx, true
+		}
+	}
+	return 0, false
+}
+
+// FieldsInfo returns the layout of the fields of a container schema
+func (s *Schema) FieldsInfo() []FieldInfo {
+	res := make([]FieldInfo, 0, len(s.Fields))
+	offset := uint64(0)
+	for indx, f := range s.Fields {
+		info := FieldInfo{
+			Name:     f.Name,
+			Type:     f.Schema.String(),
+			Size:     f.Schema.FixedSize(),
+			Variable: !f.Schema.IsFixed(),
+			Offset:   offset,
+			Gindex:   uint64(1)<<s.depth() | uint64(indx),
+		}
+		if f.Schema.hasMixin() && !f.Schema.Progressive {
+			info.Limit = f.Schema.Max
+		}
+		res = append(res, info)
+		offset += info.Size
+	}
+	return res
+}
+
+// option returns the schema of the option of an union, which is nil for None
+func (s *Schema) option(selector uint64) (*Schema, error) {
+	if selector > 127 || selector >= uint64(len(s.Options)) || (s.Options[selector] == nil && selector != 0) {
+		return nil, ErrInvalidSelector
+	}
+	return s.Options[selector], nil
+}
+
+// hasMixin returns true if the root of the type mixes in its length
+func (s *Schema) hasMixin() bool {
+	return s.Kind == KindByteList || s.Kind == KindBitList || s.Kind == KindList
+}
+
+// isPacked returns true if the elements of the vector or list are packed in chunks
+func (s *Schema) isPacked() bool {
+	return (s.Kind == KindVector || s.Kind == KindList) && s.Elem.IsBasic()
+}
+
+// ChunkCount returns the number of chunks of the tree of the contents, which
+// for the lists is the number of chunks of the list with the maximum length.
+func (s *Schema) ChunkCount() uint64 {
+	return s.chunkCount(32)
+}
+
+// chunkCount returns the number of chunks of the tree of the contents with chunks of the given size
+func (s *Schema) chunkCount(chunkSize uint64) uint64 {
+	bitsPerChunk := 8 * chunkSize
+	switch s.Kind {
+	case KindUint, KindBool:
+		return 1
+	case KindByteVector:
+		return (s.Size + chunkSize - 1) / chunkSize
+	case KindByteList:
+		return (s.Max + chunkSize - 1) / chunkSize
+	case KindBitVector:
+		return (s.Size + bitsPerChunk - 1) / bitsPerChunk
+	case KindBitList:
+		return (s.Max + bitsPerChunk - 1) / bitsPerChunk
+	case KindVector:
+		if s.Elem.IsBasic() {
+			return (s.Size*s.Elem.Size + chunkSize - 1) / chunkSize
+		}
+		return s.Size
+	case KindList:
+		if s.Elem.IsBasic() {
+			return (s.Max*s.Elem.Size + chunkSize - 1) / chunkSize
+		}
+		return s.Max
+	case KindContainer:
+		return uint64(len(s.Fields))
+	case KindUnion:
+		// the root of the value is mixed in with the selector
+		return 1
+	default:
+		panic(fmt.Errorf("chunk count not implemented for %s", s.Kind))
+	}
+}
+
+// MinSize returns the size of the smallest encoding of the type
+func (s *Schema) MinSize() uint64 {
+	if s.IsFixed() {
+		return s.FixedSize()
+	}
+	switch s.Kind {
+	case KindBitList:
+		// the length bit
+		return 1
+	case KindVector:
+		return s.Size * (bytesPerLengthOffset + s.Elem.MinSize())
+	case KindContainer:
+		return s.sumFields((*Schema).MinSize)
+	case KindUnion:
+		min := uint64(0)
+		for indx, opt := range s.Options {
+			size := uint64(0)
+			if opt != nil {
+				size = opt.MinSize()
+			}
+			if indx == 0 || size < min {
+				min = size
+			}
+		}
+		return 1 + min
+	default:
+		return 0
+	}
+}
+
+// MaxSize returns the size of the largest encoding of the type, which
+// is math.MaxUint64 if the type or any of its elements is progressive
+func (s *Schema) MaxSize() uint64 {
+	if s.IsFixed() {
+		return s.FixedSize()
+	}
+	if s.Progressive {
+		return math.MaxUint64
+	}
+	switch s.Kind {
+	case KindByteList:
+		return s.Max
+	case KindBitList:
+		return s.Max/8 + 1
+	case KindVector:
+		return mulSize(s.Size, addSize(bytesPerLengthOffset, s.Elem.MaxSize()))
+	case KindList:
+		if s.Elem.IsFixed() {
+			return mulSize(s.Max, s.Elem.FixedSize())
+		}
+		return mulSize(s.Max, addSize(bytesPerLengthOffset, s.Elem.MaxSize()))
+	case KindContainer:
+		return s.sumFields((*Schema).MaxSize)
+	case KindUnion:
+		max := uint64(0)
+		for _, opt := range s.Options {
+			if opt != nil && opt.MaxSize() > max {
+				max = opt.MaxSize()
+			}
+		}
+		return addSize(1, max)
+	default:
+		panic(fmt.Errorf("max size not implemented for %s", s.Kind))
+	}
+}
+
+// sumFields adds the sizes of the fields of a container, the dynamic ones with their offset
+func (s *Schema) sumFields(size func(*Schema) uint64) uint64 {
+	res := uint64(0)
+	for _, f := range s.Fields {
+		res = addSize(res, size(f.Schema))
+		if !f.Schema.IsFixed() {
+			res = addSize(res, bytesPerLengthOffset)
+		}
+	}
+	return res
+}
+
+// addSize adds two sizes, it returns math.MaxUint64 if the sum overflows
+func addSize(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}
+
+// mulSize multiplies two sizes, it returns math.MaxUint64 if the product overflows
+func mulSize(a, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint64/a {
+		return math.MaxUint64
+	}
+	return a * b
+}
+
+// depth returns the depth of the tree of the contents
+func (s *Schema) depth() uint8 {
+	return getDepth(s.ChunkCount())
+}
+
+// Equal returns true if both schemas describe the same type
+func (s *Schema) Equal(o *Schema) bool {
+	if s.Kind != o.Kind || s.Size != o.Size || s.Max != o.Max || s.Progressive != o.Progressive || len(s.Fields) != len(o.Fields) || len(s.Options) != len(o.Options) {
+		return false
+	}
+	if (s.Elem == nil) != (o.Elem == nil) || (s.Elem != nil && !s.Elem.Equal(o.Elem)) {
+		return false
+	}
+	for indx, f := range s.Fields {
+		if f.Name != o.Fields[indx].Name || !f.Schema.Equal(o.Fields[indx].Schema) {
+			return false
+		}
+	}
+	for indx, opt := range s.Options {
+		if other := o.Options[indx]; (opt == nil) != (other == nil) || (opt != nil && !opt.Equal(other)) {
+			return false
+		}
+	}
+	return true
+}
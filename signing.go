@@ -0,0 +1,81 @@
+package ssz
+
+// ForkData is the container of the consensus specs hashed to compute the fork digests and the domains
+type ForkData struct {
+	CurrentVersion        [4]byte
+	GenesisValidatorsRoot [32]byte
+}
+
+// HashTreeRoot returns the root of the ForkData
+func (f *ForkData) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith appends the root of the ForkData to the Hasher
+func (f *ForkData) HashTreeRootWith(hh *Hasher) error {
+	indx := hh.Index()
+	hh.PutBytes(f.CurrentVersion[:])
+	hh.PutBytes(f.GenesisValidatorsRoot[:])
+	hh.Merkleize(indx)
+	return nil
+}
+
+// SigningData is the container of the consensus specs with the root of an object and the domain of its signature
+type SigningData struct {
+	ObjectRoot [32]byte
+	Domain     [32]byte
+}
+
+// HashTreeRoot returns the root of the SigningData
+func (s *SigningData) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith appends the root of the SigningData to the Hasher
+func (s *SigningData) HashTreeRootWith(hh *Hasher) error {
+	indx := hh.Index()
+	hh.PutBytes(s.ObjectRoot[:])
+	hh.PutBytes(s.Domain[:])
+	hh.Merkleize(indx)
+	return nil
+}
+
+// ComputeForkDataRoot returns the root of the ForkData of the fork version and the genesis validators root
+func ComputeForkDataRoot(version [4]byte, genesisValidatorsRoot [32]byte) ([32]byte, error) {
+	return (&ForkData{CurrentVersion: version, GenesisValidatorsRoot: genesisValidatorsRoot}).HashTreeRoot()
+}
+
+// ComputeForkDigest returns the digest of a fork, the first 4 bytes of the root of the ForkData,
+// which is used in the p2p topics and the context bytes of the req/resp protocols
+func ComputeForkDigest(version [4]byte, genesisValidatorsRoot [32]byte) ([4]byte, error) {
+	var digest [4]byte
+	root, err := ComputeForkDataRoot(version, genesisValidatorsRoot)
+	if err != nil {
+		return digest, err
+	}
+	copy(digest[:], root[:4])
+	return digest, nil
+}
+
+// ComputeDomain returns the domain of the signatures of a domain type in a fork, which
+// is the domain type followed by the first 28 bytes of the root of the ForkData
+func ComputeDomain(domainType [4]byte, version [4]byte, genesisValidatorsRoot [32]byte) ([32]byte, error) {
+	root, err := ComputeForkDataRoot(version, genesisValidatorsRoot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	var domain [32]byte
+	copy(domain[:4], domainType[:])
+	copy(domain[4:], root[:28])
+	return domain, nil
+}
+
+// ComputeSigningRoot returns the root that is signed for the object in the domain,
+// which is the root of the SigningData with the root of the object
+func ComputeSigningRoot(obj HashRoot, domain [32]byte) ([32]byte, error) {
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return (&SigningData{ObjectRoot: root, Domain: domain}).HashTreeRoot()
+}
@@ -0,0 +1,148 @@
+package ssz
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// SizeProfile records the sizes of the encodings of the fields of the objects of a schema,
+// to find the fields that take most of the bytes of an object or a stream of objects.
+// The fields of the elements of the lists and vectors of containers are added up with
+// the index [*] in their path (i.e. body.attestations[*].signature).
+type SizeProfile struct {
+	schema *Schema
+	count  uint64
+	total  uint64
+	min    uint64
+	max    uint64
+	fields []*FieldSize
+	// sizes of the fields in the object being added, by path
+	cur map[string]uint64
+}
+
+// FieldSize are the sizes of the encoding of a field in the objects of a profile. The
+// size of a variable field does not include its offset, which is part of its container.
+type FieldSize struct {
+	Path string
+	// Total is the number of bytes of the field in all the objects
+	Total uint64
+	// Min and Max are the smallest and the largest number of bytes of the field in an object
+	Min, Max uint64
+}
+
+// NewSizeProfile returns an empty profile of the objects of the container schema
+func NewSizeProfile(s *Schema) *SizeProfile {
+	p := &SizeProfile{schema: s, cur: map[string]uint64{}}
+	p.addFields(s, "")
+	return p
+}
+
+func (p *SizeProfile) addFields(s *Schema, prefix string) {
+	for _, f := range s.Fields {
+		path := prefix + f.Name
+		p.fields = append(p.fields, &FieldSize{Path: path})
+		if sub := f.Schema; sub.Kind == KindContainer {
+			p.addFields(sub, path+".")
+		} else if (sub.Kind == KindVector || sub.Kind == KindList) && sub.Elem.Kind == KindContainer {
+			p.addFields(sub.Elem, path+"[*].")
+		}
+	}
+}
+
+// Add records the sizes of the fields of an encoded object. The profile does not change if the encoding is not valid.
+func (p *SizeProfile) Add(buf []byte) error {
+	for path := range p.cur {
+		delete(p.cur, path)
+	}
+	if err := p.record(p.schema, buf, ""); err != nil {
+		return err
+	}
+	size := uint64(len(buf))
+	if p.count == 0 || size < p.min {
+		p.min = size
+	}
+	if size > p.max {
+		p.max = size
+	}
+	for _, f := range p.fields {
+		n := p.cur[f.Path]
+		if p.count == 0 || n < f.Min {
+			f.Min = n
+		}
+		if n > f.Max {
+			f.Max = n
+		}
+		f.Total += n
+	}
+	p.count++
+	p.total += size
+	return nil
+}
+
+func (p *SizeProfile) record(s *Schema, buf []byte, prefix string) error {
+	parts, err := s.splitFields(buf)
+	if err != nil {
+		return err
+	}
+	for i, f := range s.Fields {
+		path := prefix + f.Name
+		p.cur[path] += uint64(len(parts[i]))
+
+		sub := f.Schema
+		if sub.Kind == KindContainer {
+			if err := p.record(sub, parts[i], path+"."); err != nil {
+				return err
+			}
+		} else if (sub.Kind == KindVector || sub.Kind == KindList) && sub.Elem.Kind == KindContainer {
+			elems, err := sub.splitElems(parts[i])
+			if err != nil {
+				return err
+			}
+			for _, elem := range elems {
+				if err := p.record(sub.Elem, elem, path+"[*]."); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Count returns the number of objects added to the profile
+func (p *SizeProfile) Count() uint64 {
+	return p.count
+}
+
+// Fields returns the sizes of the fields, the nested fields follow their container
+func (p *SizeProfile) Fields() []FieldSize {
+	res := make([]FieldSize, 0, len(p.fields))
+	for _, f := range p.fields {
+		res = append(res, *f)
+	}
+	return res
+}
+
+// Report writes the breakdown of the sizes of the objects by field with the share of
+// the total bytes of each field
+func (p *SizeProfile) Report(w io.Writer) error {
+	fmt.Fprintf(w, "%s: %d objects, %d bytes", p.schema.Name, p.count, p.total)
+	if p.count != 0 {
+		fmt.Fprintf(w, " (%d to %d bytes, %d on average)", p.min, p.max, p.total/p.count)
+	}
+	fmt.Fprint(w, "\n\n")
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "FIELD\tTOTAL\tSHARE\tAVERAGE\tMIN\tMAX")
+	for _, f := range p.fields {
+		share, avg := 0.0, uint64(0)
+		if p.count != 0 {
+			avg = f.Total / p.count
+		}
+		if p.total != 0 {
+			share = 100 * float64(f.Total) / float64(p.total)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%.1f%%\t%d\t%d\t%d\n", f.Path, f.Total, share, avg, f.Min, f.Max)
+	}
+	return tw.Flush()
+}
@@ -34,6 +34,14 @@ func deepEqualImpl(v1, v2 reflect.Value, depth int) bool {
 		}
 		return true
 
+	case reflect.Array:
+		for i := 0; i < v1.Len(); i++ {
+			if !deepEqualImpl(v1.Index(i), v2.Index(i), depth+1) {
+				return false
+			}
+		}
+		return true
+
 	case reflect.Ptr:
 		if v1.Pointer() == v2.Pointer() {
 			return true
@@ -0,0 +1,75 @@
+//go:build go1.18
+// +build go1.18
+
+package spectests
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// The fuzz targets decode the input with the generated code and, if it is accepted, check that
+// it is encoded back without errors and that the runtime schema decodes it to the same root.
+// The corpus of each target in testdata/fuzz/FuzzXxx runs with 'go test' and has the inputs
+// that failed before. The crashers found with 'go test -fuzz FuzzXxx' are written there.
+
+func FuzzAttestation(f *testing.F)        { fuzzUnmarshal(f, "Attestation") }
+func FuzzAttesterSlashing(f *testing.F)   { fuzzUnmarshal(f, "AttesterSlashing") }
+func FuzzBeaconBlock(f *testing.F)        { fuzzUnmarshal(f, "BeaconBlock") }
+func FuzzBeaconBlockBody(f *testing.F)    { fuzzUnmarshal(f, "BeaconBlockBody") }
+func FuzzBeaconState(f *testing.F)        { fuzzUnmarshal(f, "BeaconState") }
+func FuzzIndexedAttestation(f *testing.F) { fuzzUnmarshal(f, "IndexedAttestation") }
+func FuzzPendingAttestation(f *testing.F) { fuzzUnmarshal(f, "PendingAttestation") }
+func FuzzSignedBeaconBlock(f *testing.F)  { fuzzUnmarshal(f, "SignedBeaconBlock") }
+
+func fuzzUnmarshal(f *testing.F, name string) {
+	// the embedded spec tests are the valid seeds
+	for _, c := range walkPath(f, filepath.Join(embeddedTestsPath, name)) {
+		buf, err := ioutil.ReadFile(filepath.Join(c, serializedFile))
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(buf)
+	}
+
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		obj := codecs[name]()
+		if err := obj.UnmarshalSSZ(buf); err != nil {
+			return
+		}
+		dst, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatalf("decoded object cannot be encoded: %v", err)
+		}
+		obj2 := codecs[name]()
+		if err := obj2.UnmarshalSSZ(dst); err != nil {
+			t.Fatalf("encoding cannot be decoded: %v", err)
+		}
+		if !deepEqual(obj, obj2) {
+			t.Fatal("encoding decodes to a different object")
+		}
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("decoded object cannot be hashed: %v", err)
+		}
+
+		value, err := ssz.UnmarshalValue(obj.SchemaSSZ(), buf)
+		if err != nil {
+			t.Fatalf("input rejected by the runtime schema: %v", err)
+		}
+		valueRoot, err := value.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != valueRoot {
+			t.Fatal("different root with the runtime schema")
+		}
+		if valueEnc, err := value.MarshalSSZ(); err != nil || !bytes.Equal(valueEnc, dst) {
+			t.Fatal("different encoding with the runtime schema")
+		}
+	})
+}
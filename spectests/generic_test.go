@@ -0,0 +1,45 @@
+//go:build go1.18
+// +build go1.18
+
+package spectests
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+func TestGenericFunctions(t *testing.T) {
+	obj := RandomBeaconBlock(rand.New(rand.NewSource(1)))
+
+	buf, err := ssz.MarshalOf(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != cap(buf) || len(buf) != obj.SizeSSZ() {
+		t.Fatal("the buffer does not have the size of the object")
+	}
+	res, err := ssz.UnmarshalOf[BeaconBlock](buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dst, err := res.MarshalSSZ(); err != nil || !bytes.Equal(dst, buf) {
+		t.Fatal("bad unmarshal")
+	}
+	root, err := ssz.HashTreeRootOf(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("wrong root")
+	}
+	if _, err := ssz.UnmarshalOf[BeaconBlock](buf[:len(buf)-1]); err == nil {
+		t.Fatal("expected an error for a truncated input")
+	}
+}
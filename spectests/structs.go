@@ -1,5 +1,11 @@
 package spectests
 
+import (
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
 type AggregateAndProof struct {
 	Index          uint64       `json:"aggregator_index"`
 	Aggregate      *Attestation `json:"aggregate"`
@@ -20,7 +26,7 @@ type AttestationData struct {
 }
 
 type Attestation struct {
-	AggregationBits []byte           `json:"aggregation_bits" ssz:"bitlist"`
+	AggregationBits []byte           `json:"aggregation_bits" ssz:"bitlist" ssz-max:"2048"`
 	Data            *AttestationData `json:"data"`
 	Signature       []byte           `json:"signature" ssz-size:"96"`
 }
@@ -50,7 +56,7 @@ type IndexedAttestation struct {
 }
 
 type PendingAttestation struct {
-	AggregationBits []byte           `json:"aggregation_bits" ssz-max:"2048"`
+	AggregationBits []byte           `json:"aggregation_bits" ssz:"bitlist" ssz-max:"2048"`
 	Data            *AttestationData `json:"data"`
 	InclusionDelay  uint64           `json:"inclusion_delay"`
 	ProposerIndex   uint64           `json:"proposer_index"`
@@ -132,7 +138,7 @@ type BeaconState struct {
 
 	PreviousEpochAttestations []*PendingAttestation `json:"previous_epoch_attestations" ssz-max:"4096"`
 	CurrentEpochAttestations  []*PendingAttestation `json:"current_epoch_attestations" ssz-max:"4096"`
-	JustificationBits         []byte                `json:"justification_bits" ssz-size:"1"`
+	JustificationBits         bitfield.Bitvector4   `json:"justification_bits" ssz-size:"1"`
 
 	PreviousJustifiedCheckpoint *Checkpoint `json:"previous_justified_checkpoint"`
 	CurrentJustifiedCheckpoint  *Checkpoint `json:"current_justified_checkpoint"`
@@ -183,3 +189,104 @@ type BeaconBlockHeader struct {
 	StateRoot  []byte `json:"state_root" ssz-size:"32"`
 	BodyRoot   []byte `json:"body_root" ssz-size:"32"`
 }
+
+// ForkedHeader has a field added in altair and a field removed in capella
+type ForkedHeader struct {
+	Slot       uint64 `json:"slot"`
+	ParentRoot []byte `json:"parent_root" ssz-size:"32"`
+	Extra      []byte `json:"extra" ssz-max:"32" ssz-fork:"altair+"`
+	Old        uint64 `json:"old" ssz-fork:"phase0-bellatrix"`
+}
+
+// Blob has large fixed and variable byte fields, as the blob sidecars
+type Blob struct {
+	Index      uint64 `json:"index"`
+	Blob       []byte `json:"blob" ssz-size:"131072"`
+	Commitment []byte `json:"kzg_commitment" ssz-size:"48"`
+	Data       []byte `json:"data" ssz-max:"1048576"`
+}
+
+// CommitteeBits has the aggregation bits of each committee of a slot
+type CommitteeBits struct {
+	Slot     uint64             `json:"slot"`
+	Bits     []bitfield.Bitlist `json:"bits" ssz-max:"64,2048"`
+	Previous []bitfield.Bitlist `json:"previous" ssz-size:"4,?" ssz-max:"?,2048"`
+	// Justifications are the justification bits of the last epochs
+	Justifications []bitfield.Bitvector4 `json:"justifications" ssz-max:"16"`
+}
+
+// OptionalBytes has pointers to byte slices, as some legacy types for the optional fields
+type OptionalBytes struct {
+	Root  *[]byte `json:"root" ssz-size:"32"`
+	Extra *[]byte `json:"extra" ssz-max:"256"`
+}
+
+// SlotRoots has the block roots of each slot of an epoch
+type SlotRoots struct {
+	Epoch   uint64     `json:"epoch"`
+	PerSlot [][][]byte `json:"per_slot" ssz-size:"?,?,32" ssz-max:"32,16"`
+	Blobs   [][][]byte `json:"blobs" ssz-size:"2,?" ssz-max:"?,4,64"`
+	Parents [][]byte   `json:"parents" ssz-size:"?,32" ssz-max:"1024,?"`
+}
+
+// Transactions has a list of dynamic byte lists, as the transactions of an execution payload
+type Transactions struct {
+	BlockNumber  uint64   `json:"block_number"`
+	Transactions [][]byte `json:"transactions" ssz-size:"?,?" ssz-max:"1048576,1073741824"`
+}
+
+// Votes has lists and vectors of booleans, encoded with a byte per element
+type Votes struct {
+	Voted    []bool   `json:"voted" ssz-max:"64"`
+	Quorum   []bool   `json:"quorum" ssz-size:"4"`
+	Rounds   [][]bool `json:"rounds" ssz-max:"8,16"`
+	Approved bool     `json:"approved"`
+}
+
+// Event has timestamps encoded as uint64 seconds and milliseconds since the unix epoch
+type Event struct {
+	ID      uint64    `json:"id"`
+	Created time.Time `json:"created" ssz:"unix"`
+	Updated time.Time `json:"updated" ssz:"unix-milli"`
+}
+
+// Hash is a named byte array, as the hashes of the execution types
+type Hash [32]byte
+
+// Arrays has byte arrays and slices of byte arrays, encoded as fixed bytes
+type Arrays struct {
+	Root    [32]byte   `json:"root"`
+	Hash    Hash       `json:"hash"`
+	Pubkeys [][48]byte `json:"pubkeys" ssz-max:"4"`
+	Hashes  []Hash     `json:"hashes" ssz-size:"2"`
+}
+
+// Outer has pointer receivers and it is nested by pointer in ByValue
+type Outer struct {
+	A uint64   `json:"a"`
+	B []uint64 `json:"b" ssz-max:"4"`
+}
+
+// ByValue has value receivers and a pointer to a struct with pointer receivers
+//
+//sszgen:value-receiver
+type ByValue struct {
+	A uint64 `json:"a"`
+	O *Outer `json:"o"`
+}
+
+// Inner has value receivers and it is stored by value in Values
+//
+//sszgen:value-receiver
+type Inner struct {
+	A uint64 `json:"a"`
+	B []byte `json:"b" ssz-max:"8"`
+}
+
+// Values has structs stored by value in its fields and lists
+type Values struct {
+	Checkpoint  Checkpoint   `json:"checkpoint"`
+	Inner       Inner        `json:"inner"`
+	Inners      []Inner      `json:"inners" ssz-max:"4"`
+	Checkpoints []Checkpoint `json:"checkpoints" ssz-size:"2"`
+}
@@ -3,8 +3,10 @@ package spectests
 
 import (
 	"fmt"
+	"math/rand"
 
 	ssz "github.com/ferranbt/fastssz"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
 )
 
 var (
@@ -33,8 +35,14 @@ func (a *AggregateAndProof) MarshalSSZTo(dst []byte) ([]byte, error) {
 	dst = ssz.MarshalUint64(dst, a.Index)
 
 	// Offset (1) 'Aggregate'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += a.Aggregate.SizeSSZ()
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	if a.Aggregate == nil {
+		offset += new(Attestation).SizeSSZ()
+	} else {
+		offset += a.Aggregate.SizeSSZ()
+	}
 
 	// Field (2) 'SelectionProof'
 	if dst, err = ssz.MarshalFixedBytes(dst, a.SelectionProof, 96); err != nil {
@@ -42,7 +50,47 @@ func (a *AggregateAndProof) MarshalSSZTo(dst []byte) ([]byte, error) {
 	}
 
 	// Field (1) 'Aggregate'
-	if dst, err = a.Aggregate.MarshalSSZTo(dst); err != nil {
+	if a.Aggregate == nil {
+		if dst, err = new(Attestation).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = a.Aggregate.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the AggregateAndProof object to the buffers, dst is the encoding since the last referenced field
+func (a *AggregateAndProof) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(108)
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, a.Index)
+
+	// Offset (1) 'Aggregate'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	if a.Aggregate == nil {
+		offset += new(Attestation).SizeSSZ()
+	} else {
+		offset += a.Aggregate.SizeSSZ()
+	}
+
+	// Field (2) 'SelectionProof'
+	if len(a.SelectionProof) != 96 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, a.SelectionProof)
+
+	// Field (1) 'Aggregate'
+	if a.Aggregate == nil {
+		if dst, err = new(Attestation).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(a.Aggregate, dst); err != nil {
 		return nil, err
 	}
 
@@ -64,12 +112,12 @@ func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
 	a.Index = ssz.UnmarshallUint64(buf[0:8])
 
 	// Offset (1) 'Aggregate'
-	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 108 {
 		return errOffset
 	}
 
 	// Field (2) 'SelectionProof'
-	a.SelectionProof = append(a.SelectionProof, buf[12:108]...)
+	a.SelectionProof = append(a.SelectionProof[:0], buf[12:108]...)
 
 	// Field (1) 'Aggregate'
 	{
@@ -84,16 +132,181 @@ func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZVerify ssz unmarshals the AggregateAndProof object and fails if the input is not its canonical encoding
+func (a *AggregateAndProof) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(a, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the AggregateAndProof object with the nested objects of the pool
+func (a *AggregateAndProof) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 108 {
+		return errSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Index'
+	a.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Aggregate'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 108 {
+		return errOffset
+	}
+
+	// Field (2) 'SelectionProof'
+	a.SelectionProof = append(a.SelectionProof[:0], buf[12:108]...)
+
+	// Field (1) 'Aggregate'
+	{
+		buf = tail[o1:]
+		if a.Aggregate == nil && pool != nil {
+			a.Aggregate, _ = pool.Get((*Attestation)(nil)).(*Attestation)
+		}
+		if a.Aggregate == nil {
+			a.Aggregate = new(Attestation)
+		}
+		if err = ssz.UnmarshalWithPool(a.Aggregate, buf, pool); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the AggregateAndProof object, the byte fields alias the input
+func (a *AggregateAndProof) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 108 {
+		return errSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Index'
+	a.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Aggregate'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 108 {
+		return errOffset
+	}
+
+	// Field (2) 'SelectionProof'
+	a.SelectionProof = ssz.Alias(buf[12:108])
+
+	// Field (1) 'Aggregate'
+	{
+		buf = tail[o1:]
+		if a.Aggregate == nil {
+			a.Aggregate = new(Attestation)
+		}
+		if err = ssz.UnmarshalNoCopy(a.Aggregate, buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the AggregateAndProof object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (a *AggregateAndProof) CopySSZ() (*AggregateAndProof, error) {
+	buf, err := a.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(AggregateAndProof)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the AggregateAndProof object
 func (a *AggregateAndProof) SizeSSZ() (size int) {
 	size = 108
 
 	// Field (1) 'Aggregate'
-	size += a.Aggregate.SizeSSZ()
+	if a.Aggregate == nil {
+		size += new(Attestation).SizeSSZ()
+	} else {
+		size += a.Aggregate.SizeSSZ()
+	}
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the AggregateAndProof object
+func (a *AggregateAndProof) MaxSizeSSZ() uint64 {
+	return 593
+}
+
+// HashTreeRoot ssz hashes the AggregateAndProof object
+func (a *AggregateAndProof) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AggregateAndProof object with a hasher
+func (a *AggregateAndProof) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(a.Index)
+
+	// Field (1) 'Aggregate'
+	if a.Aggregate == nil {
+		if err = new(Attestation).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = a.Aggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'SelectionProof'
+	if len(a.SelectionProof) != 96 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(a.SelectionProof)
 
+	hh.Merkleize(indx)
 	return
 }
 
+// SchemaSSZ returns the ssz schema of the AggregateAndProof object
+func (a *AggregateAndProof) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("AggregateAndProof",
+		ssz.NewField("aggregator_index", ssz.UintSchema(8)),
+		ssz.NewField("aggregate", new(Attestation).SchemaSSZ()),
+		ssz.NewField("selection_proof", ssz.ByteVectorSchema(96)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the AggregateAndProof object
+func (a *AggregateAndProof) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "aggregator_index", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "aggregate", Type: "Attestation", Size: 4, Variable: true, Limit: 0, Offset: 8, Gindex: 5},
+		{Name: "selection_proof", Type: "Bytes96", Size: 96, Variable: false, Limit: 0, Offset: 12, Gindex: 6},
+	}
+}
+
+// RandomAggregateAndProof returns a random AggregateAndProof object
+func RandomAggregateAndProof(rng *rand.Rand) *AggregateAndProof {
+	a := new(AggregateAndProof)
+	// Field (0) 'Index'
+	a.Index = rng.Uint64()
+
+	// Field (1) 'Aggregate'
+	a.Aggregate = RandomAttestation(rng)
+
+	// Field (2) 'SelectionProof'
+	a.SelectionProof = ssz.RandomBytes(rng, 96)
+
+	return a
+}
+
 // MarshalSSZ ssz marshals the Checkpoint object
 func (c *Checkpoint) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, c.SizeSSZ())
@@ -115,6 +328,22 @@ func (c *Checkpoint) MarshalSSZTo(dst []byte) ([]byte, error) {
 	return dst, err
 }
 
+// MarshalSSZToBuffers ssz marshals the Checkpoint object to the buffers, dst is the encoding since the last referenced field
+func (c *Checkpoint) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, c.Epoch)
+
+	// Field (1) 'Root'
+	if len(c.Root) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, c.Root)
+
+	return dst, err
+}
+
 // UnmarshalSSZ ssz unmarshals the Checkpoint object
 func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
 	var err error
@@ -127,17 +356,124 @@ func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
 	c.Epoch = ssz.UnmarshallUint64(buf[0:8])
 
 	// Field (1) 'Root'
-	c.Root = append(c.Root, buf[8:40]...)
+	c.Root = append(c.Root[:0], buf[8:40]...)
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Checkpoint object and fails if the input is not its canonical encoding
+func (c *Checkpoint) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(c, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Checkpoint object with the nested objects of the pool
+func (c *Checkpoint) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return errSize
+	}
+
+	// Field (0) 'Epoch'
+	c.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Root'
+	c.Root = append(c.Root[:0], buf[8:40]...)
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Checkpoint object, the byte fields alias the input
+func (c *Checkpoint) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return errSize
+	}
+
+	// Field (0) 'Epoch'
+	c.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Root'
+	c.Root = ssz.Alias(buf[8:40])
 
 	return err
 }
 
+// CopySSZ returns a copy of the Checkpoint object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (c *Checkpoint) CopySSZ() (*Checkpoint, error) {
+	buf, err := c.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Checkpoint)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Checkpoint object
-func (c *Checkpoint) SizeSSZ() (size int) {
-	size = 40
+func (c *Checkpoint) SizeSSZ() int {
+	return 40
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Checkpoint object
+func (c *Checkpoint) MaxSizeSSZ() uint64 {
+	return 40
+}
+
+// HashTreeRoot ssz hashes the Checkpoint object
+func (c *Checkpoint) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the Checkpoint object with a hasher
+func (c *Checkpoint) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(c.Epoch)
+
+	// Field (1) 'Root'
+	if len(c.Root) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(c.Root)
+
+	hh.Merkleize(indx)
 	return
 }
 
+// SchemaSSZ returns the ssz schema of the Checkpoint object
+func (c *Checkpoint) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Checkpoint",
+		ssz.NewField("epoch", ssz.UintSchema(8)),
+		ssz.NewField("root", ssz.ByteVectorSchema(32)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Checkpoint object
+func (c *Checkpoint) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "epoch", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 8, Gindex: 3},
+	}
+}
+
+// RandomCheckpoint returns a random Checkpoint object
+func RandomCheckpoint(rng *rand.Rand) *Checkpoint {
+	c := new(Checkpoint)
+	// Field (0) 'Epoch'
+	c.Epoch = rng.Uint64()
+
+	// Field (1) 'Root'
+	c.Root = ssz.RandomBytes(rng, 32)
+
+	return c
+}
+
 // MarshalSSZ ssz marshals the AttestationData object
 func (a *AttestationData) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, a.SizeSSZ())
@@ -160,12 +496,57 @@ func (a *AttestationData) MarshalSSZTo(dst []byte) ([]byte, error) {
 	}
 
 	// Field (3) 'Source'
-	if dst, err = a.Source.MarshalSSZTo(dst); err != nil {
+	if a.Source == nil {
+		if dst, err = new(Checkpoint).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = a.Source.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'Target'
+	if a.Target == nil {
+		if dst, err = new(Checkpoint).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = a.Target.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the AttestationData object to the buffers, dst is the encoding since the last referenced field
+func (a *AttestationData) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, a.Slot)
+
+	// Field (1) 'Index'
+	dst = ssz.MarshalUint64(dst, a.Index)
+
+	// Field (2) 'BeaconBlockHash'
+	if len(a.BeaconBlockHash) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, a.BeaconBlockHash)
+
+	// Field (3) 'Source'
+	if a.Source == nil {
+		if dst, err = new(Checkpoint).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(a.Source, dst); err != nil {
 		return nil, err
 	}
 
 	// Field (4) 'Target'
-	if dst, err = a.Target.MarshalSSZTo(dst); err != nil {
+	if a.Target == nil {
+		if dst, err = new(Checkpoint).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(a.Target, dst); err != nil {
 		return nil, err
 	}
 
@@ -187,7 +568,7 @@ func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
 	a.Index = ssz.UnmarshallUint64(buf[8:16])
 
 	// Field (2) 'BeaconBlockHash'
-	a.BeaconBlockHash = append(a.BeaconBlockHash, buf[16:48]...)
+	a.BeaconBlockHash = append(a.BeaconBlockHash[:0], buf[16:48]...)
 
 	// Field (3) 'Source'
 	if a.Source == nil {
@@ -208,297 +589,319 @@ func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the AttestationData object
-func (a *AttestationData) SizeSSZ() (size int) {
-	size = 128
-	return
-}
-
-// MarshalSSZ ssz marshals the Attestation object
-func (a *Attestation) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, a.SizeSSZ())
-	return a.MarshalSSZTo(buf[:0])
+// UnmarshalSSZVerify ssz unmarshals the AttestationData object and fails if the input is not its canonical encoding
+func (a *AttestationData) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(a, buf)
 }
 
-// MarshalSSZTo ssz marshals the Attestation object to a target array
-func (a *Attestation) MarshalSSZTo(dst []byte) ([]byte, error) {
+// UnmarshalSSZWithPool ssz unmarshals the AttestationData object with the nested objects of the pool
+func (a *AttestationData) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
 	var err error
-	offset := int(228)
-
-	// Offset (0) 'AggregationBits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(a.AggregationBits)
-
-	// Field (1) 'Data'
-	if dst, err = a.Data.MarshalSSZTo(dst); err != nil {
-		return nil, err
+	size := uint64(len(buf))
+	if size != 128 {
+		return errSize
 	}
 
-	// Field (2) 'Signature'
-	if dst, err = ssz.MarshalFixedBytes(dst, a.Signature, 96); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (0) 'Slot'
+	a.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Index'
+	a.Index = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'BeaconBlockHash'
+	a.BeaconBlockHash = append(a.BeaconBlockHash[:0], buf[16:48]...)
+
+	// Field (3) 'Source'
+	if a.Source == nil && pool != nil {
+		a.Source, _ = pool.Get((*Checkpoint)(nil)).(*Checkpoint)
+	}
+	if a.Source == nil {
+		a.Source = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithPool(a.Source, buf[48:88], pool); err != nil {
+		return err
 	}
 
-	// Field (0) 'AggregationBits'
-	dst = append(dst, a.AggregationBits...)
+	// Field (4) 'Target'
+	if a.Target == nil && pool != nil {
+		a.Target, _ = pool.Get((*Checkpoint)(nil)).(*Checkpoint)
+	}
+	if a.Target == nil {
+		a.Target = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithPool(a.Target, buf[88:128], pool); err != nil {
+		return err
+	}
 
-	return dst, err
+	return err
 }
 
-// UnmarshalSSZ ssz unmarshals the Attestation object
-func (a *Attestation) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZNoCopy ssz unmarshals the AttestationData object, the byte fields alias the input
+func (a *AttestationData) UnmarshalSSZNoCopy(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 228 {
+	if size != 128 {
 		return errSize
 	}
 
-	tail := buf
-	var o0 uint64
+	// Field (0) 'Slot'
+	a.Slot = ssz.UnmarshallUint64(buf[0:8])
 
-	// Offset (0) 'AggregationBits'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return errOffset
-	}
+	// Field (1) 'Index'
+	a.Index = ssz.UnmarshallUint64(buf[8:16])
 
-	// Field (1) 'Data'
-	if a.Data == nil {
-		a.Data = new(AttestationData)
+	// Field (2) 'BeaconBlockHash'
+	a.BeaconBlockHash = ssz.Alias(buf[16:48])
+
+	// Field (3) 'Source'
+	if a.Source == nil {
+		a.Source = new(Checkpoint)
 	}
-	if err = a.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+	if err = ssz.UnmarshalNoCopy(a.Source, buf[48:88]); err != nil {
 		return err
 	}
 
-	// Field (2) 'Signature'
-	a.Signature = append(a.Signature, buf[132:228]...)
-
-	// Field (0) 'AggregationBits'
-	{
-		buf = tail[o0:]
-		a.AggregationBits = append(a.AggregationBits, buf...)
+	// Field (4) 'Target'
+	if a.Target == nil {
+		a.Target = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalNoCopy(a.Target, buf[88:128]); err != nil {
+		return err
 	}
+
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Attestation object
-func (a *Attestation) SizeSSZ() (size int) {
-	size = 228
+// CopySSZ returns a copy of the AttestationData object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (a *AttestationData) CopySSZ() (*AttestationData, error) {
+	buf, err := a.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(AttestationData)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
 
-	// Field (0) 'AggregationBits'
-	size += len(a.AggregationBits)
+// SizeSSZ returns the ssz encoded size in bytes for the AttestationData object
+func (a *AttestationData) SizeSSZ() int {
+	return 128
+}
 
-	return
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the AttestationData object
+func (a *AttestationData) MaxSizeSSZ() uint64 {
+	return 128
 }
 
-// MarshalSSZ ssz marshals the DepositData object
-func (d *DepositData) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, d.SizeSSZ())
-	return d.MarshalSSZTo(buf[:0])
+// HashTreeRoot ssz hashes the AttestationData object
+func (a *AttestationData) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
 }
 
-// MarshalSSZTo ssz marshals the DepositData object to a target array
-func (d *DepositData) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
+// HashTreeRootWith ssz hashes the AttestationData object with a hasher
+func (a *AttestationData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
 
-	// Field (0) 'Pubkey'
-	if dst, err = ssz.MarshalFixedBytes(dst, d.Pubkey, 48); err != nil {
-		return nil, errMarshalFixedBytes
-	}
+	// Field (0) 'Slot'
+	hh.PutUint64(a.Slot)
 
-	// Field (1) 'WithdrawalCredentials'
-	if dst, err = ssz.MarshalFixedBytes(dst, d.WithdrawalCredentials, 32); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (1) 'Index'
+	hh.PutUint64(a.Index)
+
+	// Field (2) 'BeaconBlockHash'
+	if len(a.BeaconBlockHash) != 32 {
+		return ssz.ErrBytesLength
 	}
+	hh.PutBytes(a.BeaconBlockHash)
 
-	// Field (2) 'Amount'
-	dst = ssz.MarshalUint64(dst, d.Amount)
+	// Field (3) 'Source'
+	if a.Source == nil {
+		if err = new(Checkpoint).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = a.Source.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-	// Field (3) 'Signature'
-	if dst, err = ssz.MarshalFixedBytes(dst, d.Signature, 96); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (4) 'Target'
+	if a.Target == nil {
+		if err = new(Checkpoint).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = a.Target.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	return dst, err
+	hh.Merkleize(indx)
+	return
 }
 
-// UnmarshalSSZ ssz unmarshals the DepositData object
-func (d *DepositData) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 184 {
-		return errSize
+// SchemaSSZ returns the ssz schema of the AttestationData object
+func (a *AttestationData) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("AttestationData",
+		ssz.NewField("slot", ssz.UintSchema(8)),
+		ssz.NewField("index", ssz.UintSchema(8)),
+		ssz.NewField("beacon_block_root", ssz.ByteVectorSchema(32)),
+		ssz.NewField("source", new(Checkpoint).SchemaSSZ()),
+		ssz.NewField("target", new(Checkpoint).SchemaSSZ()),
+	)
+}
+
+// SSZFields returns the layout of the fields of the AttestationData object
+func (a *AttestationData) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "slot", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 8},
+		{Name: "index", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 8, Gindex: 9},
+		{Name: "beacon_block_root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 16, Gindex: 10},
+		{Name: "source", Type: "Checkpoint", Size: 40, Variable: false, Limit: 0, Offset: 48, Gindex: 11},
+		{Name: "target", Type: "Checkpoint", Size: 40, Variable: false, Limit: 0, Offset: 88, Gindex: 12},
 	}
+}
 
-	// Field (0) 'Pubkey'
-	d.Pubkey = append(d.Pubkey, buf[0:48]...)
+// RandomAttestationData returns a random AttestationData object
+func RandomAttestationData(rng *rand.Rand) *AttestationData {
+	a := new(AttestationData)
+	// Field (0) 'Slot'
+	a.Slot = rng.Uint64()
 
-	// Field (1) 'WithdrawalCredentials'
-	d.WithdrawalCredentials = append(d.WithdrawalCredentials, buf[48:80]...)
+	// Field (1) 'Index'
+	a.Index = rng.Uint64()
 
-	// Field (2) 'Amount'
-	d.Amount = ssz.UnmarshallUint64(buf[80:88])
+	// Field (2) 'BeaconBlockHash'
+	a.BeaconBlockHash = ssz.RandomBytes(rng, 32)
 
-	// Field (3) 'Signature'
-	d.Signature = append(d.Signature, buf[88:184]...)
+	// Field (3) 'Source'
+	a.Source = RandomCheckpoint(rng)
 
-	return err
-}
+	// Field (4) 'Target'
+	a.Target = RandomCheckpoint(rng)
 
-// SizeSSZ returns the ssz encoded size in bytes for the DepositData object
-func (d *DepositData) SizeSSZ() (size int) {
-	size = 184
-	return
+	return a
 }
 
-// MarshalSSZ ssz marshals the Deposit object
-func (d *Deposit) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, d.SizeSSZ())
-	return d.MarshalSSZTo(buf[:0])
+// MarshalSSZ ssz marshals the Attestation object
+func (a *Attestation) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, a.SizeSSZ())
+	return a.MarshalSSZTo(buf[:0])
 }
 
-// MarshalSSZTo ssz marshals the Deposit object to a target array
-func (d *Deposit) MarshalSSZTo(dst []byte) ([]byte, error) {
+// MarshalSSZTo ssz marshals the Attestation object to a target array
+func (a *Attestation) MarshalSSZTo(dst []byte) ([]byte, error) {
 	var err error
+	offset := int(228)
 
-	// Field (0) 'Proof'
-	if len(d.Proof) != 33 {
-		return nil, errMarshalVector
+	// Offset (0) 'AggregationBits'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
 	}
-	for ii := 0; ii < 33; ii++ {
-		if dst, err = ssz.MarshalFixedBytes(dst, d.Proof[ii], 32); err != nil {
-			return nil, errMarshalFixedBytes
+	offset += len(a.AggregationBits)
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		if dst, err = new(AttestationData).MarshalSSZTo(dst); err != nil {
+			return nil, err
 		}
+	} else if dst, err = a.Data.MarshalSSZTo(dst); err != nil {
+		return nil, err
 	}
 
-	// Field (1) 'Data'
-	if dst, err = d.Data.MarshalSSZTo(dst); err != nil {
+	// Field (2) 'Signature'
+	if dst, err = ssz.MarshalFixedBytes(dst, a.Signature, 96); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (0) 'AggregationBits'
+	if err = ssz.ValidateBitlist(a.AggregationBits, 2048); err != nil {
 		return nil, err
 	}
+	dst = append(dst, a.AggregationBits...)
 
 	return dst, err
 }
 
-// UnmarshalSSZ ssz unmarshals the Deposit object
-func (d *Deposit) UnmarshalSSZ(buf []byte) error {
+// MarshalSSZToBuffers ssz marshals the Attestation object to the buffers, dst is the encoding since the last referenced field
+func (a *Attestation) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
 	var err error
-	size := uint64(len(buf))
-	if size != 1240 {
-		return errSize
-	}
+	offset := int(228)
 
-	// Field (0) 'Proof'
-	d.Proof = make([][]byte, 33)
-	for ii := 0; ii < 33; ii++ {
-		d.Proof[ii] = append(d.Proof[ii], buf[0:1056][ii*32:(ii+1)*32]...)
+	// Offset (0) 'AggregationBits'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
 	}
+	offset += len(a.AggregationBits)
 
 	// Field (1) 'Data'
-	if d.Data == nil {
-		d.Data = new(DepositData)
-	}
-	if err = d.Data.UnmarshalSSZ(buf[1056:1240]); err != nil {
-		return err
+	if a.Data == nil {
+		if dst, err = new(AttestationData).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(a.Data, dst); err != nil {
+		return nil, err
 	}
 
-	return err
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the Deposit object
-func (d *Deposit) SizeSSZ() (size int) {
-	size = 1240
-	return
-}
-
-// MarshalSSZ ssz marshals the DepositMessage object
-func (d *DepositMessage) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, d.SizeSSZ())
-	return d.MarshalSSZTo(buf[:0])
-}
-
-// MarshalSSZTo ssz marshals the DepositMessage object to a target array
-func (d *DepositMessage) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
-
-	// Field (0) 'Pubkey'
-	if dst, err = ssz.MarshalFixedBytes(dst, d.Pubkey, 48); err != nil {
+	// Field (2) 'Signature'
+	if len(a.Signature) != 96 {
 		return nil, errMarshalFixedBytes
 	}
+	dst = bufs.Append(dst, a.Signature)
 
-	// Field (1) 'WithdrawalCredentials'
-	if dst, err = ssz.MarshalFixedBytes(dst, d.WithdrawalCredentials, 32); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (0) 'AggregationBits'
+	if err = ssz.ValidateBitlist(a.AggregationBits, 2048); err != nil {
+		return nil, err
 	}
-
-	// Field (2) 'Amount'
-	dst = ssz.MarshalUint64(dst, d.Amount)
+	dst = append(dst, a.AggregationBits...)
 
 	return dst, err
 }
 
-// UnmarshalSSZ ssz unmarshals the DepositMessage object
-func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Attestation object
+func (a *Attestation) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 88 {
+	if size < 228 {
 		return errSize
 	}
 
-	// Field (0) 'Pubkey'
-	d.Pubkey = append(d.Pubkey, buf[0:48]...)
+	tail := buf
+	var o0 uint64
 
-	// Field (1) 'WithdrawalCredentials'
-	d.WithdrawalCredentials = append(d.WithdrawalCredentials, buf[48:80]...)
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 228 {
+		return errOffset
+	}
 
-	// Field (2) 'Amount'
-	d.Amount = ssz.UnmarshallUint64(buf[80:88])
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(AttestationData)
+	}
+	if err = a.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+		return err
+	}
 
-	return err
-}
+	// Field (2) 'Signature'
+	a.Signature = append(a.Signature[:0], buf[132:228]...)
 
-// SizeSSZ returns the ssz encoded size in bytes for the DepositMessage object
-func (d *DepositMessage) SizeSSZ() (size int) {
-	size = 88
-	return
+	// Field (0) 'AggregationBits'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		a.AggregationBits = append(a.AggregationBits[:0], buf...)
+	}
+	return err
 }
 
-// MarshalSSZ ssz marshals the IndexedAttestation object
-func (i *IndexedAttestation) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, i.SizeSSZ())
-	return i.MarshalSSZTo(buf[:0])
+// UnmarshalSSZVerify ssz unmarshals the Attestation object and fails if the input is not its canonical encoding
+func (a *Attestation) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(a, buf)
 }
 
-// MarshalSSZTo ssz marshals the IndexedAttestation object to a target array
-func (i *IndexedAttestation) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
-	offset := int(228)
-
-	// Offset (0) 'AttestationIndices'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(i.AttestationIndices) * 8
-
-	// Field (1) 'Data'
-	if dst, err = i.Data.MarshalSSZTo(dst); err != nil {
-		return nil, err
-	}
-
-	// Field (2) 'Signature'
-	if dst, err = ssz.MarshalFixedBytes(dst, i.Signature, 96); err != nil {
-		return nil, errMarshalFixedBytes
-	}
-
-	// Field (0) 'AttestationIndices'
-	if len(i.AttestationIndices) > 2048 {
-		return nil, errMarshalList
-	}
-	for ii := 0; ii < len(i.AttestationIndices); ii++ {
-		dst = ssz.MarshalUint64(dst, i.AttestationIndices[ii])
-	}
-
-	return dst, err
-}
-
-// UnmarshalSSZ ssz unmarshals the IndexedAttestation object
-func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZWithPool ssz unmarshals the Attestation object with the nested objects of the pool
+func (a *Attestation) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 228 {
@@ -508,90 +911,41 @@ func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
 	tail := buf
 	var o0 uint64
 
-	// Offset (0) 'AttestationIndices'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 228 {
 		return errOffset
 	}
 
 	// Field (1) 'Data'
-	if i.Data == nil {
-		i.Data = new(AttestationData)
+	if a.Data == nil && pool != nil {
+		a.Data, _ = pool.Get((*AttestationData)(nil)).(*AttestationData)
 	}
-	if err = i.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+	if a.Data == nil {
+		a.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalWithPool(a.Data, buf[4:132], pool); err != nil {
 		return err
 	}
 
 	// Field (2) 'Signature'
-	i.Signature = append(i.Signature, buf[132:228]...)
+	a.Signature = append(a.Signature[:0], buf[132:228]...)
 
-	// Field (0) 'AttestationIndices'
+	// Field (0) 'AggregationBits'
 	{
 		buf = tail[o0:]
-		num, ok := ssz.DivideInt(len(buf), 8)
-		if !ok {
-			return errDivideInt
-		}
-		if num > 2048 {
-			return errListTooBig
-		}
-		i.AttestationIndices = ssz.ExtendUint64(i.AttestationIndices, num)
-		for ii := 0; ii < num; ii++ {
-			i.AttestationIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
 		}
+		a.AggregationBits = append(a.AggregationBits[:0], buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the IndexedAttestation object
-func (i *IndexedAttestation) SizeSSZ() (size int) {
-	size = 228
-
-	// Field (0) 'AttestationIndices'
-	size += len(i.AttestationIndices) * 8
-
-	return
-}
-
-// MarshalSSZ ssz marshals the PendingAttestation object
-func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, p.SizeSSZ())
-	return p.MarshalSSZTo(buf[:0])
-}
-
-// MarshalSSZTo ssz marshals the PendingAttestation object to a target array
-func (p *PendingAttestation) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
-	offset := int(148)
-
-	// Offset (0) 'AggregationBits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(p.AggregationBits)
-
-	// Field (1) 'Data'
-	if dst, err = p.Data.MarshalSSZTo(dst); err != nil {
-		return nil, err
-	}
-
-	// Field (2) 'InclusionDelay'
-	dst = ssz.MarshalUint64(dst, p.InclusionDelay)
-
-	// Field (3) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, p.ProposerIndex)
-
-	// Field (0) 'AggregationBits'
-	if len(p.AggregationBits) > 2048 {
-		return nil, errMarshalDynamicBytes
-	}
-	dst = append(dst, p.AggregationBits...)
-
-	return dst, err
-}
-
-// UnmarshalSSZ ssz unmarshals the PendingAttestation object
-func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZNoCopy ssz unmarshals the Attestation object, the byte fields alias the input
+func (a *Attestation) UnmarshalSSZNoCopy(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 148 {
+	if size < 228 {
 		return errSize
 	}
 
@@ -599,1433 +953,13847 @@ func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
 	var o0 uint64
 
 	// Offset (0) 'AggregationBits'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 228 {
 		return errOffset
 	}
 
 	// Field (1) 'Data'
-	if p.Data == nil {
-		p.Data = new(AttestationData)
+	if a.Data == nil {
+		a.Data = new(AttestationData)
 	}
-	if err = p.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+	if err = ssz.UnmarshalNoCopy(a.Data, buf[4:132]); err != nil {
 		return err
 	}
 
-	// Field (2) 'InclusionDelay'
-	p.InclusionDelay = ssz.UnmarshallUint64(buf[132:140])
-
-	// Field (3) 'ProposerIndex'
-	p.ProposerIndex = ssz.UnmarshallUint64(buf[140:148])
+	// Field (2) 'Signature'
+	a.Signature = ssz.Alias(buf[132:228])
 
 	// Field (0) 'AggregationBits'
 	{
 		buf = tail[o0:]
-		p.AggregationBits = append(p.AggregationBits, buf...)
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		a.AggregationBits = ssz.Alias(buf)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the PendingAttestation object
-func (p *PendingAttestation) SizeSSZ() (size int) {
-	size = 148
+// CopySSZ returns a copy of the Attestation object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (a *Attestation) CopySSZ() (*Attestation, error) {
+	buf, err := a.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Attestation)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Attestation object
+func (a *Attestation) SizeSSZ() (size int) {
+	size = 228
 
 	// Field (0) 'AggregationBits'
-	size += len(p.AggregationBits)
+	size += len(a.AggregationBits)
 
 	return
 }
 
-// MarshalSSZ ssz marshals the Fork object
-func (f *Fork) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, f.SizeSSZ())
-	return f.MarshalSSZTo(buf[:0])
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Attestation object
+func (a *Attestation) MaxSizeSSZ() uint64 {
+	return 485
 }
 
-// MarshalSSZTo ssz marshals the Fork object to a target array
-func (f *Fork) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
+// HashTreeRoot ssz hashes the Attestation object
+func (a *Attestation) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
 
-	// Field (0) 'PreviousVersion'
-	if dst, err = ssz.MarshalFixedBytes(dst, f.PreviousVersion, 4); err != nil {
-		return nil, errMarshalFixedBytes
+// HashTreeRootWith ssz hashes the Attestation object with a hasher
+func (a *Attestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AggregationBits'
+	if err = ssz.ValidateBitlist(a.AggregationBits, 2048); err != nil {
+		return
 	}
+	hh.PutBitlist(a.AggregationBits, 2048)
 
-	// Field (1) 'CurrentVersion'
-	if dst, err = ssz.MarshalFixedBytes(dst, f.CurrentVersion, 4); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (1) 'Data'
+	if a.Data == nil {
+		if err = new(AttestationData).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = a.Data.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	// Field (2) 'Epoch'
-	dst = ssz.MarshalUint64(dst, f.Epoch)
+	// Field (2) 'Signature'
+	if len(a.Signature) != 96 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(a.Signature)
 
-	return dst, err
+	hh.Merkleize(indx)
+	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Fork object
-func (f *Fork) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 16 {
-		return errSize
-	}
+// SchemaSSZ returns the ssz schema of the Attestation object
+func (a *Attestation) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Attestation",
+		ssz.NewField("aggregation_bits", ssz.BitlistSchema(2048)),
+		ssz.NewField("data", new(AttestationData).SchemaSSZ()),
+		ssz.NewField("signature", ssz.ByteVectorSchema(96)),
+	)
+}
 
-	// Field (0) 'PreviousVersion'
-	f.PreviousVersion = append(f.PreviousVersion, buf[0:4]...)
+// SSZFields returns the layout of the fields of the Attestation object
+func (a *Attestation) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "aggregation_bits", Type: "Bitlist[2048]", Size: 4, Variable: true, Limit: 2048, Offset: 0, Gindex: 4},
+		{Name: "data", Type: "AttestationData", Size: 128, Variable: false, Limit: 0, Offset: 4, Gindex: 5},
+		{Name: "signature", Type: "Bytes96", Size: 96, Variable: false, Limit: 0, Offset: 132, Gindex: 6},
+	}
+}
 
-	// Field (1) 'CurrentVersion'
-	f.CurrentVersion = append(f.CurrentVersion, buf[4:8]...)
+// RandomAttestation returns a random Attestation object
+func RandomAttestation(rng *rand.Rand) *Attestation {
+	a := new(Attestation)
+	// Field (0) 'AggregationBits'
+	a.AggregationBits = ssz.RandomBitlist(rng, 2048)
 
-	// Field (2) 'Epoch'
-	f.Epoch = ssz.UnmarshallUint64(buf[8:16])
+	// Field (1) 'Data'
+	a.Data = RandomAttestationData(rng)
 
-	return err
-}
+	// Field (2) 'Signature'
+	a.Signature = ssz.RandomBytes(rng, 96)
 
-// SizeSSZ returns the ssz encoded size in bytes for the Fork object
-func (f *Fork) SizeSSZ() (size int) {
-	size = 16
-	return
+	return a
 }
 
-// MarshalSSZ ssz marshals the Validator object
-func (v *Validator) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, v.SizeSSZ())
-	return v.MarshalSSZTo(buf[:0])
+// MarshalSSZ ssz marshals the DepositData object
+func (d *DepositData) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, d.SizeSSZ())
+	return d.MarshalSSZTo(buf[:0])
 }
 
-// MarshalSSZTo ssz marshals the Validator object to a target array
-func (v *Validator) MarshalSSZTo(dst []byte) ([]byte, error) {
+// MarshalSSZTo ssz marshals the DepositData object to a target array
+func (d *DepositData) MarshalSSZTo(dst []byte) ([]byte, error) {
 	var err error
 
 	// Field (0) 'Pubkey'
-	if dst, err = ssz.MarshalFixedBytes(dst, v.Pubkey, 48); err != nil {
+	if dst, err = ssz.MarshalFixedBytes(dst, d.Pubkey, 48); err != nil {
 		return nil, errMarshalFixedBytes
 	}
 
 	// Field (1) 'WithdrawalCredentials'
-	if dst, err = ssz.MarshalFixedBytes(dst, v.WithdrawalCredentials, 32); err != nil {
+	if dst, err = ssz.MarshalFixedBytes(dst, d.WithdrawalCredentials, 32); err != nil {
 		return nil, errMarshalFixedBytes
 	}
 
-	// Field (2) 'EffectiveBalance'
-	dst = ssz.MarshalUint64(dst, v.EffectiveBalance)
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, d.Amount)
 
-	// Field (3) 'Slashed'
-	dst = ssz.MarshalBool(dst, v.Slashed)
+	// Field (3) 'Signature'
+	if dst, err = ssz.MarshalFixedBytes(dst, d.Signature, 96); err != nil {
+		return nil, errMarshalFixedBytes
+	}
 
-	// Field (4) 'ActivationEligibilityEpoch'
-	dst = ssz.MarshalUint64(dst, v.ActivationEligibilityEpoch)
+	return dst, err
+}
 
-	// Field (5) 'ActivationEpoch'
-	dst = ssz.MarshalUint64(dst, v.ActivationEpoch)
+// MarshalSSZToBuffers ssz marshals the DepositData object to the buffers, dst is the encoding since the last referenced field
+func (d *DepositData) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
 
-	// Field (6) 'ExitEpoch'
-	dst = ssz.MarshalUint64(dst, v.ExitEpoch)
+	// Field (0) 'Pubkey'
+	if len(d.Pubkey) != 48 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, d.Pubkey)
 
-	// Field (7) 'WithdrawableEpoch'
-	dst = ssz.MarshalUint64(dst, v.WithdrawableEpoch)
+	// Field (1) 'WithdrawalCredentials'
+	if len(d.WithdrawalCredentials) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, d.WithdrawalCredentials)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, d.Amount)
+
+	// Field (3) 'Signature'
+	if len(d.Signature) != 96 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, d.Signature)
 
 	return dst, err
 }
 
-// UnmarshalSSZ ssz unmarshals the Validator object
-func (v *Validator) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the DepositData object
+func (d *DepositData) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 121 {
+	if size != 184 {
 		return errSize
 	}
 
 	// Field (0) 'Pubkey'
-	v.Pubkey = append(v.Pubkey, buf[0:48]...)
+	d.Pubkey = append(d.Pubkey[:0], buf[0:48]...)
 
 	// Field (1) 'WithdrawalCredentials'
-	v.WithdrawalCredentials = append(v.WithdrawalCredentials, buf[48:80]...)
-
-	// Field (2) 'EffectiveBalance'
-	v.EffectiveBalance = ssz.UnmarshallUint64(buf[80:88])
-
-	// Field (3) 'Slashed'
-	v.Slashed = ssz.UnmarshalBool(buf[88:89])
-
-	// Field (4) 'ActivationEligibilityEpoch'
-	v.ActivationEligibilityEpoch = ssz.UnmarshallUint64(buf[89:97])
-
-	// Field (5) 'ActivationEpoch'
-	v.ActivationEpoch = ssz.UnmarshallUint64(buf[97:105])
+	d.WithdrawalCredentials = append(d.WithdrawalCredentials[:0], buf[48:80]...)
 
-	// Field (6) 'ExitEpoch'
-	v.ExitEpoch = ssz.UnmarshallUint64(buf[105:113])
+	// Field (2) 'Amount'
+	d.Amount = ssz.UnmarshallUint64(buf[80:88])
 
-	// Field (7) 'WithdrawableEpoch'
-	v.WithdrawableEpoch = ssz.UnmarshallUint64(buf[113:121])
+	// Field (3) 'Signature'
+	d.Signature = append(d.Signature[:0], buf[88:184]...)
 
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Validator object
-func (v *Validator) SizeSSZ() (size int) {
-	size = 121
-	return
-}
-
-// MarshalSSZ ssz marshals the VoluntaryExit object
-func (v *VoluntaryExit) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, v.SizeSSZ())
-	return v.MarshalSSZTo(buf[:0])
+// UnmarshalSSZVerify ssz unmarshals the DepositData object and fails if the input is not its canonical encoding
+func (d *DepositData) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(d, buf)
 }
 
-// MarshalSSZTo ssz marshals the VoluntaryExit object to a target array
-func (v *VoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+// UnmarshalSSZWithPool ssz unmarshals the DepositData object with the nested objects of the pool
+func (d *DepositData) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
 	var err error
+	size := uint64(len(buf))
+	if size != 184 {
+		return errSize
+	}
 
-	// Field (0) 'Epoch'
-	dst = ssz.MarshalUint64(dst, v.Epoch)
+	// Field (0) 'Pubkey'
+	d.Pubkey = append(d.Pubkey[:0], buf[0:48]...)
 
-	// Field (1) 'ValidatorIndex'
-	dst = ssz.MarshalUint64(dst, v.ValidatorIndex)
+	// Field (1) 'WithdrawalCredentials'
+	d.WithdrawalCredentials = append(d.WithdrawalCredentials[:0], buf[48:80]...)
 
-	return dst, err
+	// Field (2) 'Amount'
+	d.Amount = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (3) 'Signature'
+	d.Signature = append(d.Signature[:0], buf[88:184]...)
+
+	return err
 }
 
-// UnmarshalSSZ ssz unmarshals the VoluntaryExit object
-func (v *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZNoCopy ssz unmarshals the DepositData object, the byte fields alias the input
+func (d *DepositData) UnmarshalSSZNoCopy(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 16 {
+	if size != 184 {
 		return errSize
 	}
 
-	// Field (0) 'Epoch'
-	v.Epoch = ssz.UnmarshallUint64(buf[0:8])
+	// Field (0) 'Pubkey'
+	d.Pubkey = ssz.Alias(buf[0:48])
 
-	// Field (1) 'ValidatorIndex'
-	v.ValidatorIndex = ssz.UnmarshallUint64(buf[8:16])
+	// Field (1) 'WithdrawalCredentials'
+	d.WithdrawalCredentials = ssz.Alias(buf[48:80])
 
-	return err
-}
+	// Field (2) 'Amount'
+	d.Amount = ssz.UnmarshallUint64(buf[80:88])
 
-// SizeSSZ returns the ssz encoded size in bytes for the VoluntaryExit object
-func (v *VoluntaryExit) SizeSSZ() (size int) {
-	size = 16
-	return
-}
+	// Field (3) 'Signature'
+	d.Signature = ssz.Alias(buf[88:184])
 
-// MarshalSSZ ssz marshals the SignedVoluntaryExit object
-func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, s.SizeSSZ())
-	return s.MarshalSSZTo(buf[:0])
+	return err
 }
 
-// MarshalSSZTo ssz marshals the SignedVoluntaryExit object to a target array
-func (s *SignedVoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
-
-	// Field (0) 'Exit'
-	if dst, err = s.Exit.MarshalSSZTo(dst); err != nil {
+// CopySSZ returns a copy of the DepositData object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (d *DepositData) CopySSZ() (*DepositData, error) {
+	buf, err := d.MarshalSSZ()
+	if err != nil {
 		return nil, err
 	}
-
-	// Field (1) 'Signature'
-	if dst, err = ssz.MarshalFixedBytes(dst, s.Signature, 96); err != nil {
-		return nil, errMarshalFixedBytes
+	obj := new(DepositData)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
 	}
+	return obj, nil
+}
 
-	return dst, err
+// SizeSSZ returns the ssz encoded size in bytes for the DepositData object
+func (d *DepositData) SizeSSZ() int {
+	return 184
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedVoluntaryExit object
-func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 112 {
-		return errSize
-	}
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the DepositData object
+func (d *DepositData) MaxSizeSSZ() uint64 {
+	return 184
+}
 
-	// Field (0) 'Exit'
-	if s.Exit == nil {
-		s.Exit = new(VoluntaryExit)
+// HashTreeRoot ssz hashes the DepositData object
+func (d *DepositData) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the DepositData object with a hasher
+func (d *DepositData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	if len(d.Pubkey) != 48 {
+		return ssz.ErrBytesLength
 	}
-	if err = s.Exit.UnmarshalSSZ(buf[0:16]); err != nil {
-		return err
+	hh.PutBytes(d.Pubkey)
+
+	// Field (1) 'WithdrawalCredentials'
+	if len(d.WithdrawalCredentials) != 32 {
+		return ssz.ErrBytesLength
 	}
+	hh.PutBytes(d.WithdrawalCredentials)
 
-	// Field (1) 'Signature'
-	s.Signature = append(s.Signature, buf[16:112]...)
+	// Field (2) 'Amount'
+	hh.PutUint64(d.Amount)
 
-	return err
-}
+	// Field (3) 'Signature'
+	if len(d.Signature) != 96 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(d.Signature)
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedVoluntaryExit object
-func (s *SignedVoluntaryExit) SizeSSZ() (size int) {
-	size = 112
+	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the Eth1Block object
-func (e *Eth1Block) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, e.SizeSSZ())
-	return e.MarshalSSZTo(buf[:0])
+// SchemaSSZ returns the ssz schema of the DepositData object
+func (d *DepositData) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("DepositData",
+		ssz.NewField("pubkey", ssz.ByteVectorSchema(48)),
+		ssz.NewField("withdrawal_credentials", ssz.ByteVectorSchema(32)),
+		ssz.NewField("amount", ssz.UintSchema(8)),
+		ssz.NewField("signature", ssz.ByteVectorSchema(96)),
+	)
 }
 
-// MarshalSSZTo ssz marshals the Eth1Block object to a target array
-func (e *Eth1Block) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
+// SSZFields returns the layout of the fields of the DepositData object
+func (d *DepositData) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "pubkey", Type: "Bytes48", Size: 48, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "withdrawal_credentials", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 48, Gindex: 5},
+		{Name: "amount", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 80, Gindex: 6},
+		{Name: "signature", Type: "Bytes96", Size: 96, Variable: false, Limit: 0, Offset: 88, Gindex: 7},
+	}
+}
 
-	// Field (0) 'Timestamp'
-	dst = ssz.MarshalUint64(dst, e.Timestamp)
+// RandomDepositData returns a random DepositData object
+func RandomDepositData(rng *rand.Rand) *DepositData {
+	d := new(DepositData)
+	// Field (0) 'Pubkey'
+	d.Pubkey = ssz.RandomBytes(rng, 48)
 
-	return dst, err
-}
+	// Field (1) 'WithdrawalCredentials'
+	d.WithdrawalCredentials = ssz.RandomBytes(rng, 32)
 
-// UnmarshalSSZ ssz unmarshals the Eth1Block object
-func (e *Eth1Block) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 8 {
-		return errSize
-	}
+	// Field (2) 'Amount'
+	d.Amount = rng.Uint64()
 
-	// Field (0) 'Timestamp'
-	e.Timestamp = ssz.UnmarshallUint64(buf[0:8])
+	// Field (3) 'Signature'
+	d.Signature = ssz.RandomBytes(rng, 96)
 
-	return err
+	return d
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Eth1Block object
-func (e *Eth1Block) SizeSSZ() (size int) {
-	size = 8
-	return
+// MarshalSSZ ssz marshals the Deposit object
+func (d *Deposit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, d.SizeSSZ())
+	return d.MarshalSSZTo(buf[:0])
 }
 
-// MarshalSSZ ssz marshals the Eth1Data object
-func (e *Eth1Data) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, e.SizeSSZ())
-	return e.MarshalSSZTo(buf[:0])
+// MarshalSSZTo ssz marshals the Deposit object to a target array
+func (d *Deposit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Proof'
+	if len(d.Proof) != 33 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 33; ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, d.Proof[ii], 32); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+
+	// Field (1) 'Data'
+	if d.Data == nil {
+		if dst, err = new(DepositData).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = d.Data.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
 }
 
-// MarshalSSZTo ssz marshals the Eth1Data object to a target array
-func (e *Eth1Data) MarshalSSZTo(dst []byte) ([]byte, error) {
+// MarshalSSZToBuffers ssz marshals the Deposit object to the buffers, dst is the encoding since the last referenced field
+func (d *Deposit) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
 	var err error
 
-	// Field (0) 'DepositRoot'
-	if dst, err = ssz.MarshalFixedBytes(dst, e.DepositRoot, 32); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (0) 'Proof'
+	if len(d.Proof) != 33 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 33; ii++ {
+		if len(d.Proof[ii]) != 32 {
+			return nil, errMarshalFixedBytes
+		}
+		dst = bufs.Append(dst, d.Proof[ii])
 	}
 
-	// Field (1) 'DepositCount'
-	dst = ssz.MarshalUint64(dst, e.DepositCount)
-
-	// Field (2) 'BlockHash'
-	if dst, err = ssz.MarshalFixedBytes(dst, e.BlockHash, 32); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (1) 'Data'
+	if d.Data == nil {
+		if dst, err = new(DepositData).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(d.Data, dst); err != nil {
+		return nil, err
 	}
 
 	return dst, err
 }
 
-// UnmarshalSSZ ssz unmarshals the Eth1Data object
-func (e *Eth1Data) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Deposit object
+func (d *Deposit) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 72 {
+	if size != 1240 {
 		return errSize
 	}
 
-	// Field (0) 'DepositRoot'
-	e.DepositRoot = append(e.DepositRoot, buf[0:32]...)
-
-	// Field (1) 'DepositCount'
-	e.DepositCount = ssz.UnmarshallUint64(buf[32:40])
+	// Field (0) 'Proof'
+	if cap(d.Proof) >= 33 {
+		d.Proof = d.Proof[:33]
+	} else {
+		d.Proof = make([][]byte, 33)
+	}
+	for ii := 0; ii < 33; ii++ {
+		d.Proof[ii] = append(d.Proof[ii][:0], buf[0:1056][ii*32:(ii+1)*32]...)
+	}
 
-	// Field (2) 'BlockHash'
-	e.BlockHash = append(e.BlockHash, buf[40:72]...)
+	// Field (1) 'Data'
+	if d.Data == nil {
+		d.Data = new(DepositData)
+	}
+	if err = d.Data.UnmarshalSSZ(buf[1056:1240]); err != nil {
+		return err
+	}
 
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Eth1Data object
-func (e *Eth1Data) SizeSSZ() (size int) {
-	size = 72
-	return
-}
-
-// MarshalSSZ ssz marshals the SigningRoot object
-func (s *SigningRoot) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, s.SizeSSZ())
-	return s.MarshalSSZTo(buf[:0])
+// UnmarshalSSZVerify ssz unmarshals the Deposit object and fails if the input is not its canonical encoding
+func (d *Deposit) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(d, buf)
 }
 
-// MarshalSSZTo ssz marshals the SigningRoot object to a target array
-func (s *SigningRoot) MarshalSSZTo(dst []byte) ([]byte, error) {
+// UnmarshalSSZWithPool ssz unmarshals the Deposit object with the nested objects of the pool
+func (d *Deposit) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
 	var err error
+	size := uint64(len(buf))
+	if size != 1240 {
+		return errSize
+	}
 
-	// Field (0) 'ObjectRoot'
-	if dst, err = ssz.MarshalFixedBytes(dst, s.ObjectRoot, 32); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (0) 'Proof'
+	if cap(d.Proof) >= 33 {
+		d.Proof = d.Proof[:33]
+	} else {
+		d.Proof = make([][]byte, 33)
+	}
+	for ii := 0; ii < 33; ii++ {
+		d.Proof[ii] = append(d.Proof[ii][:0], buf[0:1056][ii*32:(ii+1)*32]...)
 	}
 
-	// Field (1) 'Domain'
-	if dst, err = ssz.MarshalFixedBytes(dst, s.Domain, 8); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (1) 'Data'
+	if d.Data == nil && pool != nil {
+		d.Data, _ = pool.Get((*DepositData)(nil)).(*DepositData)
+	}
+	if d.Data == nil {
+		d.Data = new(DepositData)
+	}
+	if err = ssz.UnmarshalWithPool(d.Data, buf[1056:1240], pool); err != nil {
+		return err
 	}
 
-	return dst, err
+	return err
 }
 
-// UnmarshalSSZ ssz unmarshals the SigningRoot object
-func (s *SigningRoot) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZNoCopy ssz unmarshals the Deposit object, the byte fields alias the input
+func (d *Deposit) UnmarshalSSZNoCopy(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 40 {
+	if size != 1240 {
 		return errSize
 	}
 
-	// Field (0) 'ObjectRoot'
-	s.ObjectRoot = append(s.ObjectRoot, buf[0:32]...)
+	// Field (0) 'Proof'
+	if cap(d.Proof) >= 33 {
+		d.Proof = d.Proof[:33]
+	} else {
+		d.Proof = make([][]byte, 33)
+	}
+	for ii := 0; ii < 33; ii++ {
+		d.Proof[ii] = ssz.Alias(buf[0:1056][ii*32 : (ii+1)*32])
+	}
 
-	// Field (1) 'Domain'
-	s.Domain = append(s.Domain, buf[32:40]...)
+	// Field (1) 'Data'
+	if d.Data == nil {
+		d.Data = new(DepositData)
+	}
+	if err = ssz.UnmarshalNoCopy(d.Data, buf[1056:1240]); err != nil {
+		return err
+	}
 
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SigningRoot object
-func (s *SigningRoot) SizeSSZ() (size int) {
-	size = 40
-	return
+// CopySSZ returns a copy of the Deposit object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (d *Deposit) CopySSZ() (*Deposit, error) {
+	buf, err := d.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Deposit)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
 }
 
-// MarshalSSZ ssz marshals the HistoricalBatch object
-func (h *HistoricalBatch) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, h.SizeSSZ())
-	return h.MarshalSSZTo(buf[:0])
+// SizeSSZ returns the ssz encoded size in bytes for the Deposit object
+func (d *Deposit) SizeSSZ() int {
+	return 1240
 }
 
-// MarshalSSZTo ssz marshals the HistoricalBatch object to a target array
-func (h *HistoricalBatch) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Deposit object
+func (d *Deposit) MaxSizeSSZ() uint64 {
+	return 1240
+}
 
-	// Field (0) 'BlockRoots'
-	if len(h.BlockRoots) != 64 {
-		return nil, errMarshalVector
-	}
-	for ii := 0; ii < 64; ii++ {
-		if dst, err = ssz.MarshalFixedBytes(dst, h.BlockRoots[ii], 32); err != nil {
-			return nil, errMarshalFixedBytes
+// HashTreeRoot ssz hashes the Deposit object
+func (d *Deposit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the Deposit object with a hasher
+func (d *Deposit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Proof'
+	{
+		if len(d.Proof) != 33 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(d.Proof); ii++ {
+			if len(d.Proof[ii]) != 32 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(d.Proof[ii])
 		}
+		hh.Merkleize(subIndx)
 	}
 
-	// Field (1) 'StateRoots'
-	if len(h.StateRoots) != 64 {
-		return nil, errMarshalVector
-	}
-	for ii := 0; ii < 64; ii++ {
-		if dst, err = ssz.MarshalFixedBytes(dst, h.StateRoots[ii], 32); err != nil {
-			return nil, errMarshalFixedBytes
+	// Field (1) 'Data'
+	if d.Data == nil {
+		if err = new(DepositData).HashTreeRootWith(hh); err != nil {
+			return
 		}
+	} else if err = d.Data.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	return dst, err
+	hh.Merkleize(indx)
+	return
 }
 
-// UnmarshalSSZ ssz unmarshals the HistoricalBatch object
-func (h *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 4096 {
-		return errSize
-	}
+// SchemaSSZ returns the ssz schema of the Deposit object
+func (d *Deposit) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Deposit",
+		ssz.NewField("proof", ssz.VectorSchema(ssz.ByteVectorSchema(32), 33)),
+		ssz.NewField("data", new(DepositData).SchemaSSZ()),
+	)
+}
 
-	// Field (0) 'BlockRoots'
-	h.BlockRoots = make([][]byte, 64)
-	for ii := 0; ii < 64; ii++ {
-		h.BlockRoots[ii] = append(h.BlockRoots[ii], buf[0:2048][ii*32:(ii+1)*32]...)
+// SSZFields returns the layout of the fields of the Deposit object
+func (d *Deposit) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "proof", Type: "Vector[Bytes32, 33]", Size: 1056, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "data", Type: "DepositData", Size: 184, Variable: false, Limit: 0, Offset: 1056, Gindex: 3},
 	}
+}
 
-	// Field (1) 'StateRoots'
-	h.StateRoots = make([][]byte, 64)
-	for ii := 0; ii < 64; ii++ {
-		h.StateRoots[ii] = append(h.StateRoots[ii], buf[2048:4096][ii*32:(ii+1)*32]...)
+// RandomDeposit returns a random Deposit object
+func RandomDeposit(rng *rand.Rand) *Deposit {
+	d := new(Deposit)
+	// Field (0) 'Proof'
+	{
+		if cap(d.Proof) >= 33 {
+			d.Proof = d.Proof[:33]
+		} else {
+			d.Proof = make([][]byte, 33)
+		}
+		for ii := 0; ii < len(d.Proof); ii++ {
+			d.Proof[ii] = ssz.RandomBytes(rng, 32)
+		}
 	}
 
-	return err
-}
+	// Field (1) 'Data'
+	d.Data = RandomDepositData(rng)
 
-// SizeSSZ returns the ssz encoded size in bytes for the HistoricalBatch object
-func (h *HistoricalBatch) SizeSSZ() (size int) {
-	size = 4096
-	return
+	return d
 }
 
-// MarshalSSZ ssz marshals the ProposerSlashing object
-func (p *ProposerSlashing) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, p.SizeSSZ())
-	return p.MarshalSSZTo(buf[:0])
+// MarshalSSZ ssz marshals the DepositMessage object
+func (d *DepositMessage) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, d.SizeSSZ())
+	return d.MarshalSSZTo(buf[:0])
 }
 
-// MarshalSSZTo ssz marshals the ProposerSlashing object to a target array
-func (p *ProposerSlashing) MarshalSSZTo(dst []byte) ([]byte, error) {
+// MarshalSSZTo ssz marshals the DepositMessage object to a target array
+func (d *DepositMessage) MarshalSSZTo(dst []byte) ([]byte, error) {
 	var err error
 
-	// Field (0) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, p.ProposerIndex)
+	// Field (0) 'Pubkey'
+	if dst, err = ssz.MarshalFixedBytes(dst, d.Pubkey, 48); err != nil {
+		return nil, errMarshalFixedBytes
+	}
 
-	// Field (1) 'Header1'
-	if dst, err = p.Header1.MarshalSSZTo(dst); err != nil {
-		return nil, err
+	// Field (1) 'WithdrawalCredentials'
+	if dst, err = ssz.MarshalFixedBytes(dst, d.WithdrawalCredentials, 32); err != nil {
+		return nil, errMarshalFixedBytes
 	}
 
-	// Field (2) 'Header2'
-	if dst, err = p.Header2.MarshalSSZTo(dst); err != nil {
-		return nil, err
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, d.Amount)
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the DepositMessage object to the buffers, dst is the encoding since the last referenced field
+func (d *DepositMessage) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Pubkey'
+	if len(d.Pubkey) != 48 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, d.Pubkey)
+
+	// Field (1) 'WithdrawalCredentials'
+	if len(d.WithdrawalCredentials) != 32 {
+		return nil, errMarshalFixedBytes
 	}
+	dst = bufs.Append(dst, d.WithdrawalCredentials)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, d.Amount)
 
 	return dst, err
 }
 
-// UnmarshalSSZ ssz unmarshals the ProposerSlashing object
-func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the DepositMessage object
+func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 408 {
+	if size != 88 {
 		return errSize
 	}
 
-	// Field (0) 'ProposerIndex'
-	p.ProposerIndex = ssz.UnmarshallUint64(buf[0:8])
+	// Field (0) 'Pubkey'
+	d.Pubkey = append(d.Pubkey[:0], buf[0:48]...)
 
-	// Field (1) 'Header1'
-	if p.Header1 == nil {
-		p.Header1 = new(SignedBeaconBlockHeader)
-	}
-	if err = p.Header1.UnmarshalSSZ(buf[8:208]); err != nil {
-		return err
-	}
+	// Field (1) 'WithdrawalCredentials'
+	d.WithdrawalCredentials = append(d.WithdrawalCredentials[:0], buf[48:80]...)
 
-	// Field (2) 'Header2'
-	if p.Header2 == nil {
-		p.Header2 = new(SignedBeaconBlockHeader)
-	}
-	if err = p.Header2.UnmarshalSSZ(buf[208:408]); err != nil {
-		return err
-	}
+	// Field (2) 'Amount'
+	d.Amount = ssz.UnmarshallUint64(buf[80:88])
 
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ProposerSlashing object
-func (p *ProposerSlashing) SizeSSZ() (size int) {
-	size = 408
-	return
+// UnmarshalSSZVerify ssz unmarshals the DepositMessage object and fails if the input is not its canonical encoding
+func (d *DepositMessage) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(d, buf)
 }
 
-// MarshalSSZ ssz marshals the AttesterSlashing object
-func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, a.SizeSSZ())
-	return a.MarshalSSZTo(buf[:0])
+// UnmarshalSSZWithPool ssz unmarshals the DepositMessage object with the nested objects of the pool
+func (d *DepositMessage) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 88 {
+		return errSize
+	}
+
+	// Field (0) 'Pubkey'
+	d.Pubkey = append(d.Pubkey[:0], buf[0:48]...)
+
+	// Field (1) 'WithdrawalCredentials'
+	d.WithdrawalCredentials = append(d.WithdrawalCredentials[:0], buf[48:80]...)
+
+	// Field (2) 'Amount'
+	d.Amount = ssz.UnmarshallUint64(buf[80:88])
+
+	return err
 }
 
-// MarshalSSZTo ssz marshals the AttesterSlashing object to a target array
-func (a *AttesterSlashing) MarshalSSZTo(dst []byte) ([]byte, error) {
+// UnmarshalSSZNoCopy ssz unmarshals the DepositMessage object, the byte fields alias the input
+func (d *DepositMessage) UnmarshalSSZNoCopy(buf []byte) error {
 	var err error
-	offset := int(8)
+	size := uint64(len(buf))
+	if size != 88 {
+		return errSize
+	}
 
-	// Offset (0) 'Attestation1'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += a.Attestation1.SizeSSZ()
+	// Field (0) 'Pubkey'
+	d.Pubkey = ssz.Alias(buf[0:48])
 
-	// Offset (1) 'Attestation2'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += a.Attestation2.SizeSSZ()
+	// Field (1) 'WithdrawalCredentials'
+	d.WithdrawalCredentials = ssz.Alias(buf[48:80])
 
-	// Field (0) 'Attestation1'
-	if dst, err = a.Attestation1.MarshalSSZTo(dst); err != nil {
+	// Field (2) 'Amount'
+	d.Amount = ssz.UnmarshallUint64(buf[80:88])
+
+	return err
+}
+
+// CopySSZ returns a copy of the DepositMessage object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (d *DepositMessage) CopySSZ() (*DepositMessage, error) {
+	buf, err := d.MarshalSSZ()
+	if err != nil {
 		return nil, err
 	}
-
-	// Field (1) 'Attestation2'
-	if dst, err = a.Attestation2.MarshalSSZTo(dst); err != nil {
+	obj := new(DepositMessage)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
 		return nil, err
 	}
+	return obj, nil
+}
 
-	return dst, err
+// SizeSSZ returns the ssz encoded size in bytes for the DepositMessage object
+func (d *DepositMessage) SizeSSZ() int {
+	return 88
 }
 
-// UnmarshalSSZ ssz unmarshals the AttesterSlashing object
-func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 8 {
-		return errSize
-	}
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the DepositMessage object
+func (d *DepositMessage) MaxSizeSSZ() uint64 {
+	return 88
+}
 
-	tail := buf
-	var o0, o1 uint64
+// HashTreeRoot ssz hashes the DepositMessage object
+func (d *DepositMessage) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
 
-	// Offset (0) 'Attestation1'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return errOffset
-	}
+// HashTreeRootWith ssz hashes the DepositMessage object with a hasher
+func (d *DepositMessage) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
 
-	// Offset (1) 'Attestation2'
-	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
-		return errOffset
+	// Field (0) 'Pubkey'
+	if len(d.Pubkey) != 48 {
+		return ssz.ErrBytesLength
 	}
+	hh.PutBytes(d.Pubkey)
 
-	// Field (0) 'Attestation1'
-	{
-		buf = tail[o0:o1]
-		if a.Attestation1 == nil {
-			a.Attestation1 = new(IndexedAttestation)
-		}
-		if err = a.Attestation1.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+	// Field (1) 'WithdrawalCredentials'
+	if len(d.WithdrawalCredentials) != 32 {
+		return ssz.ErrBytesLength
 	}
+	hh.PutBytes(d.WithdrawalCredentials)
 
-	// Field (1) 'Attestation2'
-	{
-		buf = tail[o1:]
-		if a.Attestation2 == nil {
-			a.Attestation2 = new(IndexedAttestation)
-		}
-		if err = a.Attestation2.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+	// Field (2) 'Amount'
+	hh.PutUint64(d.Amount)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the DepositMessage object
+func (d *DepositMessage) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("DepositMessage",
+		ssz.NewField("pubkey", ssz.ByteVectorSchema(48)),
+		ssz.NewField("withdrawal_credentials", ssz.ByteVectorSchema(32)),
+		ssz.NewField("amount", ssz.UintSchema(8)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the DepositMessage object
+func (d *DepositMessage) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "pubkey", Type: "Bytes48", Size: 48, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "withdrawal_credentials", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 48, Gindex: 5},
+		{Name: "amount", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 80, Gindex: 6},
 	}
-	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the AttesterSlashing object
-func (a *AttesterSlashing) SizeSSZ() (size int) {
-	size = 8
+// RandomDepositMessage returns a random DepositMessage object
+func RandomDepositMessage(rng *rand.Rand) *DepositMessage {
+	d := new(DepositMessage)
+	// Field (0) 'Pubkey'
+	d.Pubkey = ssz.RandomBytes(rng, 48)
 
-	// Field (0) 'Attestation1'
-	size += a.Attestation1.SizeSSZ()
+	// Field (1) 'WithdrawalCredentials'
+	d.WithdrawalCredentials = ssz.RandomBytes(rng, 32)
 
-	// Field (1) 'Attestation2'
-	size += a.Attestation2.SizeSSZ()
+	// Field (2) 'Amount'
+	d.Amount = rng.Uint64()
 
-	return
+	return d
 }
 
-// MarshalSSZ ssz marshals the BeaconState object
-func (b *BeaconState) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, b.SizeSSZ())
-	return b.MarshalSSZTo(buf[:0])
+// MarshalSSZ ssz marshals the IndexedAttestation object
+func (i *IndexedAttestation) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, i.SizeSSZ())
+	return i.MarshalSSZTo(buf[:0])
 }
 
-// MarshalSSZTo ssz marshals the BeaconState object to a target array
-func (b *BeaconState) MarshalSSZTo(dst []byte) ([]byte, error) {
+// MarshalSSZTo ssz marshals the IndexedAttestation object to a target array
+func (i *IndexedAttestation) MarshalSSZTo(dst []byte) ([]byte, error) {
 	var err error
-	offset := int(7017)
-
-	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalUint64(dst, b.GenesisTime)
-
-	// Field (1) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+	offset := int(228)
 
-	// Field (2) 'Fork'
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+	// Offset (0) 'AttestationIndices'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
 		return nil, err
 	}
+	offset += len(i.AttestationIndices) * 8
 
-	// Field (3) 'LatestBlockHeader'
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+	// Field (1) 'Data'
+	if i.Data == nil {
+		if dst, err = new(AttestationData).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = i.Data.MarshalSSZTo(dst); err != nil {
 		return nil, err
 	}
 
-	// Field (4) 'BlockRoots'
-	if len(b.BlockRoots) != 64 {
-		return nil, errMarshalVector
-	}
-	for ii := 0; ii < 64; ii++ {
-		if dst, err = ssz.MarshalFixedBytes(dst, b.BlockRoots[ii], 32); err != nil {
-			return nil, errMarshalFixedBytes
-		}
+	// Field (2) 'Signature'
+	if dst, err = ssz.MarshalFixedBytes(dst, i.Signature, 96); err != nil {
+		return nil, errMarshalFixedBytes
 	}
 
-	// Field (5) 'StateRoots'
-	if len(b.StateRoots) != 64 {
-		return nil, errMarshalVector
+	// Field (0) 'AttestationIndices'
+	if len(i.AttestationIndices) > 2048 {
+		return nil, errMarshalList
 	}
-	for ii := 0; ii < 64; ii++ {
-		if dst, err = ssz.MarshalFixedBytes(dst, b.StateRoots[ii], 32); err != nil {
-			return nil, errMarshalFixedBytes
-		}
+	for ii := 0; ii < len(i.AttestationIndices); ii++ {
+		dst = ssz.MarshalUint64(dst, i.AttestationIndices[ii])
 	}
 
-	// Offset (6) 'HistoricalRoots'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.HistoricalRoots) * 32
-
-	// Field (7) 'Eth1Data'
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return nil, err
-	}
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the IndexedAttestation object to the buffers, dst is the encoding since the last referenced field
+func (i *IndexedAttestation) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(228)
+
+	// Offset (0) 'AttestationIndices'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(i.AttestationIndices) * 8
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		if dst, err = new(AttestationData).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(i.Data, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Signature'
+	if len(i.Signature) != 96 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, i.Signature)
+
+	// Field (0) 'AttestationIndices'
+	if len(i.AttestationIndices) > 2048 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(i.AttestationIndices); ii++ {
+		dst = ssz.MarshalUint64(dst, i.AttestationIndices[ii])
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the IndexedAttestation object
+func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 228 {
+		return errSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AttestationIndices'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 228 {
+		return errOffset
+	}
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(AttestationData)
+	}
+	if err = i.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Signature'
+	i.Signature = append(i.Signature[:0], buf[132:228]...)
+
+	// Field (0) 'AttestationIndices'
+	{
+		buf = tail[o0:]
+		num, ok := ssz.DivideInt(len(buf), 8)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 2048 {
+			return errListTooBig
+		}
+		i.AttestationIndices = ssz.ExtendUint64(i.AttestationIndices, num)
+		for ii := 0; ii < num; ii++ {
+			i.AttestationIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the IndexedAttestation object and fails if the input is not its canonical encoding
+func (i *IndexedAttestation) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(i, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the IndexedAttestation object with the nested objects of the pool
+func (i *IndexedAttestation) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 228 {
+		return errSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AttestationIndices'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 228 {
+		return errOffset
+	}
+
+	// Field (1) 'Data'
+	if i.Data == nil && pool != nil {
+		i.Data, _ = pool.Get((*AttestationData)(nil)).(*AttestationData)
+	}
+	if i.Data == nil {
+		i.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalWithPool(i.Data, buf[4:132], pool); err != nil {
+		return err
+	}
+
+	// Field (2) 'Signature'
+	i.Signature = append(i.Signature[:0], buf[132:228]...)
+
+	// Field (0) 'AttestationIndices'
+	{
+		buf = tail[o0:]
+		num, ok := ssz.DivideInt(len(buf), 8)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 2048 {
+			return errListTooBig
+		}
+		i.AttestationIndices = ssz.ExtendUint64(i.AttestationIndices, num)
+		for ii := 0; ii < num; ii++ {
+			i.AttestationIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the IndexedAttestation object, the byte fields alias the input
+func (i *IndexedAttestation) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 228 {
+		return errSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AttestationIndices'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 228 {
+		return errOffset
+	}
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalNoCopy(i.Data, buf[4:132]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Signature'
+	i.Signature = ssz.Alias(buf[132:228])
+
+	// Field (0) 'AttestationIndices'
+	{
+		buf = tail[o0:]
+		num, ok := ssz.DivideInt(len(buf), 8)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 2048 {
+			return errListTooBig
+		}
+		i.AttestationIndices = ssz.ExtendUint64(i.AttestationIndices, num)
+		for ii := 0; ii < num; ii++ {
+			i.AttestationIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the IndexedAttestation object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (i *IndexedAttestation) CopySSZ() (*IndexedAttestation, error) {
+	buf, err := i.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(IndexedAttestation)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the IndexedAttestation object
+func (i *IndexedAttestation) SizeSSZ() (size int) {
+	size = 228
+
+	// Field (0) 'AttestationIndices'
+	size += len(i.AttestationIndices) * 8
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the IndexedAttestation object
+func (i *IndexedAttestation) MaxSizeSSZ() uint64 {
+	return 16612
+}
+
+// HashTreeRoot ssz hashes the IndexedAttestation object
+func (i *IndexedAttestation) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(i)
+}
+
+// HashTreeRootWith ssz hashes the IndexedAttestation object with a hasher
+func (i *IndexedAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AttestationIndices'
+	{
+		if len(i.AttestationIndices) > 2048 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(i.AttestationIndices); ii++ {
+			hh.AppendUint64(i.AttestationIndices[ii])
+		}
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(subIndx, uint64(len(i.AttestationIndices)), 512)
+	}
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		if err = new(AttestationData).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = i.Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	if len(i.Signature) != 96 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(i.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the IndexedAttestation object
+func (i *IndexedAttestation) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("IndexedAttestation",
+		ssz.NewField("attesting_indices", ssz.ListSchema(ssz.UintSchema(8), 2048)),
+		ssz.NewField("data", new(AttestationData).SchemaSSZ()),
+		ssz.NewField("signature", ssz.ByteVectorSchema(96)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the IndexedAttestation object
+func (i *IndexedAttestation) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "attesting_indices", Type: "List[uint64, 2048]", Size: 4, Variable: true, Limit: 2048, Offset: 0, Gindex: 4},
+		{Name: "data", Type: "AttestationData", Size: 128, Variable: false, Limit: 0, Offset: 4, Gindex: 5},
+		{Name: "signature", Type: "Bytes96", Size: 96, Variable: false, Limit: 0, Offset: 132, Gindex: 6},
+	}
+}
+
+// RandomIndexedAttestation returns a random IndexedAttestation object
+func RandomIndexedAttestation(rng *rand.Rand) *IndexedAttestation {
+	i := new(IndexedAttestation)
+	// Field (0) 'AttestationIndices'
+	{
+		num := ssz.RandomLength(rng, 2048)
+		i.AttestationIndices = ssz.ExtendUint64(i.AttestationIndices, num)
+		for ii := 0; ii < len(i.AttestationIndices); ii++ {
+			i.AttestationIndices[ii] = rng.Uint64()
+		}
+	}
+
+	// Field (1) 'Data'
+	i.Data = RandomAttestationData(rng)
+
+	// Field (2) 'Signature'
+	i.Signature = ssz.RandomBytes(rng, 96)
+
+	return i
+}
+
+// MarshalSSZ ssz marshals the PendingAttestation object
+func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, p.SizeSSZ())
+	return p.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the PendingAttestation object to a target array
+func (p *PendingAttestation) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(148)
+
+	// Offset (0) 'AggregationBits'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(p.AggregationBits)
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		if dst, err = new(AttestationData).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = p.Data.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'InclusionDelay'
+	dst = ssz.MarshalUint64(dst, p.InclusionDelay)
+
+	// Field (3) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, p.ProposerIndex)
+
+	// Field (0) 'AggregationBits'
+	if err = ssz.ValidateBitlist(p.AggregationBits, 2048); err != nil {
+		return nil, err
+	}
+	dst = append(dst, p.AggregationBits...)
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the PendingAttestation object to the buffers, dst is the encoding since the last referenced field
+func (p *PendingAttestation) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(148)
+
+	// Offset (0) 'AggregationBits'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(p.AggregationBits)
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		if dst, err = new(AttestationData).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(p.Data, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'InclusionDelay'
+	dst = ssz.MarshalUint64(dst, p.InclusionDelay)
+
+	// Field (3) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, p.ProposerIndex)
+
+	// Field (0) 'AggregationBits'
+	if err = ssz.ValidateBitlist(p.AggregationBits, 2048); err != nil {
+		return nil, err
+	}
+	dst = append(dst, p.AggregationBits...)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the PendingAttestation object
+func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 148 {
+		return errSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 148 {
+		return errOffset
+	}
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
+	}
+	if err = p.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+		return err
+	}
+
+	// Field (2) 'InclusionDelay'
+	p.InclusionDelay = ssz.UnmarshallUint64(buf[132:140])
+
+	// Field (3) 'ProposerIndex'
+	p.ProposerIndex = ssz.UnmarshallUint64(buf[140:148])
+
+	// Field (0) 'AggregationBits'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		p.AggregationBits = append(p.AggregationBits[:0], buf...)
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the PendingAttestation object and fails if the input is not its canonical encoding
+func (p *PendingAttestation) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(p, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the PendingAttestation object with the nested objects of the pool
+func (p *PendingAttestation) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 148 {
+		return errSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 148 {
+		return errOffset
+	}
+
+	// Field (1) 'Data'
+	if p.Data == nil && pool != nil {
+		p.Data, _ = pool.Get((*AttestationData)(nil)).(*AttestationData)
+	}
+	if p.Data == nil {
+		p.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalWithPool(p.Data, buf[4:132], pool); err != nil {
+		return err
+	}
+
+	// Field (2) 'InclusionDelay'
+	p.InclusionDelay = ssz.UnmarshallUint64(buf[132:140])
+
+	// Field (3) 'ProposerIndex'
+	p.ProposerIndex = ssz.UnmarshallUint64(buf[140:148])
+
+	// Field (0) 'AggregationBits'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		p.AggregationBits = append(p.AggregationBits[:0], buf...)
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the PendingAttestation object, the byte fields alias the input
+func (p *PendingAttestation) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 148 {
+		return errSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 148 {
+		return errOffset
+	}
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalNoCopy(p.Data, buf[4:132]); err != nil {
+		return err
+	}
+
+	// Field (2) 'InclusionDelay'
+	p.InclusionDelay = ssz.UnmarshallUint64(buf[132:140])
+
+	// Field (3) 'ProposerIndex'
+	p.ProposerIndex = ssz.UnmarshallUint64(buf[140:148])
+
+	// Field (0) 'AggregationBits'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		p.AggregationBits = ssz.Alias(buf)
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the PendingAttestation object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (p *PendingAttestation) CopySSZ() (*PendingAttestation, error) {
+	buf, err := p.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(PendingAttestation)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the PendingAttestation object
+func (p *PendingAttestation) SizeSSZ() (size int) {
+	size = 148
+
+	// Field (0) 'AggregationBits'
+	size += len(p.AggregationBits)
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the PendingAttestation object
+func (p *PendingAttestation) MaxSizeSSZ() uint64 {
+	return 405
+}
+
+// HashTreeRoot ssz hashes the PendingAttestation object
+func (p *PendingAttestation) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the PendingAttestation object with a hasher
+func (p *PendingAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AggregationBits'
+	if err = ssz.ValidateBitlist(p.AggregationBits, 2048); err != nil {
+		return
+	}
+	hh.PutBitlist(p.AggregationBits, 2048)
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		if err = new(AttestationData).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = p.Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'InclusionDelay'
+	hh.PutUint64(p.InclusionDelay)
+
+	// Field (3) 'ProposerIndex'
+	hh.PutUint64(p.ProposerIndex)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the PendingAttestation object
+func (p *PendingAttestation) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("PendingAttestation",
+		ssz.NewField("aggregation_bits", ssz.BitlistSchema(2048)),
+		ssz.NewField("data", new(AttestationData).SchemaSSZ()),
+		ssz.NewField("inclusion_delay", ssz.UintSchema(8)),
+		ssz.NewField("proposer_index", ssz.UintSchema(8)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the PendingAttestation object
+func (p *PendingAttestation) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "aggregation_bits", Type: "Bitlist[2048]", Size: 4, Variable: true, Limit: 2048, Offset: 0, Gindex: 4},
+		{Name: "data", Type: "AttestationData", Size: 128, Variable: false, Limit: 0, Offset: 4, Gindex: 5},
+		{Name: "inclusion_delay", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 132, Gindex: 6},
+		{Name: "proposer_index", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 140, Gindex: 7},
+	}
+}
+
+// RandomPendingAttestation returns a random PendingAttestation object
+func RandomPendingAttestation(rng *rand.Rand) *PendingAttestation {
+	p := new(PendingAttestation)
+	// Field (0) 'AggregationBits'
+	p.AggregationBits = ssz.RandomBitlist(rng, 2048)
+
+	// Field (1) 'Data'
+	p.Data = RandomAttestationData(rng)
+
+	// Field (2) 'InclusionDelay'
+	p.InclusionDelay = rng.Uint64()
+
+	// Field (3) 'ProposerIndex'
+	p.ProposerIndex = rng.Uint64()
+
+	return p
+}
+
+// MarshalSSZ ssz marshals the Fork object
+func (f *Fork) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, f.SizeSSZ())
+	return f.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Fork object to a target array
+func (f *Fork) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'PreviousVersion'
+	if dst, err = ssz.MarshalFixedBytes(dst, f.PreviousVersion, 4); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (1) 'CurrentVersion'
+	if dst, err = ssz.MarshalFixedBytes(dst, f.CurrentVersion, 4); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (2) 'Epoch'
+	dst = ssz.MarshalUint64(dst, f.Epoch)
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Fork object to the buffers, dst is the encoding since the last referenced field
+func (f *Fork) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'PreviousVersion'
+	if len(f.PreviousVersion) != 4 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, f.PreviousVersion)
+
+	// Field (1) 'CurrentVersion'
+	if len(f.CurrentVersion) != 4 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, f.CurrentVersion)
+
+	// Field (2) 'Epoch'
+	dst = ssz.MarshalUint64(dst, f.Epoch)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Fork object
+func (f *Fork) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return errSize
+	}
+
+	// Field (0) 'PreviousVersion'
+	f.PreviousVersion = append(f.PreviousVersion[:0], buf[0:4]...)
+
+	// Field (1) 'CurrentVersion'
+	f.CurrentVersion = append(f.CurrentVersion[:0], buf[4:8]...)
+
+	// Field (2) 'Epoch'
+	f.Epoch = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Fork object and fails if the input is not its canonical encoding
+func (f *Fork) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(f, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Fork object with the nested objects of the pool
+func (f *Fork) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return errSize
+	}
+
+	// Field (0) 'PreviousVersion'
+	f.PreviousVersion = append(f.PreviousVersion[:0], buf[0:4]...)
+
+	// Field (1) 'CurrentVersion'
+	f.CurrentVersion = append(f.CurrentVersion[:0], buf[4:8]...)
+
+	// Field (2) 'Epoch'
+	f.Epoch = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Fork object, the byte fields alias the input
+func (f *Fork) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return errSize
+	}
+
+	// Field (0) 'PreviousVersion'
+	f.PreviousVersion = ssz.Alias(buf[0:4])
+
+	// Field (1) 'CurrentVersion'
+	f.CurrentVersion = ssz.Alias(buf[4:8])
+
+	// Field (2) 'Epoch'
+	f.Epoch = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// CopySSZ returns a copy of the Fork object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (f *Fork) CopySSZ() (*Fork, error) {
+	buf, err := f.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Fork)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Fork object
+func (f *Fork) SizeSSZ() int {
+	return 16
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Fork object
+func (f *Fork) MaxSizeSSZ() uint64 {
+	return 16
+}
+
+// HashTreeRoot ssz hashes the Fork object
+func (f *Fork) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the Fork object with a hasher
+func (f *Fork) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'PreviousVersion'
+	if len(f.PreviousVersion) != 4 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(f.PreviousVersion)
+
+	// Field (1) 'CurrentVersion'
+	if len(f.CurrentVersion) != 4 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(f.CurrentVersion)
+
+	// Field (2) 'Epoch'
+	hh.PutUint64(f.Epoch)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Fork object
+func (f *Fork) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Fork",
+		ssz.NewField("previous_version", ssz.ByteVectorSchema(4)),
+		ssz.NewField("current_version", ssz.ByteVectorSchema(4)),
+		ssz.NewField("epoch", ssz.UintSchema(8)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Fork object
+func (f *Fork) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "previous_version", Type: "Bytes4", Size: 4, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "current_version", Type: "Bytes4", Size: 4, Variable: false, Limit: 0, Offset: 4, Gindex: 5},
+		{Name: "epoch", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 8, Gindex: 6},
+	}
+}
+
+// RandomFork returns a random Fork object
+func RandomFork(rng *rand.Rand) *Fork {
+	f := new(Fork)
+	// Field (0) 'PreviousVersion'
+	f.PreviousVersion = ssz.RandomBytes(rng, 4)
+
+	// Field (1) 'CurrentVersion'
+	f.CurrentVersion = ssz.RandomBytes(rng, 4)
+
+	// Field (2) 'Epoch'
+	f.Epoch = rng.Uint64()
+
+	return f
+}
+
+// MarshalSSZ ssz marshals the Validator object
+func (v *Validator) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, v.SizeSSZ())
+	return v.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Validator object to a target array
+func (v *Validator) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Pubkey'
+	if dst, err = ssz.MarshalFixedBytes(dst, v.Pubkey, 48); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (1) 'WithdrawalCredentials'
+	if dst, err = ssz.MarshalFixedBytes(dst, v.WithdrawalCredentials, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (2) 'EffectiveBalance'
+	dst = ssz.MarshalUint64(dst, v.EffectiveBalance)
+
+	// Field (3) 'Slashed'
+	dst = ssz.MarshalBool(dst, v.Slashed)
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	dst = ssz.MarshalUint64(dst, v.ActivationEligibilityEpoch)
+
+	// Field (5) 'ActivationEpoch'
+	dst = ssz.MarshalUint64(dst, v.ActivationEpoch)
+
+	// Field (6) 'ExitEpoch'
+	dst = ssz.MarshalUint64(dst, v.ExitEpoch)
+
+	// Field (7) 'WithdrawableEpoch'
+	dst = ssz.MarshalUint64(dst, v.WithdrawableEpoch)
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Validator object to the buffers, dst is the encoding since the last referenced field
+func (v *Validator) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Pubkey'
+	if len(v.Pubkey) != 48 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, v.Pubkey)
+
+	// Field (1) 'WithdrawalCredentials'
+	if len(v.WithdrawalCredentials) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, v.WithdrawalCredentials)
+
+	// Field (2) 'EffectiveBalance'
+	dst = ssz.MarshalUint64(dst, v.EffectiveBalance)
+
+	// Field (3) 'Slashed'
+	dst = ssz.MarshalBool(dst, v.Slashed)
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	dst = ssz.MarshalUint64(dst, v.ActivationEligibilityEpoch)
+
+	// Field (5) 'ActivationEpoch'
+	dst = ssz.MarshalUint64(dst, v.ActivationEpoch)
+
+	// Field (6) 'ExitEpoch'
+	dst = ssz.MarshalUint64(dst, v.ExitEpoch)
+
+	// Field (7) 'WithdrawableEpoch'
+	dst = ssz.MarshalUint64(dst, v.WithdrawableEpoch)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Validator object
+func (v *Validator) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 121 {
+		return errSize
+	}
+
+	// Field (0) 'Pubkey'
+	v.Pubkey = append(v.Pubkey[:0], buf[0:48]...)
+
+	// Field (1) 'WithdrawalCredentials'
+	v.WithdrawalCredentials = append(v.WithdrawalCredentials[:0], buf[48:80]...)
+
+	// Field (2) 'EffectiveBalance'
+	v.EffectiveBalance = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (3) 'Slashed'
+	v.Slashed = ssz.UnmarshalBool(buf[88:89])
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	v.ActivationEligibilityEpoch = ssz.UnmarshallUint64(buf[89:97])
+
+	// Field (5) 'ActivationEpoch'
+	v.ActivationEpoch = ssz.UnmarshallUint64(buf[97:105])
+
+	// Field (6) 'ExitEpoch'
+	v.ExitEpoch = ssz.UnmarshallUint64(buf[105:113])
+
+	// Field (7) 'WithdrawableEpoch'
+	v.WithdrawableEpoch = ssz.UnmarshallUint64(buf[113:121])
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Validator object and fails if the input is not its canonical encoding
+func (v *Validator) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(v, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Validator object with the nested objects of the pool
+func (v *Validator) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 121 {
+		return errSize
+	}
+
+	// Field (0) 'Pubkey'
+	v.Pubkey = append(v.Pubkey[:0], buf[0:48]...)
+
+	// Field (1) 'WithdrawalCredentials'
+	v.WithdrawalCredentials = append(v.WithdrawalCredentials[:0], buf[48:80]...)
+
+	// Field (2) 'EffectiveBalance'
+	v.EffectiveBalance = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (3) 'Slashed'
+	v.Slashed = ssz.UnmarshalBool(buf[88:89])
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	v.ActivationEligibilityEpoch = ssz.UnmarshallUint64(buf[89:97])
+
+	// Field (5) 'ActivationEpoch'
+	v.ActivationEpoch = ssz.UnmarshallUint64(buf[97:105])
+
+	// Field (6) 'ExitEpoch'
+	v.ExitEpoch = ssz.UnmarshallUint64(buf[105:113])
+
+	// Field (7) 'WithdrawableEpoch'
+	v.WithdrawableEpoch = ssz.UnmarshallUint64(buf[113:121])
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Validator object, the byte fields alias the input
+func (v *Validator) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 121 {
+		return errSize
+	}
+
+	// Field (0) 'Pubkey'
+	v.Pubkey = ssz.Alias(buf[0:48])
+
+	// Field (1) 'WithdrawalCredentials'
+	v.WithdrawalCredentials = ssz.Alias(buf[48:80])
+
+	// Field (2) 'EffectiveBalance'
+	v.EffectiveBalance = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (3) 'Slashed'
+	v.Slashed = ssz.UnmarshalBool(buf[88:89])
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	v.ActivationEligibilityEpoch = ssz.UnmarshallUint64(buf[89:97])
+
+	// Field (5) 'ActivationEpoch'
+	v.ActivationEpoch = ssz.UnmarshallUint64(buf[97:105])
+
+	// Field (6) 'ExitEpoch'
+	v.ExitEpoch = ssz.UnmarshallUint64(buf[105:113])
+
+	// Field (7) 'WithdrawableEpoch'
+	v.WithdrawableEpoch = ssz.UnmarshallUint64(buf[113:121])
+
+	return err
+}
+
+// CopySSZ returns a copy of the Validator object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (v *Validator) CopySSZ() (*Validator, error) {
+	buf, err := v.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Validator)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Validator object
+func (v *Validator) SizeSSZ() int {
+	return 121
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Validator object
+func (v *Validator) MaxSizeSSZ() uint64 {
+	return 121
+}
+
+// HashTreeRoot ssz hashes the Validator object
+func (v *Validator) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the Validator object with a hasher
+func (v *Validator) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	if len(v.Pubkey) != 48 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(v.Pubkey)
+
+	// Field (1) 'WithdrawalCredentials'
+	if len(v.WithdrawalCredentials) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(v.WithdrawalCredentials)
+
+	// Field (2) 'EffectiveBalance'
+	hh.PutUint64(v.EffectiveBalance)
+
+	// Field (3) 'Slashed'
+	hh.PutBool(v.Slashed)
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	hh.PutUint64(v.ActivationEligibilityEpoch)
+
+	// Field (5) 'ActivationEpoch'
+	hh.PutUint64(v.ActivationEpoch)
+
+	// Field (6) 'ExitEpoch'
+	hh.PutUint64(v.ExitEpoch)
+
+	// Field (7) 'WithdrawableEpoch'
+	hh.PutUint64(v.WithdrawableEpoch)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Validator object
+func (v *Validator) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Validator",
+		ssz.NewField("pubkey", ssz.ByteVectorSchema(48)),
+		ssz.NewField("withdrawal_credentials", ssz.ByteVectorSchema(32)),
+		ssz.NewField("effective_balance", ssz.UintSchema(8)),
+		ssz.NewField("slashed", ssz.BoolSchema()),
+		ssz.NewField("activation_eligibility_epoch", ssz.UintSchema(8)),
+		ssz.NewField("activation_epoch", ssz.UintSchema(8)),
+		ssz.NewField("exit_epoch", ssz.UintSchema(8)),
+		ssz.NewField("withdrawable_epoch", ssz.UintSchema(8)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Validator object
+func (v *Validator) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "pubkey", Type: "Bytes48", Size: 48, Variable: false, Limit: 0, Offset: 0, Gindex: 8},
+		{Name: "withdrawal_credentials", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 48, Gindex: 9},
+		{Name: "effective_balance", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 80, Gindex: 10},
+		{Name: "slashed", Type: "boolean", Size: 1, Variable: false, Limit: 0, Offset: 88, Gindex: 11},
+		{Name: "activation_eligibility_epoch", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 89, Gindex: 12},
+		{Name: "activation_epoch", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 97, Gindex: 13},
+		{Name: "exit_epoch", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 105, Gindex: 14},
+		{Name: "withdrawable_epoch", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 113, Gindex: 15},
+	}
+}
+
+// RandomValidator returns a random Validator object
+func RandomValidator(rng *rand.Rand) *Validator {
+	v := new(Validator)
+	// Field (0) 'Pubkey'
+	v.Pubkey = ssz.RandomBytes(rng, 48)
+
+	// Field (1) 'WithdrawalCredentials'
+	v.WithdrawalCredentials = ssz.RandomBytes(rng, 32)
+
+	// Field (2) 'EffectiveBalance'
+	v.EffectiveBalance = rng.Uint64()
+
+	// Field (3) 'Slashed'
+	v.Slashed = rng.Intn(2) == 1
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	v.ActivationEligibilityEpoch = rng.Uint64()
+
+	// Field (5) 'ActivationEpoch'
+	v.ActivationEpoch = rng.Uint64()
+
+	// Field (6) 'ExitEpoch'
+	v.ExitEpoch = rng.Uint64()
+
+	// Field (7) 'WithdrawableEpoch'
+	v.WithdrawableEpoch = rng.Uint64()
+
+	return v
+}
+
+// MarshalSSZ ssz marshals the VoluntaryExit object
+func (v *VoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, v.SizeSSZ())
+	return v.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the VoluntaryExit object to a target array
+func (v *VoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, v.Epoch)
+
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, v.ValidatorIndex)
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the VoluntaryExit object to the buffers, dst is the encoding since the last referenced field
+func (v *VoluntaryExit) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, v.Epoch)
+
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, v.ValidatorIndex)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the VoluntaryExit object
+func (v *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return errSize
+	}
+
+	// Field (0) 'Epoch'
+	v.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ValidatorIndex'
+	v.ValidatorIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the VoluntaryExit object and fails if the input is not its canonical encoding
+func (v *VoluntaryExit) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(v, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the VoluntaryExit object with the nested objects of the pool
+func (v *VoluntaryExit) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return errSize
+	}
+
+	// Field (0) 'Epoch'
+	v.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ValidatorIndex'
+	v.ValidatorIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the VoluntaryExit object, the byte fields alias the input
+func (v *VoluntaryExit) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return errSize
+	}
+
+	// Field (0) 'Epoch'
+	v.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ValidatorIndex'
+	v.ValidatorIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// CopySSZ returns a copy of the VoluntaryExit object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (v *VoluntaryExit) CopySSZ() (*VoluntaryExit, error) {
+	buf, err := v.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(VoluntaryExit)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VoluntaryExit object
+func (v *VoluntaryExit) SizeSSZ() int {
+	return 16
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the VoluntaryExit object
+func (v *VoluntaryExit) MaxSizeSSZ() uint64 {
+	return 16
+}
+
+// HashTreeRoot ssz hashes the VoluntaryExit object
+func (v *VoluntaryExit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VoluntaryExit object with a hasher
+func (v *VoluntaryExit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(v.Epoch)
+
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(v.ValidatorIndex)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the VoluntaryExit object
+func (v *VoluntaryExit) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("VoluntaryExit",
+		ssz.NewField("epoch", ssz.UintSchema(8)),
+		ssz.NewField("validator_index", ssz.UintSchema(8)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the VoluntaryExit object
+func (v *VoluntaryExit) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "epoch", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "validator_index", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 8, Gindex: 3},
+	}
+}
+
+// RandomVoluntaryExit returns a random VoluntaryExit object
+func RandomVoluntaryExit(rng *rand.Rand) *VoluntaryExit {
+	v := new(VoluntaryExit)
+	// Field (0) 'Epoch'
+	v.Epoch = rng.Uint64()
+
+	// Field (1) 'ValidatorIndex'
+	v.ValidatorIndex = rng.Uint64()
+
+	return v
+}
+
+// MarshalSSZ ssz marshals the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, s.SizeSSZ())
+	return s.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the SignedVoluntaryExit object to a target array
+func (s *SignedVoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		if dst, err = new(VoluntaryExit).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = s.Exit.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Signature'
+	if dst, err = ssz.MarshalFixedBytes(dst, s.Signature, 96); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the SignedVoluntaryExit object to the buffers, dst is the encoding since the last referenced field
+func (s *SignedVoluntaryExit) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		if dst, err = new(VoluntaryExit).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(s.Exit, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Signature'
+	if len(s.Signature) != 96 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, s.Signature)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 112 {
+		return errSize
+	}
+
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		s.Exit = new(VoluntaryExit)
+	}
+	if err = s.Exit.UnmarshalSSZ(buf[0:16]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	s.Signature = append(s.Signature[:0], buf[16:112]...)
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the SignedVoluntaryExit object and fails if the input is not its canonical encoding
+func (s *SignedVoluntaryExit) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(s, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the SignedVoluntaryExit object with the nested objects of the pool
+func (s *SignedVoluntaryExit) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 112 {
+		return errSize
+	}
+
+	// Field (0) 'Exit'
+	if s.Exit == nil && pool != nil {
+		s.Exit, _ = pool.Get((*VoluntaryExit)(nil)).(*VoluntaryExit)
+	}
+	if s.Exit == nil {
+		s.Exit = new(VoluntaryExit)
+	}
+	if err = ssz.UnmarshalWithPool(s.Exit, buf[0:16], pool); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	s.Signature = append(s.Signature[:0], buf[16:112]...)
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the SignedVoluntaryExit object, the byte fields alias the input
+func (s *SignedVoluntaryExit) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 112 {
+		return errSize
+	}
+
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		s.Exit = new(VoluntaryExit)
+	}
+	if err = ssz.UnmarshalNoCopy(s.Exit, buf[0:16]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	s.Signature = ssz.Alias(buf[16:112])
+
+	return err
+}
+
+// CopySSZ returns a copy of the SignedVoluntaryExit object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (s *SignedVoluntaryExit) CopySSZ() (*SignedVoluntaryExit, error) {
+	buf, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(SignedVoluntaryExit)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) SizeSSZ() int {
+	return 112
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) MaxSizeSSZ() uint64 {
+	return 112
+}
+
+// HashTreeRoot ssz hashes the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedVoluntaryExit object with a hasher
+func (s *SignedVoluntaryExit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		if err = new(VoluntaryExit).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = s.Exit.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if len(s.Signature) != 96 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("SignedVoluntaryExit",
+		ssz.NewField("message", new(VoluntaryExit).SchemaSSZ()),
+		ssz.NewField("signature", ssz.ByteVectorSchema(96)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "message", Type: "VoluntaryExit", Size: 16, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "signature", Type: "Bytes96", Size: 96, Variable: false, Limit: 0, Offset: 16, Gindex: 3},
+	}
+}
+
+// RandomSignedVoluntaryExit returns a random SignedVoluntaryExit object
+func RandomSignedVoluntaryExit(rng *rand.Rand) *SignedVoluntaryExit {
+	s := new(SignedVoluntaryExit)
+	// Field (0) 'Exit'
+	s.Exit = RandomVoluntaryExit(rng)
+
+	// Field (1) 'Signature'
+	s.Signature = ssz.RandomBytes(rng, 96)
+
+	return s
+}
+
+// MarshalSSZ ssz marshals the Eth1Block object
+func (e *Eth1Block) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ())
+	return e.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Eth1Block object to a target array
+func (e *Eth1Block) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Eth1Block object to the buffers, dst is the encoding since the last referenced field
+func (e *Eth1Block) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Eth1Block object
+func (e *Eth1Block) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 8 {
+		return errSize
+	}
+
+	// Field (0) 'Timestamp'
+	e.Timestamp = ssz.UnmarshallUint64(buf[0:8])
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Eth1Block object and fails if the input is not its canonical encoding
+func (e *Eth1Block) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(e, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Eth1Block object with the nested objects of the pool
+func (e *Eth1Block) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 8 {
+		return errSize
+	}
+
+	// Field (0) 'Timestamp'
+	e.Timestamp = ssz.UnmarshallUint64(buf[0:8])
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Eth1Block object, the byte fields alias the input
+func (e *Eth1Block) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 8 {
+		return errSize
+	}
+
+	// Field (0) 'Timestamp'
+	e.Timestamp = ssz.UnmarshallUint64(buf[0:8])
+
+	return err
+}
+
+// CopySSZ returns a copy of the Eth1Block object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (e *Eth1Block) CopySSZ() (*Eth1Block, error) {
+	buf, err := e.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Eth1Block)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Eth1Block object
+func (e *Eth1Block) SizeSSZ() int {
+	return 8
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Eth1Block object
+func (e *Eth1Block) MaxSizeSSZ() uint64 {
+	return 8
+}
+
+// HashTreeRoot ssz hashes the Eth1Block object
+func (e *Eth1Block) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the Eth1Block object with a hasher
+func (e *Eth1Block) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Timestamp'
+	hh.PutUint64(e.Timestamp)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Eth1Block object
+func (e *Eth1Block) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Eth1Block",
+		ssz.NewField("timestamp", ssz.UintSchema(8)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Eth1Block object
+func (e *Eth1Block) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "timestamp", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 1},
+	}
+}
+
+// RandomEth1Block returns a random Eth1Block object
+func RandomEth1Block(rng *rand.Rand) *Eth1Block {
+	e := new(Eth1Block)
+	// Field (0) 'Timestamp'
+	e.Timestamp = rng.Uint64()
+
+	return e
+}
+
+// MarshalSSZ ssz marshals the Eth1Data object
+func (e *Eth1Data) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ())
+	return e.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Eth1Data object to a target array
+func (e *Eth1Data) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'DepositRoot'
+	if dst, err = ssz.MarshalFixedBytes(dst, e.DepositRoot, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (1) 'DepositCount'
+	dst = ssz.MarshalUint64(dst, e.DepositCount)
+
+	// Field (2) 'BlockHash'
+	if dst, err = ssz.MarshalFixedBytes(dst, e.BlockHash, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Eth1Data object to the buffers, dst is the encoding since the last referenced field
+func (e *Eth1Data) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'DepositRoot'
+	if len(e.DepositRoot) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, e.DepositRoot)
+
+	// Field (1) 'DepositCount'
+	dst = ssz.MarshalUint64(dst, e.DepositCount)
+
+	// Field (2) 'BlockHash'
+	if len(e.BlockHash) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, e.BlockHash)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Eth1Data object
+func (e *Eth1Data) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 72 {
+		return errSize
+	}
+
+	// Field (0) 'DepositRoot'
+	e.DepositRoot = append(e.DepositRoot[:0], buf[0:32]...)
+
+	// Field (1) 'DepositCount'
+	e.DepositCount = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (2) 'BlockHash'
+	e.BlockHash = append(e.BlockHash[:0], buf[40:72]...)
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Eth1Data object and fails if the input is not its canonical encoding
+func (e *Eth1Data) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(e, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Eth1Data object with the nested objects of the pool
+func (e *Eth1Data) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 72 {
+		return errSize
+	}
+
+	// Field (0) 'DepositRoot'
+	e.DepositRoot = append(e.DepositRoot[:0], buf[0:32]...)
+
+	// Field (1) 'DepositCount'
+	e.DepositCount = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (2) 'BlockHash'
+	e.BlockHash = append(e.BlockHash[:0], buf[40:72]...)
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Eth1Data object, the byte fields alias the input
+func (e *Eth1Data) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 72 {
+		return errSize
+	}
+
+	// Field (0) 'DepositRoot'
+	e.DepositRoot = ssz.Alias(buf[0:32])
+
+	// Field (1) 'DepositCount'
+	e.DepositCount = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (2) 'BlockHash'
+	e.BlockHash = ssz.Alias(buf[40:72])
+
+	return err
+}
+
+// CopySSZ returns a copy of the Eth1Data object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (e *Eth1Data) CopySSZ() (*Eth1Data, error) {
+	buf, err := e.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Eth1Data)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Eth1Data object
+func (e *Eth1Data) SizeSSZ() int {
+	return 72
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Eth1Data object
+func (e *Eth1Data) MaxSizeSSZ() uint64 {
+	return 72
+}
+
+// HashTreeRoot ssz hashes the Eth1Data object
+func (e *Eth1Data) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the Eth1Data object with a hasher
+func (e *Eth1Data) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'DepositRoot'
+	if len(e.DepositRoot) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(e.DepositRoot)
+
+	// Field (1) 'DepositCount'
+	hh.PutUint64(e.DepositCount)
+
+	// Field (2) 'BlockHash'
+	if len(e.BlockHash) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(e.BlockHash)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Eth1Data object
+func (e *Eth1Data) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Eth1Data",
+		ssz.NewField("deposit_root", ssz.ByteVectorSchema(32)),
+		ssz.NewField("deposit_count", ssz.UintSchema(8)),
+		ssz.NewField("block_hash", ssz.ByteVectorSchema(32)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Eth1Data object
+func (e *Eth1Data) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "deposit_root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "deposit_count", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 32, Gindex: 5},
+		{Name: "block_hash", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 40, Gindex: 6},
+	}
+}
+
+// RandomEth1Data returns a random Eth1Data object
+func RandomEth1Data(rng *rand.Rand) *Eth1Data {
+	e := new(Eth1Data)
+	// Field (0) 'DepositRoot'
+	e.DepositRoot = ssz.RandomBytes(rng, 32)
+
+	// Field (1) 'DepositCount'
+	e.DepositCount = rng.Uint64()
+
+	// Field (2) 'BlockHash'
+	e.BlockHash = ssz.RandomBytes(rng, 32)
+
+	return e
+}
+
+// MarshalSSZ ssz marshals the SigningRoot object
+func (s *SigningRoot) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, s.SizeSSZ())
+	return s.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the SigningRoot object to a target array
+func (s *SigningRoot) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'ObjectRoot'
+	if dst, err = ssz.MarshalFixedBytes(dst, s.ObjectRoot, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (1) 'Domain'
+	if dst, err = ssz.MarshalFixedBytes(dst, s.Domain, 8); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the SigningRoot object to the buffers, dst is the encoding since the last referenced field
+func (s *SigningRoot) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'ObjectRoot'
+	if len(s.ObjectRoot) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, s.ObjectRoot)
+
+	// Field (1) 'Domain'
+	if len(s.Domain) != 8 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, s.Domain)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the SigningRoot object
+func (s *SigningRoot) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return errSize
+	}
+
+	// Field (0) 'ObjectRoot'
+	s.ObjectRoot = append(s.ObjectRoot[:0], buf[0:32]...)
+
+	// Field (1) 'Domain'
+	s.Domain = append(s.Domain[:0], buf[32:40]...)
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the SigningRoot object and fails if the input is not its canonical encoding
+func (s *SigningRoot) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(s, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the SigningRoot object with the nested objects of the pool
+func (s *SigningRoot) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return errSize
+	}
+
+	// Field (0) 'ObjectRoot'
+	s.ObjectRoot = append(s.ObjectRoot[:0], buf[0:32]...)
+
+	// Field (1) 'Domain'
+	s.Domain = append(s.Domain[:0], buf[32:40]...)
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the SigningRoot object, the byte fields alias the input
+func (s *SigningRoot) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return errSize
+	}
+
+	// Field (0) 'ObjectRoot'
+	s.ObjectRoot = ssz.Alias(buf[0:32])
+
+	// Field (1) 'Domain'
+	s.Domain = ssz.Alias(buf[32:40])
+
+	return err
+}
+
+// CopySSZ returns a copy of the SigningRoot object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (s *SigningRoot) CopySSZ() (*SigningRoot, error) {
+	buf, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(SigningRoot)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SigningRoot object
+func (s *SigningRoot) SizeSSZ() int {
+	return 40
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the SigningRoot object
+func (s *SigningRoot) MaxSizeSSZ() uint64 {
+	return 40
+}
+
+// HashTreeRoot ssz hashes the SigningRoot object
+func (s *SigningRoot) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SigningRoot object with a hasher
+func (s *SigningRoot) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ObjectRoot'
+	if len(s.ObjectRoot) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(s.ObjectRoot)
+
+	// Field (1) 'Domain'
+	if len(s.Domain) != 8 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(s.Domain)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the SigningRoot object
+func (s *SigningRoot) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("SigningRoot",
+		ssz.NewField("object_root", ssz.ByteVectorSchema(32)),
+		ssz.NewField("domain", ssz.ByteVectorSchema(8)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the SigningRoot object
+func (s *SigningRoot) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "object_root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "domain", Type: "Bytes8", Size: 8, Variable: false, Limit: 0, Offset: 32, Gindex: 3},
+	}
+}
+
+// RandomSigningRoot returns a random SigningRoot object
+func RandomSigningRoot(rng *rand.Rand) *SigningRoot {
+	s := new(SigningRoot)
+	// Field (0) 'ObjectRoot'
+	s.ObjectRoot = ssz.RandomBytes(rng, 32)
+
+	// Field (1) 'Domain'
+	s.Domain = ssz.RandomBytes(rng, 8)
+
+	return s
+}
+
+// MarshalSSZ ssz marshals the HistoricalBatch object
+func (h *HistoricalBatch) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, h.SizeSSZ())
+	return h.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the HistoricalBatch object to a target array
+func (h *HistoricalBatch) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'BlockRoots'
+	if len(h.BlockRoots) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, h.BlockRoots[ii], 32); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+
+	// Field (1) 'StateRoots'
+	if len(h.StateRoots) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, h.StateRoots[ii], 32); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the HistoricalBatch object to the buffers, dst is the encoding since the last referenced field
+func (h *HistoricalBatch) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'BlockRoots'
+	if len(h.BlockRoots) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		if len(h.BlockRoots[ii]) != 32 {
+			return nil, errMarshalFixedBytes
+		}
+		dst = bufs.Append(dst, h.BlockRoots[ii])
+	}
+
+	// Field (1) 'StateRoots'
+	if len(h.StateRoots) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		if len(h.StateRoots[ii]) != 32 {
+			return nil, errMarshalFixedBytes
+		}
+		dst = bufs.Append(dst, h.StateRoots[ii])
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the HistoricalBatch object
+func (h *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4096 {
+		return errSize
+	}
+
+	// Field (0) 'BlockRoots'
+	if cap(h.BlockRoots) >= 64 {
+		h.BlockRoots = h.BlockRoots[:64]
+	} else {
+		h.BlockRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		h.BlockRoots[ii] = append(h.BlockRoots[ii][:0], buf[0:2048][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (1) 'StateRoots'
+	if cap(h.StateRoots) >= 64 {
+		h.StateRoots = h.StateRoots[:64]
+	} else {
+		h.StateRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		h.StateRoots[ii] = append(h.StateRoots[ii][:0], buf[2048:4096][ii*32:(ii+1)*32]...)
+	}
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the HistoricalBatch object and fails if the input is not its canonical encoding
+func (h *HistoricalBatch) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(h, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the HistoricalBatch object with the nested objects of the pool
+func (h *HistoricalBatch) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4096 {
+		return errSize
+	}
+
+	// Field (0) 'BlockRoots'
+	if cap(h.BlockRoots) >= 64 {
+		h.BlockRoots = h.BlockRoots[:64]
+	} else {
+		h.BlockRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		h.BlockRoots[ii] = append(h.BlockRoots[ii][:0], buf[0:2048][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (1) 'StateRoots'
+	if cap(h.StateRoots) >= 64 {
+		h.StateRoots = h.StateRoots[:64]
+	} else {
+		h.StateRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		h.StateRoots[ii] = append(h.StateRoots[ii][:0], buf[2048:4096][ii*32:(ii+1)*32]...)
+	}
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the HistoricalBatch object, the byte fields alias the input
+func (h *HistoricalBatch) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4096 {
+		return errSize
+	}
+
+	// Field (0) 'BlockRoots'
+	if cap(h.BlockRoots) >= 64 {
+		h.BlockRoots = h.BlockRoots[:64]
+	} else {
+		h.BlockRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		h.BlockRoots[ii] = ssz.Alias(buf[0:2048][ii*32 : (ii+1)*32])
+	}
+
+	// Field (1) 'StateRoots'
+	if cap(h.StateRoots) >= 64 {
+		h.StateRoots = h.StateRoots[:64]
+	} else {
+		h.StateRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		h.StateRoots[ii] = ssz.Alias(buf[2048:4096][ii*32 : (ii+1)*32])
+	}
+
+	return err
+}
+
+// CopySSZ returns a copy of the HistoricalBatch object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (h *HistoricalBatch) CopySSZ() (*HistoricalBatch, error) {
+	buf, err := h.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(HistoricalBatch)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the HistoricalBatch object
+func (h *HistoricalBatch) SizeSSZ() int {
+	return 4096
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the HistoricalBatch object
+func (h *HistoricalBatch) MaxSizeSSZ() uint64 {
+	return 4096
+}
+
+// HashTreeRoot ssz hashes the HistoricalBatch object
+func (h *HistoricalBatch) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(h)
+}
+
+// HashTreeRootWith ssz hashes the HistoricalBatch object with a hasher
+func (h *HistoricalBatch) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'BlockRoots'
+	{
+		if len(h.BlockRoots) != 64 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(h.BlockRoots); ii++ {
+			if len(h.BlockRoots[ii]) != 32 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(h.BlockRoots[ii])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'StateRoots'
+	{
+		if len(h.StateRoots) != 64 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(h.StateRoots); ii++ {
+			if len(h.StateRoots[ii]) != 32 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(h.StateRoots[ii])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the HistoricalBatch object
+func (h *HistoricalBatch) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("HistoricalBatch",
+		ssz.NewField("block_roots", ssz.VectorSchema(ssz.ByteVectorSchema(32), 64)),
+		ssz.NewField("state_roots", ssz.VectorSchema(ssz.ByteVectorSchema(32), 64)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the HistoricalBatch object
+func (h *HistoricalBatch) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "block_roots", Type: "Vector[Bytes32, 64]", Size: 2048, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "state_roots", Type: "Vector[Bytes32, 64]", Size: 2048, Variable: false, Limit: 0, Offset: 2048, Gindex: 3},
+	}
+}
+
+// RandomHistoricalBatch returns a random HistoricalBatch object
+func RandomHistoricalBatch(rng *rand.Rand) *HistoricalBatch {
+	h := new(HistoricalBatch)
+	// Field (0) 'BlockRoots'
+	{
+		if cap(h.BlockRoots) >= 64 {
+			h.BlockRoots = h.BlockRoots[:64]
+		} else {
+			h.BlockRoots = make([][]byte, 64)
+		}
+		for ii := 0; ii < len(h.BlockRoots); ii++ {
+			h.BlockRoots[ii] = ssz.RandomBytes(rng, 32)
+		}
+	}
+
+	// Field (1) 'StateRoots'
+	{
+		if cap(h.StateRoots) >= 64 {
+			h.StateRoots = h.StateRoots[:64]
+		} else {
+			h.StateRoots = make([][]byte, 64)
+		}
+		for ii := 0; ii < len(h.StateRoots); ii++ {
+			h.StateRoots[ii] = ssz.RandomBytes(rng, 32)
+		}
+	}
+
+	return h
+}
+
+// MarshalSSZ ssz marshals the ProposerSlashing object
+func (p *ProposerSlashing) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, p.SizeSSZ())
+	return p.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the ProposerSlashing object to a target array
+func (p *ProposerSlashing) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, p.ProposerIndex)
+
+	// Field (1) 'Header1'
+	if p.Header1 == nil {
+		if dst, err = new(SignedBeaconBlockHeader).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = p.Header1.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Header2'
+	if p.Header2 == nil {
+		if dst, err = new(SignedBeaconBlockHeader).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = p.Header2.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the ProposerSlashing object to the buffers, dst is the encoding since the last referenced field
+func (p *ProposerSlashing) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, p.ProposerIndex)
+
+	// Field (1) 'Header1'
+	if p.Header1 == nil {
+		if dst, err = new(SignedBeaconBlockHeader).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(p.Header1, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Header2'
+	if p.Header2 == nil {
+		if dst, err = new(SignedBeaconBlockHeader).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(p.Header2, dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the ProposerSlashing object
+func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 408 {
+		return errSize
+	}
+
+	// Field (0) 'ProposerIndex'
+	p.ProposerIndex = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Header1'
+	if p.Header1 == nil {
+		p.Header1 = new(SignedBeaconBlockHeader)
+	}
+	if err = p.Header1.UnmarshalSSZ(buf[8:208]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Header2'
+	if p.Header2 == nil {
+		p.Header2 = new(SignedBeaconBlockHeader)
+	}
+	if err = p.Header2.UnmarshalSSZ(buf[208:408]); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the ProposerSlashing object and fails if the input is not its canonical encoding
+func (p *ProposerSlashing) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(p, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the ProposerSlashing object with the nested objects of the pool
+func (p *ProposerSlashing) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 408 {
+		return errSize
+	}
+
+	// Field (0) 'ProposerIndex'
+	p.ProposerIndex = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Header1'
+	if p.Header1 == nil && pool != nil {
+		p.Header1, _ = pool.Get((*SignedBeaconBlockHeader)(nil)).(*SignedBeaconBlockHeader)
+	}
+	if p.Header1 == nil {
+		p.Header1 = new(SignedBeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalWithPool(p.Header1, buf[8:208], pool); err != nil {
+		return err
+	}
+
+	// Field (2) 'Header2'
+	if p.Header2 == nil && pool != nil {
+		p.Header2, _ = pool.Get((*SignedBeaconBlockHeader)(nil)).(*SignedBeaconBlockHeader)
+	}
+	if p.Header2 == nil {
+		p.Header2 = new(SignedBeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalWithPool(p.Header2, buf[208:408], pool); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the ProposerSlashing object, the byte fields alias the input
+func (p *ProposerSlashing) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 408 {
+		return errSize
+	}
+
+	// Field (0) 'ProposerIndex'
+	p.ProposerIndex = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Header1'
+	if p.Header1 == nil {
+		p.Header1 = new(SignedBeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalNoCopy(p.Header1, buf[8:208]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Header2'
+	if p.Header2 == nil {
+		p.Header2 = new(SignedBeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalNoCopy(p.Header2, buf[208:408]); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// CopySSZ returns a copy of the ProposerSlashing object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (p *ProposerSlashing) CopySSZ() (*ProposerSlashing, error) {
+	buf, err := p.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(ProposerSlashing)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ProposerSlashing object
+func (p *ProposerSlashing) SizeSSZ() int {
+	return 408
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the ProposerSlashing object
+func (p *ProposerSlashing) MaxSizeSSZ() uint64 {
+	return 408
+}
+
+// HashTreeRoot ssz hashes the ProposerSlashing object
+func (p *ProposerSlashing) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the ProposerSlashing object with a hasher
+func (p *ProposerSlashing) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ProposerIndex'
+	hh.PutUint64(p.ProposerIndex)
+
+	// Field (1) 'Header1'
+	if p.Header1 == nil {
+		if err = new(SignedBeaconBlockHeader).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = p.Header1.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Header2'
+	if p.Header2 == nil {
+		if err = new(SignedBeaconBlockHeader).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = p.Header2.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the ProposerSlashing object
+func (p *ProposerSlashing) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("ProposerSlashing",
+		ssz.NewField("proposer_index", ssz.UintSchema(8)),
+		ssz.NewField("signed_header_1", new(SignedBeaconBlockHeader).SchemaSSZ()),
+		ssz.NewField("signed_header_2", new(SignedBeaconBlockHeader).SchemaSSZ()),
+	)
+}
+
+// SSZFields returns the layout of the fields of the ProposerSlashing object
+func (p *ProposerSlashing) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "proposer_index", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "signed_header_1", Type: "SignedBeaconBlockHeader", Size: 200, Variable: false, Limit: 0, Offset: 8, Gindex: 5},
+		{Name: "signed_header_2", Type: "SignedBeaconBlockHeader", Size: 200, Variable: false, Limit: 0, Offset: 208, Gindex: 6},
+	}
+}
+
+// RandomProposerSlashing returns a random ProposerSlashing object
+func RandomProposerSlashing(rng *rand.Rand) *ProposerSlashing {
+	p := new(ProposerSlashing)
+	// Field (0) 'ProposerIndex'
+	p.ProposerIndex = rng.Uint64()
+
+	// Field (1) 'Header1'
+	p.Header1 = RandomSignedBeaconBlockHeader(rng)
+
+	// Field (2) 'Header2'
+	p.Header2 = RandomSignedBeaconBlockHeader(rng)
+
+	return p
+}
+
+// MarshalSSZ ssz marshals the AttesterSlashing object
+func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, a.SizeSSZ())
+	return a.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the AttesterSlashing object to a target array
+func (a *AttesterSlashing) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(8)
+
+	// Offset (0) 'Attestation1'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	if a.Attestation1 == nil {
+		offset += new(IndexedAttestation).SizeSSZ()
+	} else {
+		offset += a.Attestation1.SizeSSZ()
+	}
+
+	// Offset (1) 'Attestation2'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	if a.Attestation2 == nil {
+		offset += new(IndexedAttestation).SizeSSZ()
+	} else {
+		offset += a.Attestation2.SizeSSZ()
+	}
+
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		if dst, err = new(IndexedAttestation).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = a.Attestation1.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		if dst, err = new(IndexedAttestation).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = a.Attestation2.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the AttesterSlashing object to the buffers, dst is the encoding since the last referenced field
+func (a *AttesterSlashing) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(8)
+
+	// Offset (0) 'Attestation1'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	if a.Attestation1 == nil {
+		offset += new(IndexedAttestation).SizeSSZ()
+	} else {
+		offset += a.Attestation1.SizeSSZ()
+	}
+
+	// Offset (1) 'Attestation2'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	if a.Attestation2 == nil {
+		offset += new(IndexedAttestation).SizeSSZ()
+	} else {
+		offset += a.Attestation2.SizeSSZ()
+	}
+
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		if dst, err = new(IndexedAttestation).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(a.Attestation1, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		if dst, err = new(IndexedAttestation).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(a.Attestation2, dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the AttesterSlashing object
+func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return errSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Attestation1'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 8 {
+		return errOffset
+	}
+
+	// Offset (1) 'Attestation2'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return errOffset
+	}
+
+	// Field (0) 'Attestation1'
+	{
+		buf = tail[o0:o1]
+		if a.Attestation1 == nil {
+			a.Attestation1 = new(IndexedAttestation)
+		}
+		if err = a.Attestation1.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Attestation2'
+	{
+		buf = tail[o1:]
+		if a.Attestation2 == nil {
+			a.Attestation2 = new(IndexedAttestation)
+		}
+		if err = a.Attestation2.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the AttesterSlashing object and fails if the input is not its canonical encoding
+func (a *AttesterSlashing) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(a, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the AttesterSlashing object with the nested objects of the pool
+func (a *AttesterSlashing) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return errSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Attestation1'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 8 {
+		return errOffset
+	}
+
+	// Offset (1) 'Attestation2'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return errOffset
+	}
+
+	// Field (0) 'Attestation1'
+	{
+		buf = tail[o0:o1]
+		if a.Attestation1 == nil && pool != nil {
+			a.Attestation1, _ = pool.Get((*IndexedAttestation)(nil)).(*IndexedAttestation)
+		}
+		if a.Attestation1 == nil {
+			a.Attestation1 = new(IndexedAttestation)
+		}
+		if err = ssz.UnmarshalWithPool(a.Attestation1, buf, pool); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Attestation2'
+	{
+		buf = tail[o1:]
+		if a.Attestation2 == nil && pool != nil {
+			a.Attestation2, _ = pool.Get((*IndexedAttestation)(nil)).(*IndexedAttestation)
+		}
+		if a.Attestation2 == nil {
+			a.Attestation2 = new(IndexedAttestation)
+		}
+		if err = ssz.UnmarshalWithPool(a.Attestation2, buf, pool); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the AttesterSlashing object, the byte fields alias the input
+func (a *AttesterSlashing) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return errSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Attestation1'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 8 {
+		return errOffset
+	}
+
+	// Offset (1) 'Attestation2'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return errOffset
+	}
+
+	// Field (0) 'Attestation1'
+	{
+		buf = tail[o0:o1]
+		if a.Attestation1 == nil {
+			a.Attestation1 = new(IndexedAttestation)
+		}
+		if err = ssz.UnmarshalNoCopy(a.Attestation1, buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Attestation2'
+	{
+		buf = tail[o1:]
+		if a.Attestation2 == nil {
+			a.Attestation2 = new(IndexedAttestation)
+		}
+		if err = ssz.UnmarshalNoCopy(a.Attestation2, buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the AttesterSlashing object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (a *AttesterSlashing) CopySSZ() (*AttesterSlashing, error) {
+	buf, err := a.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(AttesterSlashing)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AttesterSlashing object
+func (a *AttesterSlashing) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		size += new(IndexedAttestation).SizeSSZ()
+	} else {
+		size += a.Attestation1.SizeSSZ()
+	}
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		size += new(IndexedAttestation).SizeSSZ()
+	} else {
+		size += a.Attestation2.SizeSSZ()
+	}
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the AttesterSlashing object
+func (a *AttesterSlashing) MaxSizeSSZ() uint64 {
+	return 33232
+}
+
+// HashTreeRoot ssz hashes the AttesterSlashing object
+func (a *AttesterSlashing) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AttesterSlashing object with a hasher
+func (a *AttesterSlashing) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		if err = new(IndexedAttestation).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = a.Attestation1.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		if err = new(IndexedAttestation).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = a.Attestation2.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the AttesterSlashing object
+func (a *AttesterSlashing) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("AttesterSlashing",
+		ssz.NewField("attestation_1", new(IndexedAttestation).SchemaSSZ()),
+		ssz.NewField("attestation_2", new(IndexedAttestation).SchemaSSZ()),
+	)
+}
+
+// SSZFields returns the layout of the fields of the AttesterSlashing object
+func (a *AttesterSlashing) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "attestation_1", Type: "IndexedAttestation", Size: 4, Variable: true, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "attestation_2", Type: "IndexedAttestation", Size: 4, Variable: true, Limit: 0, Offset: 4, Gindex: 3},
+	}
+}
+
+// RandomAttesterSlashing returns a random AttesterSlashing object
+func RandomAttesterSlashing(rng *rand.Rand) *AttesterSlashing {
+	a := new(AttesterSlashing)
+	// Field (0) 'Attestation1'
+	a.Attestation1 = RandomIndexedAttestation(rng)
+
+	// Field (1) 'Attestation2'
+	a.Attestation2 = RandomIndexedAttestation(rng)
+
+	return a
+}
+
+// MarshalSSZ ssz marshals the BeaconState object
+func (b *BeaconState) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ())
+	return b.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the BeaconState object to a target array
+func (b *BeaconState) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(7017)
+
+	// Field (0) 'GenesisTime'
+	if dst, err = b.marshalSSZGenesisTime(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Slot'
+	if dst, err = b.marshalSSZSlot(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Fork'
+	if dst, err = b.marshalSSZFork(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (3) 'LatestBlockHeader'
+	if dst, err = b.marshalSSZLatestBlockHeader(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'BlockRoots'
+	if dst, err = b.marshalSSZBlockRoots(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (5) 'StateRoots'
+	if dst, err = b.marshalSSZStateRoots(dst); err != nil {
+		return nil, err
+	}
+
+	// Offset (6) 'HistoricalRoots'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.HistoricalRoots) * 32
+
+	// Field (7) 'Eth1Data'
+	if dst, err = b.marshalSSZEth1Data(dst); err != nil {
+		return nil, err
+	}
+
+	// Offset (8) 'Eth1DataVotes'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.Eth1DataVotes) * 72
+
+	// Field (9) 'Eth1DepositIndex'
+	if dst, err = b.marshalSSZEth1DepositIndex(dst); err != nil {
+		return nil, err
+	}
+
+	// Offset (10) 'Validators'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.Validators) * 121
+
+	// Offset (11) 'Balances'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.Balances) * 8
+
+	// Field (12) 'RandaoMixes'
+	if dst, err = b.marshalSSZRandaoMixes(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (13) 'Slashings'
+	if dst, err = b.marshalSSZSlashings(dst); err != nil {
+		return nil, err
+	}
+
+	// Offset (14) 'PreviousEpochAttestations'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+		offset += 4
+		if b.PreviousEpochAttestations[ii] == nil {
+			offset += new(PendingAttestation).SizeSSZ()
+		} else {
+			offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+		}
+	}
+
+	// Offset (15) 'CurrentEpochAttestations'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+		offset += 4
+		if b.CurrentEpochAttestations[ii] == nil {
+			offset += new(PendingAttestation).SizeSSZ()
+		} else {
+			offset += b.CurrentEpochAttestations[ii].SizeSSZ()
+		}
+	}
+
+	// Field (16) 'JustificationBits'
+	if dst, err = b.marshalSSZJustificationBits(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (17) 'PreviousJustifiedCheckpoint'
+	if dst, err = b.marshalSSZPreviousJustifiedCheckpoint(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (18) 'CurrentJustifiedCheckpoint'
+	if dst, err = b.marshalSSZCurrentJustifiedCheckpoint(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (19) 'FinalizedCheckpoint'
+	if dst, err = b.marshalSSZFinalizedCheckpoint(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (6) 'HistoricalRoots'
+	if dst, err = b.marshalSSZHistoricalRoots(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (8) 'Eth1DataVotes'
+	if dst, err = b.marshalSSZEth1DataVotes(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (10) 'Validators'
+	if dst, err = b.marshalSSZValidators(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (11) 'Balances'
+	if dst, err = b.marshalSSZBalances(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (14) 'PreviousEpochAttestations'
+	if dst, err = b.marshalSSZPreviousEpochAttestations(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (15) 'CurrentEpochAttestations'
+	if dst, err = b.marshalSSZCurrentEpochAttestations(dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// marshalSSZGenesisTime ssz marshals the GenesisTime field of the BeaconState object
+func (b *BeaconState) marshalSSZGenesisTime(dst []byte) (res []byte, err error) {
+	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+	return dst, nil
+}
+
+// marshalSSZSlot ssz marshals the Slot field of the BeaconState object
+func (b *BeaconState) marshalSSZSlot(dst []byte) (res []byte, err error) {
+	dst = ssz.MarshalUint64(dst, b.Slot)
+	return dst, nil
+}
+
+// marshalSSZFork ssz marshals the Fork field of the BeaconState object
+func (b *BeaconState) marshalSSZFork(dst []byte) (res []byte, err error) {
+	if b.Fork == nil {
+		if dst, err = new(Fork).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// marshalSSZLatestBlockHeader ssz marshals the LatestBlockHeader field of the BeaconState object
+func (b *BeaconState) marshalSSZLatestBlockHeader(dst []byte) (res []byte, err error) {
+	if b.LatestBlockHeader == nil {
+		if dst, err = new(BeaconBlockHeader).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// marshalSSZBlockRoots ssz marshals the BlockRoots field of the BeaconState object
+func (b *BeaconState) marshalSSZBlockRoots(dst []byte) (res []byte, err error) {
+	if len(b.BlockRoots) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, b.BlockRoots[ii], 32); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+	return dst, nil
+}
+
+// marshalSSZStateRoots ssz marshals the StateRoots field of the BeaconState object
+func (b *BeaconState) marshalSSZStateRoots(dst []byte) (res []byte, err error) {
+	if len(b.StateRoots) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, b.StateRoots[ii], 32); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+	return dst, nil
+}
+
+// marshalSSZHistoricalRoots ssz marshals the HistoricalRoots field of the BeaconState object
+func (b *BeaconState) marshalSSZHistoricalRoots(dst []byte) (res []byte, err error) {
+	if len(b.HistoricalRoots) > 16777216 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, b.HistoricalRoots[ii], 32); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+	return dst, nil
+}
+
+// marshalSSZEth1Data ssz marshals the Eth1Data field of the BeaconState object
+func (b *BeaconState) marshalSSZEth1Data(dst []byte) (res []byte, err error) {
+	if b.Eth1Data == nil {
+		if dst, err = new(Eth1Data).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// marshalSSZEth1DataVotes ssz marshals the Eth1DataVotes field of the BeaconState object
+func (b *BeaconState) marshalSSZEth1DataVotes(dst []byte) (res []byte, err error) {
+	if len(b.Eth1DataVotes) > 1024 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if b.Eth1DataVotes[ii] == nil {
+			if dst, err = new(Eth1Data).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+// marshalSSZEth1DepositIndex ssz marshals the Eth1DepositIndex field of the BeaconState object
+func (b *BeaconState) marshalSSZEth1DepositIndex(dst []byte) (res []byte, err error) {
+	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+	return dst, nil
+}
+
+// marshalSSZValidators ssz marshals the Validators field of the BeaconState object
+func (b *BeaconState) marshalSSZValidators(dst []byte) (res []byte, err error) {
+	if uint64(len(b.Validators)) > 1099511627776 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if b.Validators[ii] == nil {
+			if dst, err = new(Validator).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+// marshalSSZBalances ssz marshals the Balances field of the BeaconState object
+func (b *BeaconState) marshalSSZBalances(dst []byte) (res []byte, err error) {
+	if uint64(len(b.Balances)) > 1099511627776 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, b.Balances[ii])
+	}
+	return dst, nil
+}
+
+// marshalSSZRandaoMixes ssz marshals the RandaoMixes field of the BeaconState object
+func (b *BeaconState) marshalSSZRandaoMixes(dst []byte) (res []byte, err error) {
+	if len(b.RandaoMixes) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, b.RandaoMixes[ii], 32); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+	return dst, nil
+}
+
+// marshalSSZSlashings ssz marshals the Slashings field of the BeaconState object
+func (b *BeaconState) marshalSSZSlashings(dst []byte) (res []byte, err error) {
+	if len(b.Slashings) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+	}
+	return dst, nil
+}
+
+// marshalSSZPreviousEpochAttestations ssz marshals the PreviousEpochAttestations field of the BeaconState object
+func (b *BeaconState) marshalSSZPreviousEpochAttestations(dst []byte) (res []byte, err error) {
+	var offset int
+	if len(b.PreviousEpochAttestations) > 4096 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(b.PreviousEpochAttestations)
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			if b.PreviousEpochAttestations[ii] == nil {
+				offset += new(PendingAttestation).SizeSSZ()
+			} else {
+				offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+			}
+		}
+	}
+	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+		if b.PreviousEpochAttestations[ii] == nil {
+			if dst, err = new(PendingAttestation).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = b.PreviousEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+// marshalSSZCurrentEpochAttestations ssz marshals the CurrentEpochAttestations field of the BeaconState object
+func (b *BeaconState) marshalSSZCurrentEpochAttestations(dst []byte) (res []byte, err error) {
+	var offset int
+	if len(b.CurrentEpochAttestations) > 4096 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(b.CurrentEpochAttestations)
+		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			if b.CurrentEpochAttestations[ii] == nil {
+				offset += new(PendingAttestation).SizeSSZ()
+			} else {
+				offset += b.CurrentEpochAttestations[ii].SizeSSZ()
+			}
+		}
+	}
+	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+		if b.CurrentEpochAttestations[ii] == nil {
+			if dst, err = new(PendingAttestation).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = b.CurrentEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+// marshalSSZJustificationBits ssz marshals the JustificationBits field of the BeaconState object
+func (b *BeaconState) marshalSSZJustificationBits(dst []byte) (res []byte, err error) {
+	if dst, err = ssz.MarshalFixedBytes(dst, b.JustificationBits, 1); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+	return dst, nil
+}
+
+// marshalSSZPreviousJustifiedCheckpoint ssz marshals the PreviousJustifiedCheckpoint field of the BeaconState object
+func (b *BeaconState) marshalSSZPreviousJustifiedCheckpoint(dst []byte) (res []byte, err error) {
+	if b.PreviousJustifiedCheckpoint == nil {
+		if dst, err = new(Checkpoint).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// marshalSSZCurrentJustifiedCheckpoint ssz marshals the CurrentJustifiedCheckpoint field of the BeaconState object
+func (b *BeaconState) marshalSSZCurrentJustifiedCheckpoint(dst []byte) (res []byte, err error) {
+	if b.CurrentJustifiedCheckpoint == nil {
+		if dst, err = new(Checkpoint).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// marshalSSZFinalizedCheckpoint ssz marshals the FinalizedCheckpoint field of the BeaconState object
+func (b *BeaconState) marshalSSZFinalizedCheckpoint(dst []byte) (res []byte, err error) {
+	if b.FinalizedCheckpoint == nil {
+		if dst, err = new(Checkpoint).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// MarshalSSZToBuffers ssz marshals the BeaconState object to the buffers, dst is the encoding since the last referenced field
+func (b *BeaconState) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(7017)
+
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+	// Field (1) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (2) 'Fork'
+	if b.Fork == nil {
+		if dst, err = new(Fork).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(b.Fork, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (3) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		if dst, err = new(BeaconBlockHeader).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(b.LatestBlockHeader, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'BlockRoots'
+	if len(b.BlockRoots) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		if len(b.BlockRoots[ii]) != 32 {
+			return nil, errMarshalFixedBytes
+		}
+		dst = bufs.Append(dst, b.BlockRoots[ii])
+	}
+
+	// Field (5) 'StateRoots'
+	if len(b.StateRoots) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		if len(b.StateRoots[ii]) != 32 {
+			return nil, errMarshalFixedBytes
+		}
+		dst = bufs.Append(dst, b.StateRoots[ii])
+	}
+
+	// Offset (6) 'HistoricalRoots'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.HistoricalRoots) * 32
+
+	// Field (7) 'Eth1Data'
+	if b.Eth1Data == nil {
+		if dst, err = new(Eth1Data).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(b.Eth1Data, dst); err != nil {
+		return nil, err
+	}
+
+	// Offset (8) 'Eth1DataVotes'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.Eth1DataVotes) * 72
+
+	// Field (9) 'Eth1DepositIndex'
+	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+	// Offset (10) 'Validators'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.Validators) * 121
+
+	// Offset (11) 'Balances'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.Balances) * 8
+
+	// Field (12) 'RandaoMixes'
+	if len(b.RandaoMixes) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		if len(b.RandaoMixes[ii]) != 32 {
+			return nil, errMarshalFixedBytes
+		}
+		dst = bufs.Append(dst, b.RandaoMixes[ii])
+	}
+
+	// Field (13) 'Slashings'
+	if len(b.Slashings) != 64 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 64; ii++ {
+		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+	}
+
+	// Offset (14) 'PreviousEpochAttestations'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+		offset += 4
+		if b.PreviousEpochAttestations[ii] == nil {
+			offset += new(PendingAttestation).SizeSSZ()
+		} else {
+			offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+		}
+	}
+
+	// Offset (15) 'CurrentEpochAttestations'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+		offset += 4
+		if b.CurrentEpochAttestations[ii] == nil {
+			offset += new(PendingAttestation).SizeSSZ()
+		} else {
+			offset += b.CurrentEpochAttestations[ii].SizeSSZ()
+		}
+	}
+
+	// Field (16) 'JustificationBits'
+	if dst, err = ssz.MarshalFixedBytes(dst, b.JustificationBits, 1); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (17) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		if dst, err = new(Checkpoint).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(b.PreviousJustifiedCheckpoint, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (18) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		if dst, err = new(Checkpoint).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(b.CurrentJustifiedCheckpoint, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (19) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		if dst, err = new(Checkpoint).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(b.FinalizedCheckpoint, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (6) 'HistoricalRoots'
+	if len(b.HistoricalRoots) > 16777216 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		if len(b.HistoricalRoots[ii]) != 32 {
+			return nil, errMarshalFixedBytes
+		}
+		dst = bufs.Append(dst, b.HistoricalRoots[ii])
+	}
+
+	// Field (8) 'Eth1DataVotes'
+	if len(b.Eth1DataVotes) > 1024 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if b.Eth1DataVotes[ii] == nil {
+			if dst, err = new(Eth1Data).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = bufs.MarshalTo(b.Eth1DataVotes[ii], dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (10) 'Validators'
+	if uint64(len(b.Validators)) > 1099511627776 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if b.Validators[ii] == nil {
+			if dst, err = new(Validator).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = bufs.MarshalTo(b.Validators[ii], dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (11) 'Balances'
+	if uint64(len(b.Balances)) > 1099511627776 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, b.Balances[ii])
+	}
+
+	// Field (14) 'PreviousEpochAttestations'
+	if len(b.PreviousEpochAttestations) > 4096 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(b.PreviousEpochAttestations)
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			if b.PreviousEpochAttestations[ii] == nil {
+				offset += new(PendingAttestation).SizeSSZ()
+			} else {
+				offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+			}
+		}
+	}
+	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+		if b.PreviousEpochAttestations[ii] == nil {
+			if dst, err = new(PendingAttestation).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = bufs.MarshalTo(b.PreviousEpochAttestations[ii], dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (15) 'CurrentEpochAttestations'
+	if len(b.CurrentEpochAttestations) > 4096 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(b.CurrentEpochAttestations)
+		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			if b.CurrentEpochAttestations[ii] == nil {
+				offset += new(PendingAttestation).SizeSSZ()
+			} else {
+				offset += b.CurrentEpochAttestations[ii].SizeSSZ()
+			}
+		}
+	}
+	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+		if b.CurrentEpochAttestations[ii] == nil {
+			if dst, err = new(PendingAttestation).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = bufs.MarshalTo(b.CurrentEpochAttestations[ii], dst); err != nil {
+			return nil, err
+		}
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconState object
+func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 7017 {
+		return errSize
+	}
+
+	tail := buf
+	var o6, o8, o10, o11, o14, o15 uint64
+
+	// Field (0) 'GenesisTime'
+	if err = b.unmarshalSSZGenesisTime(buf[0:8]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Slot'
+	if err = b.unmarshalSSZSlot(buf[8:16]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Fork'
+	if err = b.unmarshalSSZFork(buf[16:32]); err != nil {
+		return err
+	}
+
+	// Field (3) 'LatestBlockHeader'
+	if err = b.unmarshalSSZLatestBlockHeader(buf[32:136]); err != nil {
+		return err
+	}
+
+	// Field (4) 'BlockRoots'
+	if err = b.unmarshalSSZBlockRoots(buf[136:2184]); err != nil {
+		return err
+	}
+
+	// Field (5) 'StateRoots'
+	if err = b.unmarshalSSZStateRoots(buf[2184:4232]); err != nil {
+		return err
+	}
+
+	// Offset (6) 'HistoricalRoots'
+	if o6 = ssz.ReadOffset(buf[4232:4236]); o6 > size || o6 != 7017 {
+		return errOffset
+	}
+
+	// Field (7) 'Eth1Data'
+	if err = b.unmarshalSSZEth1Data(buf[4236:4308]); err != nil {
+		return err
+	}
+
+	// Offset (8) 'Eth1DataVotes'
+	if o8 = ssz.ReadOffset(buf[4308:4312]); o8 > size || o6 > o8 {
+		return errOffset
+	}
+
+	// Field (9) 'Eth1DepositIndex'
+	if err = b.unmarshalSSZEth1DepositIndex(buf[4312:4320]); err != nil {
+		return err
+	}
+
+	// Offset (10) 'Validators'
+	if o10 = ssz.ReadOffset(buf[4320:4324]); o10 > size || o8 > o10 {
+		return errOffset
+	}
+
+	// Offset (11) 'Balances'
+	if o11 = ssz.ReadOffset(buf[4324:4328]); o11 > size || o10 > o11 {
+		return errOffset
+	}
+
+	// Field (12) 'RandaoMixes'
+	if err = b.unmarshalSSZRandaoMixes(buf[4328:6376]); err != nil {
+		return err
+	}
+
+	// Field (13) 'Slashings'
+	if err = b.unmarshalSSZSlashings(buf[6376:6888]); err != nil {
+		return err
+	}
+
+	// Offset (14) 'PreviousEpochAttestations'
+	if o14 = ssz.ReadOffset(buf[6888:6892]); o14 > size || o11 > o14 {
+		return errOffset
+	}
+
+	// Offset (15) 'CurrentEpochAttestations'
+	if o15 = ssz.ReadOffset(buf[6892:6896]); o15 > size || o14 > o15 {
+		return errOffset
+	}
+
+	// Field (16) 'JustificationBits'
+	if err = b.unmarshalSSZJustificationBits(buf[6896:6897]); err != nil {
+		return err
+	}
+
+	// Field (17) 'PreviousJustifiedCheckpoint'
+	if err = b.unmarshalSSZPreviousJustifiedCheckpoint(buf[6897:6937]); err != nil {
+		return err
+	}
+
+	// Field (18) 'CurrentJustifiedCheckpoint'
+	if err = b.unmarshalSSZCurrentJustifiedCheckpoint(buf[6937:6977]); err != nil {
+		return err
+	}
+
+	// Field (19) 'FinalizedCheckpoint'
+	if err = b.unmarshalSSZFinalizedCheckpoint(buf[6977:7017]); err != nil {
+		return err
+	}
+
+	// Field (6) 'HistoricalRoots'
+	{
+		buf = tail[o6:o8]
+		if err = b.unmarshalSSZHistoricalRoots(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (8) 'Eth1DataVotes'
+	{
+		buf = tail[o8:o10]
+		if err = b.unmarshalSSZEth1DataVotes(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (10) 'Validators'
+	{
+		buf = tail[o10:o11]
+		if err = b.unmarshalSSZValidators(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (11) 'Balances'
+	{
+		buf = tail[o11:o14]
+		if err = b.unmarshalSSZBalances(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (14) 'PreviousEpochAttestations'
+	{
+		buf = tail[o14:o15]
+		if err = b.unmarshalSSZPreviousEpochAttestations(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (15) 'CurrentEpochAttestations'
+	{
+		buf = tail[o15:]
+		if err = b.unmarshalSSZCurrentEpochAttestations(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// unmarshalSSZGenesisTime ssz unmarshals the GenesisTime field of the BeaconState object
+func (b *BeaconState) unmarshalSSZGenesisTime(buf []byte) (err error) {
+	b.GenesisTime = ssz.UnmarshallUint64(buf)
+	return
+}
+
+// unmarshalSSZSlot ssz unmarshals the Slot field of the BeaconState object
+func (b *BeaconState) unmarshalSSZSlot(buf []byte) (err error) {
+	b.Slot = ssz.UnmarshallUint64(buf)
+	return
+}
+
+// unmarshalSSZFork ssz unmarshals the Fork field of the BeaconState object
+func (b *BeaconState) unmarshalSSZFork(buf []byte) (err error) {
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = b.Fork.UnmarshalSSZ(buf); err != nil {
+		return err
+	}
+	return
+}
+
+// unmarshalSSZLatestBlockHeader ssz unmarshals the LatestBlockHeader field of the BeaconState object
+func (b *BeaconState) unmarshalSSZLatestBlockHeader(buf []byte) (err error) {
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = b.LatestBlockHeader.UnmarshalSSZ(buf); err != nil {
+		return err
+	}
+	return
+}
+
+// unmarshalSSZBlockRoots ssz unmarshals the BlockRoots field of the BeaconState object
+func (b *BeaconState) unmarshalSSZBlockRoots(buf []byte) (err error) {
+	if cap(b.BlockRoots) >= 64 {
+		b.BlockRoots = b.BlockRoots[:64]
+	} else {
+		b.BlockRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.BlockRoots[ii] = append(b.BlockRoots[ii][:0], buf[ii*32:(ii+1)*32]...)
+	}
+	return
+}
+
+// unmarshalSSZStateRoots ssz unmarshals the StateRoots field of the BeaconState object
+func (b *BeaconState) unmarshalSSZStateRoots(buf []byte) (err error) {
+	if cap(b.StateRoots) >= 64 {
+		b.StateRoots = b.StateRoots[:64]
+	} else {
+		b.StateRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.StateRoots[ii] = append(b.StateRoots[ii][:0], buf[ii*32:(ii+1)*32]...)
+	}
+	return
+}
+
+// unmarshalSSZHistoricalRoots ssz unmarshals the HistoricalRoots field of the BeaconState object
+func (b *BeaconState) unmarshalSSZHistoricalRoots(buf []byte) (err error) {
+	num, ok := ssz.DivideInt(len(buf), 32)
+	if !ok {
+		return errDivideInt
+	}
+	if num > 16777216 {
+		return errListTooBig
+	}
+	if cap(b.HistoricalRoots) >= num {
+		b.HistoricalRoots = b.HistoricalRoots[:num]
+	} else {
+		b.HistoricalRoots = make([][]byte, num)
+	}
+	for ii := 0; ii < num; ii++ {
+		b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii][:0], buf[ii*32:(ii+1)*32]...)
+	}
+	return
+}
+
+// unmarshalSSZEth1Data ssz unmarshals the Eth1Data field of the BeaconState object
+func (b *BeaconState) unmarshalSSZEth1Data(buf []byte) (err error) {
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf); err != nil {
+		return err
+	}
+	return
+}
+
+// unmarshalSSZEth1DataVotes ssz unmarshals the Eth1DataVotes field of the BeaconState object
+func (b *BeaconState) unmarshalSSZEth1DataVotes(buf []byte) (err error) {
+	num, ok := ssz.DivideInt(len(buf), 72)
+	if !ok {
+		return errDivideInt
+	}
+	if num > 1024 {
+		return errListTooBig
+	}
+	if cap(b.Eth1DataVotes) >= num {
+		b.Eth1DataVotes = b.Eth1DataVotes[:num]
+	} else {
+		b.Eth1DataVotes = make([]*Eth1Data, num)
+	}
+	for ii := 0; ii < num; ii++ {
+		if b.Eth1DataVotes[ii] == nil {
+			b.Eth1DataVotes[ii] = new(Eth1Data)
+		}
+		if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
+			return err
+		}
+	}
+	return
+}
+
+// unmarshalSSZEth1DepositIndex ssz unmarshals the Eth1DepositIndex field of the BeaconState object
+func (b *BeaconState) unmarshalSSZEth1DepositIndex(buf []byte) (err error) {
+	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf)
+	return
+}
+
+// unmarshalSSZValidators ssz unmarshals the Validators field of the BeaconState object
+func (b *BeaconState) unmarshalSSZValidators(buf []byte) (err error) {
+	num, ok := ssz.DivideInt(len(buf), 121)
+	if !ok {
+		return errDivideInt
+	}
+	if uint64(num) > 1099511627776 {
+		return errListTooBig
+	}
+	if cap(b.Validators) >= num {
+		b.Validators = b.Validators[:num]
+	} else {
+		b.Validators = make([]*Validator, num)
+	}
+	for ii := 0; ii < num; ii++ {
+		if b.Validators[ii] == nil {
+			b.Validators[ii] = new(Validator)
+		}
+		if err = b.Validators[ii].UnmarshalSSZ(buf[ii*121 : (ii+1)*121]); err != nil {
+			return err
+		}
+	}
+	return
+}
+
+// unmarshalSSZBalances ssz unmarshals the Balances field of the BeaconState object
+func (b *BeaconState) unmarshalSSZBalances(buf []byte) (err error) {
+	num, ok := ssz.DivideInt(len(buf), 8)
+	if !ok {
+		return errDivideInt
+	}
+	if uint64(num) > 1099511627776 {
+		return errListTooBig
+	}
+	b.Balances = ssz.ExtendUint64(b.Balances, num)
+	for ii := 0; ii < num; ii++ {
+		b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+	}
+	return
+}
+
+// unmarshalSSZRandaoMixes ssz unmarshals the RandaoMixes field of the BeaconState object
+func (b *BeaconState) unmarshalSSZRandaoMixes(buf []byte) (err error) {
+	if cap(b.RandaoMixes) >= 64 {
+		b.RandaoMixes = b.RandaoMixes[:64]
+	} else {
+		b.RandaoMixes = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.RandaoMixes[ii] = append(b.RandaoMixes[ii][:0], buf[ii*32:(ii+1)*32]...)
+	}
+	return
+}
+
+// unmarshalSSZSlashings ssz unmarshals the Slashings field of the BeaconState object
+func (b *BeaconState) unmarshalSSZSlashings(buf []byte) (err error) {
+	b.Slashings = ssz.ExtendUint64(b.Slashings, 64)
+	for ii := 0; ii < 64; ii++ {
+		b.Slashings[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+	}
+	return
+}
+
+// unmarshalSSZPreviousEpochAttestations ssz unmarshals the PreviousEpochAttestations field of the BeaconState object
+func (b *BeaconState) unmarshalSSZPreviousEpochAttestations(buf []byte) (err error) {
+	num, err := ssz.DecodeDynamicLength(buf, 4096)
+	if err != nil {
+		return err
+	}
+	if cap(b.PreviousEpochAttestations) >= num {
+		b.PreviousEpochAttestations = b.PreviousEpochAttestations[:0]
+	} else {
+		b.PreviousEpochAttestations = make([]*PendingAttestation, 0, ssz.ListCapacity(num))
+	}
+	err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+		if len(b.PreviousEpochAttestations) < cap(b.PreviousEpochAttestations) {
+			b.PreviousEpochAttestations = b.PreviousEpochAttestations[:len(b.PreviousEpochAttestations)+1]
+		} else {
+			b.PreviousEpochAttestations = append(b.PreviousEpochAttestations, nil)
+		}
+		if b.PreviousEpochAttestations[indx] == nil {
+			b.PreviousEpochAttestations[indx] = new(PendingAttestation)
+		}
+		if err = b.PreviousEpochAttestations[indx].UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return
+}
+
+// unmarshalSSZCurrentEpochAttestations ssz unmarshals the CurrentEpochAttestations field of the BeaconState object
+func (b *BeaconState) unmarshalSSZCurrentEpochAttestations(buf []byte) (err error) {
+	num, err := ssz.DecodeDynamicLength(buf, 4096)
+	if err != nil {
+		return err
+	}
+	if cap(b.CurrentEpochAttestations) >= num {
+		b.CurrentEpochAttestations = b.CurrentEpochAttestations[:0]
+	} else {
+		b.CurrentEpochAttestations = make([]*PendingAttestation, 0, ssz.ListCapacity(num))
+	}
+	err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+		if len(b.CurrentEpochAttestations) < cap(b.CurrentEpochAttestations) {
+			b.CurrentEpochAttestations = b.CurrentEpochAttestations[:len(b.CurrentEpochAttestations)+1]
+		} else {
+			b.CurrentEpochAttestations = append(b.CurrentEpochAttestations, nil)
+		}
+		if b.CurrentEpochAttestations[indx] == nil {
+			b.CurrentEpochAttestations[indx] = new(PendingAttestation)
+		}
+		if err = b.CurrentEpochAttestations[indx].UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return
+}
+
+// unmarshalSSZJustificationBits ssz unmarshals the JustificationBits field of the BeaconState object
+func (b *BeaconState) unmarshalSSZJustificationBits(buf []byte) (err error) {
+	if err = ssz.ValidateBitvector(buf, 4); err != nil {
+		return err
+	}
+	b.JustificationBits = append(b.JustificationBits[:0], buf...)
+	return
+}
+
+// unmarshalSSZPreviousJustifiedCheckpoint ssz unmarshals the PreviousJustifiedCheckpoint field of the BeaconState object
+func (b *BeaconState) unmarshalSSZPreviousJustifiedCheckpoint(buf []byte) (err error) {
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf); err != nil {
+		return err
+	}
+	return
+}
+
+// unmarshalSSZCurrentJustifiedCheckpoint ssz unmarshals the CurrentJustifiedCheckpoint field of the BeaconState object
+func (b *BeaconState) unmarshalSSZCurrentJustifiedCheckpoint(buf []byte) (err error) {
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf); err != nil {
+		return err
+	}
+	return
+}
+
+// unmarshalSSZFinalizedCheckpoint ssz unmarshals the FinalizedCheckpoint field of the BeaconState object
+func (b *BeaconState) unmarshalSSZFinalizedCheckpoint(buf []byte) (err error) {
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf); err != nil {
+		return err
+	}
+	return
+}
+
+// UnmarshalSSZVerify ssz unmarshals the BeaconState object and fails if the input is not its canonical encoding
+func (b *BeaconState) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(b, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the BeaconState object with the nested objects of the pool
+func (b *BeaconState) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 7017 {
+		return errSize
+	}
+
+	tail := buf
+	var o6, o8, o10, o11, o14, o15 uint64
+
+	// Field (0) 'GenesisTime'
+	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Fork'
+	if b.Fork == nil && pool != nil {
+		b.Fork, _ = pool.Get((*Fork)(nil)).(*Fork)
+	}
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = ssz.UnmarshalWithPool(b.Fork, buf[16:32], pool); err != nil {
+		return err
+	}
+
+	// Field (3) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil && pool != nil {
+		b.LatestBlockHeader, _ = pool.Get((*BeaconBlockHeader)(nil)).(*BeaconBlockHeader)
+	}
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalWithPool(b.LatestBlockHeader, buf[32:136], pool); err != nil {
+		return err
+	}
+
+	// Field (4) 'BlockRoots'
+	if cap(b.BlockRoots) >= 64 {
+		b.BlockRoots = b.BlockRoots[:64]
+	} else {
+		b.BlockRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.BlockRoots[ii] = append(b.BlockRoots[ii][:0], buf[136:2184][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (5) 'StateRoots'
+	if cap(b.StateRoots) >= 64 {
+		b.StateRoots = b.StateRoots[:64]
+	} else {
+		b.StateRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.StateRoots[ii] = append(b.StateRoots[ii][:0], buf[2184:4232][ii*32:(ii+1)*32]...)
+	}
+
+	// Offset (6) 'HistoricalRoots'
+	if o6 = ssz.ReadOffset(buf[4232:4236]); o6 > size || o6 != 7017 {
+		return errOffset
+	}
+
+	// Field (7) 'Eth1Data'
+	if b.Eth1Data == nil && pool != nil {
+		b.Eth1Data, _ = pool.Get((*Eth1Data)(nil)).(*Eth1Data)
+	}
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalWithPool(b.Eth1Data, buf[4236:4308], pool); err != nil {
+		return err
+	}
+
+	// Offset (8) 'Eth1DataVotes'
+	if o8 = ssz.ReadOffset(buf[4308:4312]); o8 > size || o6 > o8 {
+		return errOffset
+	}
+
+	// Field (9) 'Eth1DepositIndex'
+	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[4312:4320])
+
+	// Offset (10) 'Validators'
+	if o10 = ssz.ReadOffset(buf[4320:4324]); o10 > size || o8 > o10 {
+		return errOffset
+	}
+
+	// Offset (11) 'Balances'
+	if o11 = ssz.ReadOffset(buf[4324:4328]); o11 > size || o10 > o11 {
+		return errOffset
+	}
+
+	// Field (12) 'RandaoMixes'
+	if cap(b.RandaoMixes) >= 64 {
+		b.RandaoMixes = b.RandaoMixes[:64]
+	} else {
+		b.RandaoMixes = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.RandaoMixes[ii] = append(b.RandaoMixes[ii][:0], buf[4328:6376][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (13) 'Slashings'
+	b.Slashings = ssz.ExtendUint64(b.Slashings, 64)
+	for ii := 0; ii < 64; ii++ {
+		b.Slashings[ii] = ssz.UnmarshallUint64(buf[6376:6888][ii*8 : (ii+1)*8])
+	}
+
+	// Offset (14) 'PreviousEpochAttestations'
+	if o14 = ssz.ReadOffset(buf[6888:6892]); o14 > size || o11 > o14 {
+		return errOffset
+	}
+
+	// Offset (15) 'CurrentEpochAttestations'
+	if o15 = ssz.ReadOffset(buf[6892:6896]); o15 > size || o14 > o15 {
+		return errOffset
+	}
+
+	// Field (16) 'JustificationBits'
+	if err = ssz.ValidateBitvector(buf[6896:6897], 4); err != nil {
+		return err
+	}
+	b.JustificationBits = append(b.JustificationBits[:0], buf[6896:6897]...)
+
+	// Field (17) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil && pool != nil {
+		b.PreviousJustifiedCheckpoint, _ = pool.Get((*Checkpoint)(nil)).(*Checkpoint)
+	}
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithPool(b.PreviousJustifiedCheckpoint, buf[6897:6937], pool); err != nil {
+		return err
+	}
+
+	// Field (18) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil && pool != nil {
+		b.CurrentJustifiedCheckpoint, _ = pool.Get((*Checkpoint)(nil)).(*Checkpoint)
+	}
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithPool(b.CurrentJustifiedCheckpoint, buf[6937:6977], pool); err != nil {
+		return err
+	}
+
+	// Field (19) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil && pool != nil {
+		b.FinalizedCheckpoint, _ = pool.Get((*Checkpoint)(nil)).(*Checkpoint)
+	}
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithPool(b.FinalizedCheckpoint, buf[6977:7017], pool); err != nil {
+		return err
+	}
+
+	// Field (6) 'HistoricalRoots'
+	{
+		buf = tail[o6:o8]
+		num, ok := ssz.DivideInt(len(buf), 32)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16777216 {
+			return errListTooBig
+		}
+		if cap(b.HistoricalRoots) >= num {
+			b.HistoricalRoots = b.HistoricalRoots[:num]
+		} else {
+			b.HistoricalRoots = make([][]byte, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii][:0], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (8) 'Eth1DataVotes'
+	{
+		buf = tail[o8:o10]
+		num, ok := ssz.DivideInt(len(buf), 72)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 1024 {
+			return errListTooBig
+		}
+		if cap(b.Eth1DataVotes) >= num {
+			b.Eth1DataVotes = b.Eth1DataVotes[:num]
+		} else {
+			b.Eth1DataVotes = make([]*Eth1Data, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.Eth1DataVotes[ii] == nil && pool != nil {
+				b.Eth1DataVotes[ii], _ = pool.Get((*Eth1Data)(nil)).(*Eth1Data)
+			}
+			if b.Eth1DataVotes[ii] == nil {
+				b.Eth1DataVotes[ii] = new(Eth1Data)
+			}
+			if err = ssz.UnmarshalWithPool(b.Eth1DataVotes[ii], buf[ii*72:(ii+1)*72], pool); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (10) 'Validators'
+	{
+		buf = tail[o10:o11]
+		num, ok := ssz.DivideInt(len(buf), 121)
+		if !ok {
+			return errDivideInt
+		}
+		if uint64(num) > 1099511627776 {
+			return errListTooBig
+		}
+		if cap(b.Validators) >= num {
+			b.Validators = b.Validators[:num]
+		} else {
+			b.Validators = make([]*Validator, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.Validators[ii] == nil && pool != nil {
+				b.Validators[ii], _ = pool.Get((*Validator)(nil)).(*Validator)
+			}
+			if b.Validators[ii] == nil {
+				b.Validators[ii] = new(Validator)
+			}
+			if err = ssz.UnmarshalWithPool(b.Validators[ii], buf[ii*121:(ii+1)*121], pool); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (11) 'Balances'
+	{
+		buf = tail[o11:o14]
+		num, ok := ssz.DivideInt(len(buf), 8)
+		if !ok {
+			return errDivideInt
+		}
+		if uint64(num) > 1099511627776 {
+			return errListTooBig
+		}
+		b.Balances = ssz.ExtendUint64(b.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (14) 'PreviousEpochAttestations'
+	{
+		buf = tail[o14:o15]
+		num, err := ssz.DecodeDynamicLength(buf, 4096)
+		if err != nil {
+			return err
+		}
+		if cap(b.PreviousEpochAttestations) >= num {
+			b.PreviousEpochAttestations = b.PreviousEpochAttestations[:0]
+		} else {
+			b.PreviousEpochAttestations = make([]*PendingAttestation, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(b.PreviousEpochAttestations) < cap(b.PreviousEpochAttestations) {
+				b.PreviousEpochAttestations = b.PreviousEpochAttestations[:len(b.PreviousEpochAttestations)+1]
+			} else {
+				b.PreviousEpochAttestations = append(b.PreviousEpochAttestations, nil)
+			}
+			if b.PreviousEpochAttestations[indx] == nil && pool != nil {
+				b.PreviousEpochAttestations[indx], _ = pool.Get((*PendingAttestation)(nil)).(*PendingAttestation)
+			}
+			if b.PreviousEpochAttestations[indx] == nil {
+				b.PreviousEpochAttestations[indx] = new(PendingAttestation)
+			}
+			if err = ssz.UnmarshalWithPool(b.PreviousEpochAttestations[indx], buf, pool); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (15) 'CurrentEpochAttestations'
+	{
+		buf = tail[o15:]
+		num, err := ssz.DecodeDynamicLength(buf, 4096)
+		if err != nil {
+			return err
+		}
+		if cap(b.CurrentEpochAttestations) >= num {
+			b.CurrentEpochAttestations = b.CurrentEpochAttestations[:0]
+		} else {
+			b.CurrentEpochAttestations = make([]*PendingAttestation, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(b.CurrentEpochAttestations) < cap(b.CurrentEpochAttestations) {
+				b.CurrentEpochAttestations = b.CurrentEpochAttestations[:len(b.CurrentEpochAttestations)+1]
+			} else {
+				b.CurrentEpochAttestations = append(b.CurrentEpochAttestations, nil)
+			}
+			if b.CurrentEpochAttestations[indx] == nil && pool != nil {
+				b.CurrentEpochAttestations[indx], _ = pool.Get((*PendingAttestation)(nil)).(*PendingAttestation)
+			}
+			if b.CurrentEpochAttestations[indx] == nil {
+				b.CurrentEpochAttestations[indx] = new(PendingAttestation)
+			}
+			if err = ssz.UnmarshalWithPool(b.CurrentEpochAttestations[indx], buf, pool); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the BeaconState object, the byte fields alias the input
+func (b *BeaconState) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 7017 {
+		return errSize
+	}
+
+	tail := buf
+	var o6, o8, o10, o11, o14, o15 uint64
+
+	// Field (0) 'GenesisTime'
+	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = ssz.UnmarshalNoCopy(b.Fork, buf[16:32]); err != nil {
+		return err
+	}
+
+	// Field (3) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalNoCopy(b.LatestBlockHeader, buf[32:136]); err != nil {
+		return err
+	}
+
+	// Field (4) 'BlockRoots'
+	if cap(b.BlockRoots) >= 64 {
+		b.BlockRoots = b.BlockRoots[:64]
+	} else {
+		b.BlockRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.BlockRoots[ii] = ssz.Alias(buf[136:2184][ii*32 : (ii+1)*32])
+	}
+
+	// Field (5) 'StateRoots'
+	if cap(b.StateRoots) >= 64 {
+		b.StateRoots = b.StateRoots[:64]
+	} else {
+		b.StateRoots = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.StateRoots[ii] = ssz.Alias(buf[2184:4232][ii*32 : (ii+1)*32])
+	}
+
+	// Offset (6) 'HistoricalRoots'
+	if o6 = ssz.ReadOffset(buf[4232:4236]); o6 > size || o6 != 7017 {
+		return errOffset
+	}
+
+	// Field (7) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalNoCopy(b.Eth1Data, buf[4236:4308]); err != nil {
+		return err
+	}
 
 	// Offset (8) 'Eth1DataVotes'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Eth1DataVotes) * 72
+	if o8 = ssz.ReadOffset(buf[4308:4312]); o8 > size || o6 > o8 {
+		return errOffset
+	}
+
+	// Field (9) 'Eth1DepositIndex'
+	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[4312:4320])
+
+	// Offset (10) 'Validators'
+	if o10 = ssz.ReadOffset(buf[4320:4324]); o10 > size || o8 > o10 {
+		return errOffset
+	}
+
+	// Offset (11) 'Balances'
+	if o11 = ssz.ReadOffset(buf[4324:4328]); o11 > size || o10 > o11 {
+		return errOffset
+	}
+
+	// Field (12) 'RandaoMixes'
+	if cap(b.RandaoMixes) >= 64 {
+		b.RandaoMixes = b.RandaoMixes[:64]
+	} else {
+		b.RandaoMixes = make([][]byte, 64)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.RandaoMixes[ii] = ssz.Alias(buf[4328:6376][ii*32 : (ii+1)*32])
+	}
+
+	// Field (13) 'Slashings'
+	b.Slashings = ssz.ExtendUint64(b.Slashings, 64)
+	for ii := 0; ii < 64; ii++ {
+		b.Slashings[ii] = ssz.UnmarshallUint64(buf[6376:6888][ii*8 : (ii+1)*8])
+	}
+
+	// Offset (14) 'PreviousEpochAttestations'
+	if o14 = ssz.ReadOffset(buf[6888:6892]); o14 > size || o11 > o14 {
+		return errOffset
+	}
+
+	// Offset (15) 'CurrentEpochAttestations'
+	if o15 = ssz.ReadOffset(buf[6892:6896]); o15 > size || o14 > o15 {
+		return errOffset
+	}
+
+	// Field (16) 'JustificationBits'
+	if err = ssz.ValidateBitvector(buf[6896:6897], 4); err != nil {
+		return err
+	}
+	b.JustificationBits = ssz.Alias(buf[6896:6897])
+
+	// Field (17) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalNoCopy(b.PreviousJustifiedCheckpoint, buf[6897:6937]); err != nil {
+		return err
+	}
+
+	// Field (18) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalNoCopy(b.CurrentJustifiedCheckpoint, buf[6937:6977]); err != nil {
+		return err
+	}
+
+	// Field (19) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalNoCopy(b.FinalizedCheckpoint, buf[6977:7017]); err != nil {
+		return err
+	}
+
+	// Field (6) 'HistoricalRoots'
+	{
+		buf = tail[o6:o8]
+		num, ok := ssz.DivideInt(len(buf), 32)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16777216 {
+			return errListTooBig
+		}
+		if cap(b.HistoricalRoots) >= num {
+			b.HistoricalRoots = b.HistoricalRoots[:num]
+		} else {
+			b.HistoricalRoots = make([][]byte, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			b.HistoricalRoots[ii] = ssz.Alias(buf[ii*32 : (ii+1)*32])
+		}
+	}
+
+	// Field (8) 'Eth1DataVotes'
+	{
+		buf = tail[o8:o10]
+		num, ok := ssz.DivideInt(len(buf), 72)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 1024 {
+			return errListTooBig
+		}
+		if cap(b.Eth1DataVotes) >= num {
+			b.Eth1DataVotes = b.Eth1DataVotes[:num]
+		} else {
+			b.Eth1DataVotes = make([]*Eth1Data, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.Eth1DataVotes[ii] == nil {
+				b.Eth1DataVotes[ii] = new(Eth1Data)
+			}
+			if err = ssz.UnmarshalNoCopy(b.Eth1DataVotes[ii], buf[ii*72:(ii+1)*72]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (10) 'Validators'
+	{
+		buf = tail[o10:o11]
+		num, ok := ssz.DivideInt(len(buf), 121)
+		if !ok {
+			return errDivideInt
+		}
+		if uint64(num) > 1099511627776 {
+			return errListTooBig
+		}
+		if cap(b.Validators) >= num {
+			b.Validators = b.Validators[:num]
+		} else {
+			b.Validators = make([]*Validator, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.Validators[ii] == nil {
+				b.Validators[ii] = new(Validator)
+			}
+			if err = ssz.UnmarshalNoCopy(b.Validators[ii], buf[ii*121:(ii+1)*121]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (11) 'Balances'
+	{
+		buf = tail[o11:o14]
+		num, ok := ssz.DivideInt(len(buf), 8)
+		if !ok {
+			return errDivideInt
+		}
+		if uint64(num) > 1099511627776 {
+			return errListTooBig
+		}
+		b.Balances = ssz.ExtendUint64(b.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (14) 'PreviousEpochAttestations'
+	{
+		buf = tail[o14:o15]
+		num, err := ssz.DecodeDynamicLength(buf, 4096)
+		if err != nil {
+			return err
+		}
+		if cap(b.PreviousEpochAttestations) >= num {
+			b.PreviousEpochAttestations = b.PreviousEpochAttestations[:0]
+		} else {
+			b.PreviousEpochAttestations = make([]*PendingAttestation, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(b.PreviousEpochAttestations) < cap(b.PreviousEpochAttestations) {
+				b.PreviousEpochAttestations = b.PreviousEpochAttestations[:len(b.PreviousEpochAttestations)+1]
+			} else {
+				b.PreviousEpochAttestations = append(b.PreviousEpochAttestations, nil)
+			}
+			if b.PreviousEpochAttestations[indx] == nil {
+				b.PreviousEpochAttestations[indx] = new(PendingAttestation)
+			}
+			if err = ssz.UnmarshalNoCopy(b.PreviousEpochAttestations[indx], buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (15) 'CurrentEpochAttestations'
+	{
+		buf = tail[o15:]
+		num, err := ssz.DecodeDynamicLength(buf, 4096)
+		if err != nil {
+			return err
+		}
+		if cap(b.CurrentEpochAttestations) >= num {
+			b.CurrentEpochAttestations = b.CurrentEpochAttestations[:0]
+		} else {
+			b.CurrentEpochAttestations = make([]*PendingAttestation, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(b.CurrentEpochAttestations) < cap(b.CurrentEpochAttestations) {
+				b.CurrentEpochAttestations = b.CurrentEpochAttestations[:len(b.CurrentEpochAttestations)+1]
+			} else {
+				b.CurrentEpochAttestations = append(b.CurrentEpochAttestations, nil)
+			}
+			if b.CurrentEpochAttestations[indx] == nil {
+				b.CurrentEpochAttestations[indx] = new(PendingAttestation)
+			}
+			if err = ssz.UnmarshalNoCopy(b.CurrentEpochAttestations[indx], buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the BeaconState object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (b *BeaconState) CopySSZ() (*BeaconState, error) {
+	buf, err := b.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(BeaconState)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
+func (b *BeaconState) SizeSSZ() (size int) {
+	size = 7017
+
+	// Field (6) 'HistoricalRoots'
+	size += len(b.HistoricalRoots) * 32
+
+	// Field (8) 'Eth1DataVotes'
+	size += len(b.Eth1DataVotes) * 72
+
+	// Field (10) 'Validators'
+	size += len(b.Validators) * 121
+
+	// Field (11) 'Balances'
+	size += len(b.Balances) * 8
+
+	// Field (14) 'PreviousEpochAttestations'
+	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+		size += 4
+		if b.PreviousEpochAttestations[ii] == nil {
+			size += new(PendingAttestation).SizeSSZ()
+		} else {
+			size += b.PreviousEpochAttestations[ii].SizeSSZ()
+		}
+	}
+
+	// Field (15) 'CurrentEpochAttestations'
+	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+		size += 4
+		if b.CurrentEpochAttestations[ii] == nil {
+			size += new(PendingAttestation).SizeSSZ()
+		} else {
+			size += b.CurrentEpochAttestations[ii].SizeSSZ()
+		}
+	}
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the BeaconState object
+func (b *BeaconState) MaxSizeSSZ() uint64 {
+	return 141837540285289
+}
+
+// HashTreeRoot ssz hashes the BeaconState object
+func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconState object with a hasher
+func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'GenesisTime'
+	if err = b.hashTreeRootGenesisTime(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Slot'
+	if err = b.hashTreeRootSlot(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Fork'
+	if err = b.hashTreeRootFork(hh); err != nil {
+		return
+	}
+
+	// Field (3) 'LatestBlockHeader'
+	if err = b.hashTreeRootLatestBlockHeader(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'BlockRoots'
+	if err = b.hashTreeRootBlockRoots(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'StateRoots'
+	if err = b.hashTreeRootStateRoots(hh); err != nil {
+		return
+	}
+
+	// Field (6) 'HistoricalRoots'
+	if err = b.hashTreeRootHistoricalRoots(hh); err != nil {
+		return
+	}
+
+	// Field (7) 'Eth1Data'
+	if err = b.hashTreeRootEth1Data(hh); err != nil {
+		return
+	}
+
+	// Field (8) 'Eth1DataVotes'
+	if err = b.hashTreeRootEth1DataVotes(hh); err != nil {
+		return
+	}
+
+	// Field (9) 'Eth1DepositIndex'
+	if err = b.hashTreeRootEth1DepositIndex(hh); err != nil {
+		return
+	}
+
+	// Field (10) 'Validators'
+	if err = b.hashTreeRootValidators(hh); err != nil {
+		return
+	}
+
+	// Field (11) 'Balances'
+	if err = b.hashTreeRootBalances(hh); err != nil {
+		return
+	}
+
+	// Field (12) 'RandaoMixes'
+	if err = b.hashTreeRootRandaoMixes(hh); err != nil {
+		return
+	}
+
+	// Field (13) 'Slashings'
+	if err = b.hashTreeRootSlashings(hh); err != nil {
+		return
+	}
+
+	// Field (14) 'PreviousEpochAttestations'
+	if err = b.hashTreeRootPreviousEpochAttestations(hh); err != nil {
+		return
+	}
+
+	// Field (15) 'CurrentEpochAttestations'
+	if err = b.hashTreeRootCurrentEpochAttestations(hh); err != nil {
+		return
+	}
+
+	// Field (16) 'JustificationBits'
+	if err = b.hashTreeRootJustificationBits(hh); err != nil {
+		return
+	}
+
+	// Field (17) 'PreviousJustifiedCheckpoint'
+	if err = b.hashTreeRootPreviousJustifiedCheckpoint(hh); err != nil {
+		return
+	}
+
+	// Field (18) 'CurrentJustifiedCheckpoint'
+	if err = b.hashTreeRootCurrentJustifiedCheckpoint(hh); err != nil {
+		return
+	}
+
+	// Field (19) 'FinalizedCheckpoint'
+	if err = b.hashTreeRootFinalizedCheckpoint(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// hashTreeRootGenesisTime ssz hashes the GenesisTime field of the BeaconState object
+func (b *BeaconState) hashTreeRootGenesisTime(hh *ssz.Hasher) (err error) {
+	hh.PutUint64(b.GenesisTime)
+	return
+}
+
+// hashTreeRootSlot ssz hashes the Slot field of the BeaconState object
+func (b *BeaconState) hashTreeRootSlot(hh *ssz.Hasher) (err error) {
+	hh.PutUint64(b.Slot)
+	return
+}
+
+// hashTreeRootFork ssz hashes the Fork field of the BeaconState object
+func (b *BeaconState) hashTreeRootFork(hh *ssz.Hasher) (err error) {
+	if b.Fork == nil {
+		if err = new(Fork).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = b.Fork.HashTreeRootWith(hh); err != nil {
+		return
+	}
+	return
+}
+
+// hashTreeRootLatestBlockHeader ssz hashes the LatestBlockHeader field of the BeaconState object
+func (b *BeaconState) hashTreeRootLatestBlockHeader(hh *ssz.Hasher) (err error) {
+	if b.LatestBlockHeader == nil {
+		if err = new(BeaconBlockHeader).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+	return
+}
+
+// hashTreeRootBlockRoots ssz hashes the BlockRoots field of the BeaconState object
+func (b *BeaconState) hashTreeRootBlockRoots(hh *ssz.Hasher) (err error) {
+	{
+		if len(b.BlockRoots) != 64 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.BlockRoots); ii++ {
+			if len(b.BlockRoots[ii]) != 32 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(b.BlockRoots[ii])
+		}
+		hh.Merkleize(subIndx)
+	}
+	return
+}
+
+// hashTreeRootStateRoots ssz hashes the StateRoots field of the BeaconState object
+func (b *BeaconState) hashTreeRootStateRoots(hh *ssz.Hasher) (err error) {
+	{
+		if len(b.StateRoots) != 64 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.StateRoots); ii++ {
+			if len(b.StateRoots[ii]) != 32 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(b.StateRoots[ii])
+		}
+		hh.Merkleize(subIndx)
+	}
+	return
+}
+
+// hashTreeRootHistoricalRoots ssz hashes the HistoricalRoots field of the BeaconState object
+func (b *BeaconState) hashTreeRootHistoricalRoots(hh *ssz.Hasher) (err error) {
+	{
+		if len(b.HistoricalRoots) > 16777216 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+			if len(b.HistoricalRoots[ii]) != 32 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(b.HistoricalRoots[ii])
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.HistoricalRoots)), 16777216)
+	}
+	return
+}
+
+// hashTreeRootEth1Data ssz hashes the Eth1Data field of the BeaconState object
+func (b *BeaconState) hashTreeRootEth1Data(hh *ssz.Hasher) (err error) {
+	if b.Eth1Data == nil {
+		if err = new(Eth1Data).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+	return
+}
+
+// hashTreeRootEth1DataVotes ssz hashes the Eth1DataVotes field of the BeaconState object
+func (b *BeaconState) hashTreeRootEth1DataVotes(hh *ssz.Hasher) (err error) {
+	{
+		if len(b.Eth1DataVotes) > 1024 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+			if b.Eth1DataVotes[ii] == nil {
+				if err = new(Eth1Data).HashTreeRootWith(hh); err != nil {
+					return
+				}
+			} else if err = b.Eth1DataVotes[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.Eth1DataVotes)), 1024)
+	}
+	return
+}
+
+// hashTreeRootEth1DepositIndex ssz hashes the Eth1DepositIndex field of the BeaconState object
+func (b *BeaconState) hashTreeRootEth1DepositIndex(hh *ssz.Hasher) (err error) {
+	hh.PutUint64(b.Eth1DepositIndex)
+	return
+}
+
+// hashTreeRootValidators ssz hashes the Validators field of the BeaconState object
+func (b *BeaconState) hashTreeRootValidators(hh *ssz.Hasher) (err error) {
+	{
+		if uint64(len(b.Validators)) > 1099511627776 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.Validators); ii++ {
+			if b.Validators[ii] == nil {
+				if err = new(Validator).HashTreeRootWith(hh); err != nil {
+					return
+				}
+			} else if err = b.Validators[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.Validators)), 1099511627776)
+	}
+	return
+}
+
+// hashTreeRootBalances ssz hashes the Balances field of the BeaconState object
+func (b *BeaconState) hashTreeRootBalances(hh *ssz.Hasher) (err error) {
+	{
+		if uint64(len(b.Balances)) > 1099511627776 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.Balances); ii++ {
+			hh.AppendUint64(b.Balances[ii])
+		}
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.Balances)), 274877906944)
+	}
+	return
+}
+
+// hashTreeRootRandaoMixes ssz hashes the RandaoMixes field of the BeaconState object
+func (b *BeaconState) hashTreeRootRandaoMixes(hh *ssz.Hasher) (err error) {
+	{
+		if len(b.RandaoMixes) != 64 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.RandaoMixes); ii++ {
+			if len(b.RandaoMixes[ii]) != 32 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(b.RandaoMixes[ii])
+		}
+		hh.Merkleize(subIndx)
+	}
+	return
+}
+
+// hashTreeRootSlashings ssz hashes the Slashings field of the BeaconState object
+func (b *BeaconState) hashTreeRootSlashings(hh *ssz.Hasher) (err error) {
+	{
+		if len(b.Slashings) != 64 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.Slashings); ii++ {
+			hh.AppendUint64(b.Slashings[ii])
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+	return
+}
+
+// hashTreeRootPreviousEpochAttestations ssz hashes the PreviousEpochAttestations field of the BeaconState object
+func (b *BeaconState) hashTreeRootPreviousEpochAttestations(hh *ssz.Hasher) (err error) {
+	{
+		if len(b.PreviousEpochAttestations) > 4096 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			if b.PreviousEpochAttestations[ii] == nil {
+				if err = new(PendingAttestation).HashTreeRootWith(hh); err != nil {
+					return
+				}
+			} else if err = b.PreviousEpochAttestations[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.PreviousEpochAttestations)), 4096)
+	}
+	return
+}
+
+// hashTreeRootCurrentEpochAttestations ssz hashes the CurrentEpochAttestations field of the BeaconState object
+func (b *BeaconState) hashTreeRootCurrentEpochAttestations(hh *ssz.Hasher) (err error) {
+	{
+		if len(b.CurrentEpochAttestations) > 4096 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+			if b.CurrentEpochAttestations[ii] == nil {
+				if err = new(PendingAttestation).HashTreeRootWith(hh); err != nil {
+					return
+				}
+			} else if err = b.CurrentEpochAttestations[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.CurrentEpochAttestations)), 4096)
+	}
+	return
+}
+
+// hashTreeRootJustificationBits ssz hashes the JustificationBits field of the BeaconState object
+func (b *BeaconState) hashTreeRootJustificationBits(hh *ssz.Hasher) (err error) {
+	if len(b.JustificationBits) != 1 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b.JustificationBits)
+	return
+}
+
+// hashTreeRootPreviousJustifiedCheckpoint ssz hashes the PreviousJustifiedCheckpoint field of the BeaconState object
+func (b *BeaconState) hashTreeRootPreviousJustifiedCheckpoint(hh *ssz.Hasher) (err error) {
+	if b.PreviousJustifiedCheckpoint == nil {
+		if err = new(Checkpoint).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+	return
+}
+
+// hashTreeRootCurrentJustifiedCheckpoint ssz hashes the CurrentJustifiedCheckpoint field of the BeaconState object
+func (b *BeaconState) hashTreeRootCurrentJustifiedCheckpoint(hh *ssz.Hasher) (err error) {
+	if b.CurrentJustifiedCheckpoint == nil {
+		if err = new(Checkpoint).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+	return
+}
+
+// hashTreeRootFinalizedCheckpoint ssz hashes the FinalizedCheckpoint field of the BeaconState object
+func (b *BeaconState) hashTreeRootFinalizedCheckpoint(hh *ssz.Hasher) (err error) {
+	if b.FinalizedCheckpoint == nil {
+		if err = new(Checkpoint).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the BeaconState object
+func (b *BeaconState) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("BeaconState",
+		ssz.NewField("genesis_time", ssz.UintSchema(8)),
+		ssz.NewField("slot", ssz.UintSchema(8)),
+		ssz.NewField("fork", new(Fork).SchemaSSZ()),
+		ssz.NewField("latest_block_header", new(BeaconBlockHeader).SchemaSSZ()),
+		ssz.NewField("block_roots", ssz.VectorSchema(ssz.ByteVectorSchema(32), 64)),
+		ssz.NewField("state_roots", ssz.VectorSchema(ssz.ByteVectorSchema(32), 64)),
+		ssz.NewField("historical_roots", ssz.ListSchema(ssz.ByteVectorSchema(32), 16777216)),
+		ssz.NewField("eth1_data", new(Eth1Data).SchemaSSZ()),
+		ssz.NewField("eth1_data_votes", ssz.ListSchema(new(Eth1Data).SchemaSSZ(), 1024)),
+		ssz.NewField("eth1_deposit_index", ssz.UintSchema(8)),
+		ssz.NewField("validators", ssz.ListSchema(new(Validator).SchemaSSZ(), 1099511627776)),
+		ssz.NewField("balances", ssz.ListSchema(ssz.UintSchema(8), 1099511627776)),
+		ssz.NewField("randao_mixes", ssz.VectorSchema(ssz.ByteVectorSchema(32), 64)),
+		ssz.NewField("slashings", ssz.VectorSchema(ssz.UintSchema(8), 64)),
+		ssz.NewField("previous_epoch_attestations", ssz.ListSchema(new(PendingAttestation).SchemaSSZ(), 4096)),
+		ssz.NewField("current_epoch_attestations", ssz.ListSchema(new(PendingAttestation).SchemaSSZ(), 4096)),
+		ssz.NewField("justification_bits", ssz.BitvectorSchema(4)),
+		ssz.NewField("previous_justified_checkpoint", new(Checkpoint).SchemaSSZ()),
+		ssz.NewField("current_justified_checkpoint", new(Checkpoint).SchemaSSZ()),
+		ssz.NewField("finalized_checkpoint", new(Checkpoint).SchemaSSZ()),
+	)
+}
+
+// SSZFields returns the layout of the fields of the BeaconState object
+func (b *BeaconState) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "genesis_time", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 32},
+		{Name: "slot", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 8, Gindex: 33},
+		{Name: "fork", Type: "Fork", Size: 16, Variable: false, Limit: 0, Offset: 16, Gindex: 34},
+		{Name: "latest_block_header", Type: "BeaconBlockHeader", Size: 104, Variable: false, Limit: 0, Offset: 32, Gindex: 35},
+		{Name: "block_roots", Type: "Vector[Bytes32, 64]", Size: 2048, Variable: false, Limit: 0, Offset: 136, Gindex: 36},
+		{Name: "state_roots", Type: "Vector[Bytes32, 64]", Size: 2048, Variable: false, Limit: 0, Offset: 2184, Gindex: 37},
+		{Name: "historical_roots", Type: "List[Bytes32, 16777216]", Size: 4, Variable: true, Limit: 16777216, Offset: 4232, Gindex: 38},
+		{Name: "eth1_data", Type: "Eth1Data", Size: 72, Variable: false, Limit: 0, Offset: 4236, Gindex: 39},
+		{Name: "eth1_data_votes", Type: "List[Eth1Data, 1024]", Size: 4, Variable: true, Limit: 1024, Offset: 4308, Gindex: 40},
+		{Name: "eth1_deposit_index", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 4312, Gindex: 41},
+		{Name: "validators", Type: "List[Validator, 1099511627776]", Size: 4, Variable: true, Limit: 1099511627776, Offset: 4320, Gindex: 42},
+		{Name: "balances", Type: "List[uint64, 1099511627776]", Size: 4, Variable: true, Limit: 1099511627776, Offset: 4324, Gindex: 43},
+		{Name: "randao_mixes", Type: "Vector[Bytes32, 64]", Size: 2048, Variable: false, Limit: 0, Offset: 4328, Gindex: 44},
+		{Name: "slashings", Type: "Vector[uint64, 64]", Size: 512, Variable: false, Limit: 0, Offset: 6376, Gindex: 45},
+		{Name: "previous_epoch_attestations", Type: "List[PendingAttestation, 4096]", Size: 4, Variable: true, Limit: 4096, Offset: 6888, Gindex: 46},
+		{Name: "current_epoch_attestations", Type: "List[PendingAttestation, 4096]", Size: 4, Variable: true, Limit: 4096, Offset: 6892, Gindex: 47},
+		{Name: "justification_bits", Type: "Bitvector[4]", Size: 1, Variable: false, Limit: 0, Offset: 6896, Gindex: 48},
+		{Name: "previous_justified_checkpoint", Type: "Checkpoint", Size: 40, Variable: false, Limit: 0, Offset: 6897, Gindex: 49},
+		{Name: "current_justified_checkpoint", Type: "Checkpoint", Size: 40, Variable: false, Limit: 0, Offset: 6937, Gindex: 50},
+		{Name: "finalized_checkpoint", Type: "Checkpoint", Size: 40, Variable: false, Limit: 0, Offset: 6977, Gindex: 51},
+	}
+}
+
+// RandomBeaconState returns a random BeaconState object
+func RandomBeaconState(rng *rand.Rand) *BeaconState {
+	b := new(BeaconState)
+	// Field (0) 'GenesisTime'
+	b.GenesisTime = rng.Uint64()
+
+	// Field (1) 'Slot'
+	b.Slot = rng.Uint64()
+
+	// Field (2) 'Fork'
+	b.Fork = RandomFork(rng)
+
+	// Field (3) 'LatestBlockHeader'
+	b.LatestBlockHeader = RandomBeaconBlockHeader(rng)
+
+	// Field (4) 'BlockRoots'
+	{
+		if cap(b.BlockRoots) >= 64 {
+			b.BlockRoots = b.BlockRoots[:64]
+		} else {
+			b.BlockRoots = make([][]byte, 64)
+		}
+		for ii := 0; ii < len(b.BlockRoots); ii++ {
+			b.BlockRoots[ii] = ssz.RandomBytes(rng, 32)
+		}
+	}
+
+	// Field (5) 'StateRoots'
+	{
+		if cap(b.StateRoots) >= 64 {
+			b.StateRoots = b.StateRoots[:64]
+		} else {
+			b.StateRoots = make([][]byte, 64)
+		}
+		for ii := 0; ii < len(b.StateRoots); ii++ {
+			b.StateRoots[ii] = ssz.RandomBytes(rng, 32)
+		}
+	}
+
+	// Field (6) 'HistoricalRoots'
+	{
+		num := ssz.RandomLength(rng, 16777216)
+		if cap(b.HistoricalRoots) >= num {
+			b.HistoricalRoots = b.HistoricalRoots[:num]
+		} else {
+			b.HistoricalRoots = make([][]byte, num)
+		}
+		for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+			b.HistoricalRoots[ii] = ssz.RandomBytes(rng, 32)
+		}
+	}
+
+	// Field (7) 'Eth1Data'
+	b.Eth1Data = RandomEth1Data(rng)
+
+	// Field (8) 'Eth1DataVotes'
+	{
+		num := ssz.RandomLength(rng, 1024)
+		if cap(b.Eth1DataVotes) >= num {
+			b.Eth1DataVotes = b.Eth1DataVotes[:num]
+		} else {
+			b.Eth1DataVotes = make([]*Eth1Data, num)
+		}
+		for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+			b.Eth1DataVotes[ii] = RandomEth1Data(rng)
+		}
+	}
+
+	// Field (9) 'Eth1DepositIndex'
+	b.Eth1DepositIndex = rng.Uint64()
+
+	// Field (10) 'Validators'
+	{
+		num := ssz.RandomLength(rng, 1099511627776)
+		if cap(b.Validators) >= num {
+			b.Validators = b.Validators[:num]
+		} else {
+			b.Validators = make([]*Validator, num)
+		}
+		for ii := 0; ii < len(b.Validators); ii++ {
+			b.Validators[ii] = RandomValidator(rng)
+		}
+	}
+
+	// Field (11) 'Balances'
+	{
+		num := ssz.RandomLength(rng, 1099511627776)
+		b.Balances = ssz.ExtendUint64(b.Balances, num)
+		for ii := 0; ii < len(b.Balances); ii++ {
+			b.Balances[ii] = rng.Uint64()
+		}
+	}
+
+	// Field (12) 'RandaoMixes'
+	{
+		if cap(b.RandaoMixes) >= 64 {
+			b.RandaoMixes = b.RandaoMixes[:64]
+		} else {
+			b.RandaoMixes = make([][]byte, 64)
+		}
+		for ii := 0; ii < len(b.RandaoMixes); ii++ {
+			b.RandaoMixes[ii] = ssz.RandomBytes(rng, 32)
+		}
+	}
+
+	// Field (13) 'Slashings'
+	{
+		b.Slashings = ssz.ExtendUint64(b.Slashings, 64)
+		for ii := 0; ii < len(b.Slashings); ii++ {
+			b.Slashings[ii] = rng.Uint64()
+		}
+	}
+
+	// Field (14) 'PreviousEpochAttestations'
+	{
+		num := ssz.RandomLength(rng, 4096)
+		if cap(b.PreviousEpochAttestations) >= num {
+			b.PreviousEpochAttestations = b.PreviousEpochAttestations[:num]
+		} else {
+			b.PreviousEpochAttestations = make([]*PendingAttestation, num)
+		}
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			b.PreviousEpochAttestations[ii] = RandomPendingAttestation(rng)
+		}
+	}
+
+	// Field (15) 'CurrentEpochAttestations'
+	{
+		num := ssz.RandomLength(rng, 4096)
+		if cap(b.CurrentEpochAttestations) >= num {
+			b.CurrentEpochAttestations = b.CurrentEpochAttestations[:num]
+		} else {
+			b.CurrentEpochAttestations = make([]*PendingAttestation, num)
+		}
+		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+			b.CurrentEpochAttestations[ii] = RandomPendingAttestation(rng)
+		}
+	}
+
+	// Field (16) 'JustificationBits'
+	b.JustificationBits = ssz.RandomBitvector(rng, 4)
+
+	// Field (17) 'PreviousJustifiedCheckpoint'
+	b.PreviousJustifiedCheckpoint = RandomCheckpoint(rng)
+
+	// Field (18) 'CurrentJustifiedCheckpoint'
+	b.CurrentJustifiedCheckpoint = RandomCheckpoint(rng)
+
+	// Field (19) 'FinalizedCheckpoint'
+	b.FinalizedCheckpoint = RandomCheckpoint(rng)
+
+	return b
+}
+
+// MarshalSSZ ssz marshals the BeaconBlock object
+func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ())
+	return b.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlock object to a target array
+func (b *BeaconBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(76)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ParentRoot'
+	if dst, err = ssz.MarshalFixedBytes(dst, b.ParentRoot, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (2) 'StateRoot'
+	if dst, err = ssz.MarshalFixedBytes(dst, b.StateRoot, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Offset (3) 'Body'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	if b.Body == nil {
+		offset += new(BeaconBlockBody).SizeSSZ()
+	} else {
+		offset += b.Body.SizeSSZ()
+	}
+
+	// Field (3) 'Body'
+	if b.Body == nil {
+		if dst, err = new(BeaconBlockBody).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the BeaconBlock object to the buffers, dst is the encoding since the last referenced field
+func (b *BeaconBlock) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(76)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ParentRoot'
+	if len(b.ParentRoot) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, b.ParentRoot)
+
+	// Field (2) 'StateRoot'
+	if len(b.StateRoot) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, b.StateRoot)
+
+	// Offset (3) 'Body'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	if b.Body == nil {
+		offset += new(BeaconBlockBody).SizeSSZ()
+	} else {
+		offset += b.Body.SizeSSZ()
+	}
+
+	// Field (3) 'Body'
+	if b.Body == nil {
+		if dst, err = new(BeaconBlockBody).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(b.Body, dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlock object
+func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 76 {
+		return errSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	b.ParentRoot = append(b.ParentRoot[:0], buf[8:40]...)
+
+	// Field (2) 'StateRoot'
+	b.StateRoot = append(b.StateRoot[:0], buf[40:72]...)
+
+	// Offset (3) 'Body'
+	if o3 = ssz.ReadOffset(buf[72:76]); o3 > size || o3 != 76 {
+		return errOffset
+	}
+
+	// Field (3) 'Body'
+	{
+		buf = tail[o3:]
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBody)
+		}
+		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the BeaconBlock object and fails if the input is not its canonical encoding
+func (b *BeaconBlock) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(b, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the BeaconBlock object with the nested objects of the pool
+func (b *BeaconBlock) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 76 {
+		return errSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	b.ParentRoot = append(b.ParentRoot[:0], buf[8:40]...)
+
+	// Field (2) 'StateRoot'
+	b.StateRoot = append(b.StateRoot[:0], buf[40:72]...)
+
+	// Offset (3) 'Body'
+	if o3 = ssz.ReadOffset(buf[72:76]); o3 > size || o3 != 76 {
+		return errOffset
+	}
+
+	// Field (3) 'Body'
+	{
+		buf = tail[o3:]
+		if b.Body == nil && pool != nil {
+			b.Body, _ = pool.Get((*BeaconBlockBody)(nil)).(*BeaconBlockBody)
+		}
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBody)
+		}
+		if err = ssz.UnmarshalWithPool(b.Body, buf, pool); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the BeaconBlock object, the byte fields alias the input
+func (b *BeaconBlock) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 76 {
+		return errSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	b.ParentRoot = ssz.Alias(buf[8:40])
+
+	// Field (2) 'StateRoot'
+	b.StateRoot = ssz.Alias(buf[40:72])
+
+	// Offset (3) 'Body'
+	if o3 = ssz.ReadOffset(buf[72:76]); o3 > size || o3 != 76 {
+		return errOffset
+	}
+
+	// Field (3) 'Body'
+	{
+		buf = tail[o3:]
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBody)
+		}
+		if err = ssz.UnmarshalNoCopy(b.Body, buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the BeaconBlock object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (b *BeaconBlock) CopySSZ() (*BeaconBlock, error) {
+	buf, err := b.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(BeaconBlock)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlock object
+func (b *BeaconBlock) SizeSSZ() (size int) {
+	size = 76
+
+	// Field (3) 'Body'
+	if b.Body == nil {
+		size += new(BeaconBlockBody).SizeSSZ()
+	} else {
+		size += b.Body.SizeSSZ()
+	}
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the BeaconBlock object
+func (b *BeaconBlock) MaxSizeSSZ() uint64 {
+	return 124284
+}
+
+// HashTreeRoot ssz hashes the BeaconBlock object
+func (b *BeaconBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlock object with a hasher
+func (b *BeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ParentRoot'
+	if len(b.ParentRoot) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (2) 'StateRoot'
+	if len(b.StateRoot) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (3) 'Body'
+	if b.Body == nil {
+		if err = new(BeaconBlockBody).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the BeaconBlock object
+func (b *BeaconBlock) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("BeaconBlock",
+		ssz.NewField("slot", ssz.UintSchema(8)),
+		ssz.NewField("parent_root", ssz.ByteVectorSchema(32)),
+		ssz.NewField("state_root", ssz.ByteVectorSchema(32)),
+		ssz.NewField("body", new(BeaconBlockBody).SchemaSSZ()),
+	)
+}
+
+// SSZFields returns the layout of the fields of the BeaconBlock object
+func (b *BeaconBlock) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "slot", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "parent_root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 8, Gindex: 5},
+		{Name: "state_root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 40, Gindex: 6},
+		{Name: "body", Type: "BeaconBlockBody", Size: 4, Variable: true, Limit: 0, Offset: 72, Gindex: 7},
+	}
+}
+
+// RandomBeaconBlock returns a random BeaconBlock object
+func RandomBeaconBlock(rng *rand.Rand) *BeaconBlock {
+	b := new(BeaconBlock)
+	// Field (0) 'Slot'
+	b.Slot = rng.Uint64()
+
+	// Field (1) 'ParentRoot'
+	b.ParentRoot = ssz.RandomBytes(rng, 32)
+
+	// Field (2) 'StateRoot'
+	b.StateRoot = ssz.RandomBytes(rng, 32)
+
+	// Field (3) 'Body'
+	b.Body = RandomBeaconBlockBody(rng)
+
+	return b
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlock object
+func (s *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, s.SizeSSZ())
+	return s.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlock object to a target array
+func (s *SignedBeaconBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(100)
+
+	// Offset (0) 'Block'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	if s.Block == nil {
+		offset += new(BeaconBlock).SizeSSZ()
+	} else {
+		offset += s.Block.SizeSSZ()
+	}
+
+	// Field (1) 'Signature'
+	if dst, err = ssz.MarshalFixedBytes(dst, s.Signature, 96); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		if dst, err = new(BeaconBlock).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the SignedBeaconBlock object to the buffers, dst is the encoding since the last referenced field
+func (s *SignedBeaconBlock) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(100)
+
+	// Offset (0) 'Block'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	if s.Block == nil {
+		offset += new(BeaconBlock).SizeSSZ()
+	} else {
+		offset += s.Block.SizeSSZ()
+	}
+
+	// Field (1) 'Signature'
+	if len(s.Signature) != 96 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, s.Signature)
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		if dst, err = new(BeaconBlock).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(s.Block, dst); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlock object
+func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return errSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 100 {
+		return errOffset
+	}
+
+	// Field (1) 'Signature'
+	s.Signature = append(s.Signature[:0], buf[4:100]...)
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:]
+		if s.Block == nil {
+			s.Block = new(BeaconBlock)
+		}
+		if err = s.Block.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the SignedBeaconBlock object and fails if the input is not its canonical encoding
+func (s *SignedBeaconBlock) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(s, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the SignedBeaconBlock object with the nested objects of the pool
+func (s *SignedBeaconBlock) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return errSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 100 {
+		return errOffset
+	}
+
+	// Field (1) 'Signature'
+	s.Signature = append(s.Signature[:0], buf[4:100]...)
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:]
+		if s.Block == nil && pool != nil {
+			s.Block, _ = pool.Get((*BeaconBlock)(nil)).(*BeaconBlock)
+		}
+		if s.Block == nil {
+			s.Block = new(BeaconBlock)
+		}
+		if err = ssz.UnmarshalWithPool(s.Block, buf, pool); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the SignedBeaconBlock object, the byte fields alias the input
+func (s *SignedBeaconBlock) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return errSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 100 {
+		return errOffset
+	}
+
+	// Field (1) 'Signature'
+	s.Signature = ssz.Alias(buf[4:100])
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:]
+		if s.Block == nil {
+			s.Block = new(BeaconBlock)
+		}
+		if err = ssz.UnmarshalNoCopy(s.Block, buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the SignedBeaconBlock object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (s *SignedBeaconBlock) CopySSZ() (*SignedBeaconBlock, error) {
+	buf, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(SignedBeaconBlock)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlock object
+func (s *SignedBeaconBlock) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		size += new(BeaconBlock).SizeSSZ()
+	} else {
+		size += s.Block.SizeSSZ()
+	}
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) MaxSizeSSZ() uint64 {
+	return 124384
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlock object
+func (s *SignedBeaconBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlock object with a hasher
+func (s *SignedBeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		if err = new(BeaconBlock).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = s.Block.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if len(s.Signature) != 96 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("SignedBeaconBlock",
+		ssz.NewField("message", new(BeaconBlock).SchemaSSZ()),
+		ssz.NewField("signature", ssz.ByteVectorSchema(96)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "message", Type: "BeaconBlock", Size: 4, Variable: true, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "signature", Type: "Bytes96", Size: 96, Variable: false, Limit: 0, Offset: 4, Gindex: 3},
+	}
+}
+
+// RandomSignedBeaconBlock returns a random SignedBeaconBlock object
+func RandomSignedBeaconBlock(rng *rand.Rand) *SignedBeaconBlock {
+	s := new(SignedBeaconBlock)
+	// Field (0) 'Block'
+	s.Block = RandomBeaconBlock(rng)
+
+	// Field (1) 'Signature'
+	s.Signature = ssz.RandomBytes(rng, 96)
+
+	return s
+}
+
+// MarshalSSZ ssz marshals the Transfer object
+func (t *Transfer) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, t.SizeSSZ())
+	return t.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Transfer object to a target array
+func (t *Transfer) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Sender'
+	dst = ssz.MarshalUint64(dst, t.Sender)
+
+	// Field (1) 'Recipient'
+	dst = ssz.MarshalUint64(dst, t.Recipient)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, t.Amount)
+
+	// Field (3) 'Fee'
+	dst = ssz.MarshalUint64(dst, t.Fee)
+
+	// Field (4) 'Slot'
+	dst = ssz.MarshalUint64(dst, t.Slot)
+
+	// Field (5) 'Pubkey'
+	if dst, err = ssz.MarshalFixedBytes(dst, t.Pubkey, 48); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (6) 'Signature'
+	if dst, err = ssz.MarshalFixedBytes(dst, t.Signature, 96); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Transfer object to the buffers, dst is the encoding since the last referenced field
+func (t *Transfer) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Sender'
+	dst = ssz.MarshalUint64(dst, t.Sender)
+
+	// Field (1) 'Recipient'
+	dst = ssz.MarshalUint64(dst, t.Recipient)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, t.Amount)
+
+	// Field (3) 'Fee'
+	dst = ssz.MarshalUint64(dst, t.Fee)
+
+	// Field (4) 'Slot'
+	dst = ssz.MarshalUint64(dst, t.Slot)
+
+	// Field (5) 'Pubkey'
+	if len(t.Pubkey) != 48 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, t.Pubkey)
+
+	// Field (6) 'Signature'
+	if len(t.Signature) != 96 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, t.Signature)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Transfer object
+func (t *Transfer) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 184 {
+		return errSize
+	}
+
+	// Field (0) 'Sender'
+	t.Sender = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Recipient'
+	t.Recipient = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Amount'
+	t.Amount = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (3) 'Fee'
+	t.Fee = ssz.UnmarshallUint64(buf[24:32])
+
+	// Field (4) 'Slot'
+	t.Slot = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (5) 'Pubkey'
+	t.Pubkey = append(t.Pubkey[:0], buf[40:88]...)
+
+	// Field (6) 'Signature'
+	t.Signature = append(t.Signature[:0], buf[88:184]...)
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Transfer object and fails if the input is not its canonical encoding
+func (t *Transfer) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(t, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Transfer object with the nested objects of the pool
+func (t *Transfer) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 184 {
+		return errSize
+	}
+
+	// Field (0) 'Sender'
+	t.Sender = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Recipient'
+	t.Recipient = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Amount'
+	t.Amount = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (3) 'Fee'
+	t.Fee = ssz.UnmarshallUint64(buf[24:32])
+
+	// Field (4) 'Slot'
+	t.Slot = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (5) 'Pubkey'
+	t.Pubkey = append(t.Pubkey[:0], buf[40:88]...)
+
+	// Field (6) 'Signature'
+	t.Signature = append(t.Signature[:0], buf[88:184]...)
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Transfer object, the byte fields alias the input
+func (t *Transfer) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 184 {
+		return errSize
+	}
+
+	// Field (0) 'Sender'
+	t.Sender = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Recipient'
+	t.Recipient = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Amount'
+	t.Amount = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (3) 'Fee'
+	t.Fee = ssz.UnmarshallUint64(buf[24:32])
+
+	// Field (4) 'Slot'
+	t.Slot = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (5) 'Pubkey'
+	t.Pubkey = ssz.Alias(buf[40:88])
+
+	// Field (6) 'Signature'
+	t.Signature = ssz.Alias(buf[88:184])
+
+	return err
+}
+
+// CopySSZ returns a copy of the Transfer object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (t *Transfer) CopySSZ() (*Transfer, error) {
+	buf, err := t.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Transfer)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Transfer object
+func (t *Transfer) SizeSSZ() int {
+	return 184
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Transfer object
+func (t *Transfer) MaxSizeSSZ() uint64 {
+	return 184
+}
+
+// HashTreeRoot ssz hashes the Transfer object
+func (t *Transfer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(t)
+}
+
+// HashTreeRootWith ssz hashes the Transfer object with a hasher
+func (t *Transfer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Sender'
+	hh.PutUint64(t.Sender)
+
+	// Field (1) 'Recipient'
+	hh.PutUint64(t.Recipient)
+
+	// Field (2) 'Amount'
+	hh.PutUint64(t.Amount)
+
+	// Field (3) 'Fee'
+	hh.PutUint64(t.Fee)
+
+	// Field (4) 'Slot'
+	hh.PutUint64(t.Slot)
+
+	// Field (5) 'Pubkey'
+	if len(t.Pubkey) != 48 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(t.Pubkey)
+
+	// Field (6) 'Signature'
+	if len(t.Signature) != 96 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(t.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Transfer object
+func (t *Transfer) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Transfer",
+		ssz.NewField("sender", ssz.UintSchema(8)),
+		ssz.NewField("recipient", ssz.UintSchema(8)),
+		ssz.NewField("amount", ssz.UintSchema(8)),
+		ssz.NewField("fee", ssz.UintSchema(8)),
+		ssz.NewField("slot", ssz.UintSchema(8)),
+		ssz.NewField("pubkey", ssz.ByteVectorSchema(48)),
+		ssz.NewField("signature", ssz.ByteVectorSchema(96)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Transfer object
+func (t *Transfer) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "sender", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 8},
+		{Name: "recipient", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 8, Gindex: 9},
+		{Name: "amount", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 16, Gindex: 10},
+		{Name: "fee", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 24, Gindex: 11},
+		{Name: "slot", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 32, Gindex: 12},
+		{Name: "pubkey", Type: "Bytes48", Size: 48, Variable: false, Limit: 0, Offset: 40, Gindex: 13},
+		{Name: "signature", Type: "Bytes96", Size: 96, Variable: false, Limit: 0, Offset: 88, Gindex: 14},
+	}
+}
+
+// RandomTransfer returns a random Transfer object
+func RandomTransfer(rng *rand.Rand) *Transfer {
+	t := new(Transfer)
+	// Field (0) 'Sender'
+	t.Sender = rng.Uint64()
+
+	// Field (1) 'Recipient'
+	t.Recipient = rng.Uint64()
+
+	// Field (2) 'Amount'
+	t.Amount = rng.Uint64()
+
+	// Field (3) 'Fee'
+	t.Fee = rng.Uint64()
+
+	// Field (4) 'Slot'
+	t.Slot = rng.Uint64()
+
+	// Field (5) 'Pubkey'
+	t.Pubkey = ssz.RandomBytes(rng, 48)
+
+	// Field (6) 'Signature'
+	t.Signature = ssz.RandomBytes(rng, 96)
+
+	return t
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBody object
+func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ())
+	return b.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBody object to a target array
+func (b *BeaconBlockBody) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(220)
+
+	// Field (0) 'RandaoReveal'
+	if dst, err = ssz.MarshalFixedBytes(dst, b.RandaoReveal, 96); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		if dst, err = new(Eth1Data).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Graffiti'
+	if dst, err = ssz.MarshalFixedBytes(dst, b.Graffiti, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Offset (3) 'ProposerSlashings'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.ProposerSlashings) * 408
+
+	// Offset (4) 'AttesterSlashings'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		if b.AttesterSlashings[ii] == nil {
+			offset += new(AttesterSlashing).SizeSSZ()
+		} else {
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+
+	// Offset (5) 'Attestations'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		if b.Attestations[ii] == nil {
+			offset += new(Attestation).SizeSSZ()
+		} else {
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+
+	// Offset (6) 'Deposits'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (3) 'ProposerSlashings'
+	if len(b.ProposerSlashings) > 16 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if b.ProposerSlashings[ii] == nil {
+			if dst, err = new(ProposerSlashing).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if len(b.AttesterSlashings) > 1 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			if b.AttesterSlashings[ii] == nil {
+				offset += new(AttesterSlashing).SizeSSZ()
+			} else {
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+			}
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if b.AttesterSlashings[ii] == nil {
+			if dst, err = new(AttesterSlashing).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if len(b.Attestations) > 128 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			if b.Attestations[ii] == nil {
+				offset += new(Attestation).SizeSSZ()
+			} else {
+				offset += b.Attestations[ii].SizeSSZ()
+			}
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if b.Attestations[ii] == nil {
+			if dst, err = new(Attestation).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if len(b.Deposits) > 16 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if b.Deposits[ii] == nil {
+			if dst, err = new(Deposit).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if len(b.VoluntaryExits) > 16 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if b.VoluntaryExits[ii] == nil {
+			if dst, err = new(SignedVoluntaryExit).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the BeaconBlockBody object to the buffers, dst is the encoding since the last referenced field
+func (b *BeaconBlockBody) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(220)
+
+	// Field (0) 'RandaoReveal'
+	if len(b.RandaoReveal) != 96 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		if dst, err = new(Eth1Data).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(b.Eth1Data, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Graffiti'
+	if len(b.Graffiti) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, b.Graffiti)
+
+	// Offset (3) 'ProposerSlashings'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.ProposerSlashings) * 408
+
+	// Offset (4) 'AttesterSlashings'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		if b.AttesterSlashings[ii] == nil {
+			offset += new(AttesterSlashing).SizeSSZ()
+		} else {
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+
+	// Offset (5) 'Attestations'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		if b.Attestations[ii] == nil {
+			offset += new(Attestation).SizeSSZ()
+		} else {
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+
+	// Offset (6) 'Deposits'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (3) 'ProposerSlashings'
+	if len(b.ProposerSlashings) > 16 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if b.ProposerSlashings[ii] == nil {
+			if dst, err = new(ProposerSlashing).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = bufs.MarshalTo(b.ProposerSlashings[ii], dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if len(b.AttesterSlashings) > 1 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			if b.AttesterSlashings[ii] == nil {
+				offset += new(AttesterSlashing).SizeSSZ()
+			} else {
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+			}
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if b.AttesterSlashings[ii] == nil {
+			if dst, err = new(AttesterSlashing).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = bufs.MarshalTo(b.AttesterSlashings[ii], dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if len(b.Attestations) > 128 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			if b.Attestations[ii] == nil {
+				offset += new(Attestation).SizeSSZ()
+			} else {
+				offset += b.Attestations[ii].SizeSSZ()
+			}
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if b.Attestations[ii] == nil {
+			if dst, err = new(Attestation).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = bufs.MarshalTo(b.Attestations[ii], dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if len(b.Deposits) > 16 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if b.Deposits[ii] == nil {
+			if dst, err = new(Deposit).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = bufs.MarshalTo(b.Deposits[ii], dst); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if len(b.VoluntaryExits) > 16 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if b.VoluntaryExits[ii] == nil {
+			if dst, err = new(SignedVoluntaryExit).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		} else if dst, err = bufs.MarshalTo(b.VoluntaryExits[ii], dst); err != nil {
+			return nil, err
+		}
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBody object
+func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 220 {
+		return errSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7 uint64
+
+	// Field (0) 'RandaoReveal'
+	b.RandaoReveal = append(b.RandaoReveal[:0], buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	b.Graffiti = append(b.Graffiti[:0], buf[168:200]...)
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size || o3 != 220 {
+		return errOffset
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return errOffset
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return errOffset
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return errOffset
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return errOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, ok := ssz.DivideInt(len(buf), 408)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(b.ProposerSlashings) >= num {
+			b.ProposerSlashings = b.ProposerSlashings[:num]
+		} else {
+			b.ProposerSlashings = make([]*ProposerSlashing, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*408 : (ii+1)*408]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 1)
+		if err != nil {
+			return err
+		}
+		if cap(b.AttesterSlashings) >= num {
+			b.AttesterSlashings = b.AttesterSlashings[:0]
+		} else {
+			b.AttesterSlashings = make([]*AttesterSlashing, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(b.AttesterSlashings) < cap(b.AttesterSlashings) {
+				b.AttesterSlashings = b.AttesterSlashings[:len(b.AttesterSlashings)+1]
+			} else {
+				b.AttesterSlashings = append(b.AttesterSlashings, nil)
+			}
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return err
+		}
+		if cap(b.Attestations) >= num {
+			b.Attestations = b.Attestations[:0]
+		} else {
+			b.Attestations = make([]*Attestation, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(b.Attestations) < cap(b.Attestations) {
+				b.Attestations = b.Attestations[:len(b.Attestations)+1]
+			} else {
+				b.Attestations = append(b.Attestations, nil)
+			}
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, ok := ssz.DivideInt(len(buf), 1240)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(b.Deposits) >= num {
+			b.Deposits = b.Deposits[:num]
+		} else {
+			b.Deposits = make([]*Deposit, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(Deposit)
+			}
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:]
+		num, ok := ssz.DivideInt(len(buf), 112)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(b.VoluntaryExits) >= num {
+			b.VoluntaryExits = b.VoluntaryExits[:num]
+		} else {
+			b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the BeaconBlockBody object and fails if the input is not its canonical encoding
+func (b *BeaconBlockBody) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(b, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the BeaconBlockBody object with the nested objects of the pool
+func (b *BeaconBlockBody) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 220 {
+		return errSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7 uint64
+
+	// Field (0) 'RandaoReveal'
+	b.RandaoReveal = append(b.RandaoReveal[:0], buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil && pool != nil {
+		b.Eth1Data, _ = pool.Get((*Eth1Data)(nil)).(*Eth1Data)
+	}
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalWithPool(b.Eth1Data, buf[96:168], pool); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	b.Graffiti = append(b.Graffiti[:0], buf[168:200]...)
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size || o3 != 220 {
+		return errOffset
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return errOffset
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return errOffset
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return errOffset
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return errOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, ok := ssz.DivideInt(len(buf), 408)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(b.ProposerSlashings) >= num {
+			b.ProposerSlashings = b.ProposerSlashings[:num]
+		} else {
+			b.ProposerSlashings = make([]*ProposerSlashing, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil && pool != nil {
+				b.ProposerSlashings[ii], _ = pool.Get((*ProposerSlashing)(nil)).(*ProposerSlashing)
+			}
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = ssz.UnmarshalWithPool(b.ProposerSlashings[ii], buf[ii*408:(ii+1)*408], pool); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 1)
+		if err != nil {
+			return err
+		}
+		if cap(b.AttesterSlashings) >= num {
+			b.AttesterSlashings = b.AttesterSlashings[:0]
+		} else {
+			b.AttesterSlashings = make([]*AttesterSlashing, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(b.AttesterSlashings) < cap(b.AttesterSlashings) {
+				b.AttesterSlashings = b.AttesterSlashings[:len(b.AttesterSlashings)+1]
+			} else {
+				b.AttesterSlashings = append(b.AttesterSlashings, nil)
+			}
+			if b.AttesterSlashings[indx] == nil && pool != nil {
+				b.AttesterSlashings[indx], _ = pool.Get((*AttesterSlashing)(nil)).(*AttesterSlashing)
+			}
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err = ssz.UnmarshalWithPool(b.AttesterSlashings[indx], buf, pool); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return err
+		}
+		if cap(b.Attestations) >= num {
+			b.Attestations = b.Attestations[:0]
+		} else {
+			b.Attestations = make([]*Attestation, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(b.Attestations) < cap(b.Attestations) {
+				b.Attestations = b.Attestations[:len(b.Attestations)+1]
+			} else {
+				b.Attestations = append(b.Attestations, nil)
+			}
+			if b.Attestations[indx] == nil && pool != nil {
+				b.Attestations[indx], _ = pool.Get((*Attestation)(nil)).(*Attestation)
+			}
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err = ssz.UnmarshalWithPool(b.Attestations[indx], buf, pool); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, ok := ssz.DivideInt(len(buf), 1240)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(b.Deposits) >= num {
+			b.Deposits = b.Deposits[:num]
+		} else {
+			b.Deposits = make([]*Deposit, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil && pool != nil {
+				b.Deposits[ii], _ = pool.Get((*Deposit)(nil)).(*Deposit)
+			}
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(Deposit)
+			}
+			if err = ssz.UnmarshalWithPool(b.Deposits[ii], buf[ii*1240:(ii+1)*1240], pool); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:]
+		num, ok := ssz.DivideInt(len(buf), 112)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(b.VoluntaryExits) >= num {
+			b.VoluntaryExits = b.VoluntaryExits[:num]
+		} else {
+			b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil && pool != nil {
+				b.VoluntaryExits[ii], _ = pool.Get((*SignedVoluntaryExit)(nil)).(*SignedVoluntaryExit)
+			}
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = ssz.UnmarshalWithPool(b.VoluntaryExits[ii], buf[ii*112:(ii+1)*112], pool); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the BeaconBlockBody object, the byte fields alias the input
+func (b *BeaconBlockBody) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 220 {
+		return errSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7 uint64
+
+	// Field (0) 'RandaoReveal'
+	b.RandaoReveal = ssz.Alias(buf[0:96])
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalNoCopy(b.Eth1Data, buf[96:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	b.Graffiti = ssz.Alias(buf[168:200])
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size || o3 != 220 {
+		return errOffset
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return errOffset
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return errOffset
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return errOffset
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return errOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, ok := ssz.DivideInt(len(buf), 408)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(b.ProposerSlashings) >= num {
+			b.ProposerSlashings = b.ProposerSlashings[:num]
+		} else {
+			b.ProposerSlashings = make([]*ProposerSlashing, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = ssz.UnmarshalNoCopy(b.ProposerSlashings[ii], buf[ii*408:(ii+1)*408]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 1)
+		if err != nil {
+			return err
+		}
+		if cap(b.AttesterSlashings) >= num {
+			b.AttesterSlashings = b.AttesterSlashings[:0]
+		} else {
+			b.AttesterSlashings = make([]*AttesterSlashing, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(b.AttesterSlashings) < cap(b.AttesterSlashings) {
+				b.AttesterSlashings = b.AttesterSlashings[:len(b.AttesterSlashings)+1]
+			} else {
+				b.AttesterSlashings = append(b.AttesterSlashings, nil)
+			}
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err = ssz.UnmarshalNoCopy(b.AttesterSlashings[indx], buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return err
+		}
+		if cap(b.Attestations) >= num {
+			b.Attestations = b.Attestations[:0]
+		} else {
+			b.Attestations = make([]*Attestation, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(b.Attestations) < cap(b.Attestations) {
+				b.Attestations = b.Attestations[:len(b.Attestations)+1]
+			} else {
+				b.Attestations = append(b.Attestations, nil)
+			}
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err = ssz.UnmarshalNoCopy(b.Attestations[indx], buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, ok := ssz.DivideInt(len(buf), 1240)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(b.Deposits) >= num {
+			b.Deposits = b.Deposits[:num]
+		} else {
+			b.Deposits = make([]*Deposit, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(Deposit)
+			}
+			if err = ssz.UnmarshalNoCopy(b.Deposits[ii], buf[ii*1240:(ii+1)*1240]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:]
+		num, ok := ssz.DivideInt(len(buf), 112)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(b.VoluntaryExits) >= num {
+			b.VoluntaryExits = b.VoluntaryExits[:num]
+		} else {
+			b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = ssz.UnmarshalNoCopy(b.VoluntaryExits[ii], buf[ii*112:(ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the BeaconBlockBody object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (b *BeaconBlockBody) CopySSZ() (*BeaconBlockBody, error) {
+	buf, err := b.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(BeaconBlockBody)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBody object
+func (b *BeaconBlockBody) SizeSSZ() (size int) {
+	size = 220
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 408
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		if b.AttesterSlashings[ii] == nil {
+			size += new(AttesterSlashing).SizeSSZ()
+		} else {
+			size += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		if b.Attestations[ii] == nil {
+			size += new(Attestation).SizeSSZ()
+		} else {
+			size += b.Attestations[ii].SizeSSZ()
+		}
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1240
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the BeaconBlockBody object
+func (b *BeaconBlockBody) MaxSizeSSZ() uint64 {
+	return 124208
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBody object
+func (b *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBody object with a hasher
+func (b *BeaconBlockBody) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RandaoReveal'
+	if len(b.RandaoReveal) != 96 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		if err = new(Eth1Data).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if len(b.Graffiti) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b.Graffiti)
+
+	// Field (3) 'ProposerSlashings'
+	{
+		if len(b.ProposerSlashings) > 16 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				if err = new(ProposerSlashing).HashTreeRootWith(hh); err != nil {
+					return
+				}
+			} else if err = b.ProposerSlashings[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.ProposerSlashings)), 16)
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		if len(b.AttesterSlashings) > 1 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if b.AttesterSlashings[ii] == nil {
+				if err = new(AttesterSlashing).HashTreeRootWith(hh); err != nil {
+					return
+				}
+			} else if err = b.AttesterSlashings[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.AttesterSlashings)), 1)
+	}
+
+	// Field (5) 'Attestations'
+	{
+		if len(b.Attestations) > 128 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if b.Attestations[ii] == nil {
+				if err = new(Attestation).HashTreeRootWith(hh); err != nil {
+					return
+				}
+			} else if err = b.Attestations[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.Attestations)), 128)
+	}
+
+	// Field (6) 'Deposits'
+	{
+		if len(b.Deposits) > 16 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if b.Deposits[ii] == nil {
+				if err = new(Deposit).HashTreeRootWith(hh); err != nil {
+					return
+				}
+			} else if err = b.Deposits[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.Deposits)), 16)
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		if len(b.VoluntaryExits) > 16 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				if err = new(SignedVoluntaryExit).HashTreeRootWith(hh); err != nil {
+					return
+				}
+			} else if err = b.VoluntaryExits[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(b.VoluntaryExits)), 16)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the BeaconBlockBody object
+func (b *BeaconBlockBody) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("BeaconBlockBody",
+		ssz.NewField("randao_reveal", ssz.ByteVectorSchema(96)),
+		ssz.NewField("eth1_data", new(Eth1Data).SchemaSSZ()),
+		ssz.NewField("graffiti", ssz.ByteVectorSchema(32)),
+		ssz.NewField("proposer_slashings", ssz.ListSchema(new(ProposerSlashing).SchemaSSZ(), 16)),
+		ssz.NewField("attester_slashings", ssz.ListSchema(new(AttesterSlashing).SchemaSSZ(), 1)),
+		ssz.NewField("attestations", ssz.ListSchema(new(Attestation).SchemaSSZ(), 128)),
+		ssz.NewField("deposits", ssz.ListSchema(new(Deposit).SchemaSSZ(), 16)),
+		ssz.NewField("voluntary_exits", ssz.ListSchema(new(SignedVoluntaryExit).SchemaSSZ(), 16)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the BeaconBlockBody object
+func (b *BeaconBlockBody) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "randao_reveal", Type: "Bytes96", Size: 96, Variable: false, Limit: 0, Offset: 0, Gindex: 8},
+		{Name: "eth1_data", Type: "Eth1Data", Size: 72, Variable: false, Limit: 0, Offset: 96, Gindex: 9},
+		{Name: "graffiti", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 168, Gindex: 10},
+		{Name: "proposer_slashings", Type: "List[ProposerSlashing, 16]", Size: 4, Variable: true, Limit: 16, Offset: 200, Gindex: 11},
+		{Name: "attester_slashings", Type: "List[AttesterSlashing, 1]", Size: 4, Variable: true, Limit: 1, Offset: 204, Gindex: 12},
+		{Name: "attestations", Type: "List[Attestation, 128]", Size: 4, Variable: true, Limit: 128, Offset: 208, Gindex: 13},
+		{Name: "deposits", Type: "List[Deposit, 16]", Size: 4, Variable: true, Limit: 16, Offset: 212, Gindex: 14},
+		{Name: "voluntary_exits", Type: "List[SignedVoluntaryExit, 16]", Size: 4, Variable: true, Limit: 16, Offset: 216, Gindex: 15},
+	}
+}
+
+// RandomBeaconBlockBody returns a random BeaconBlockBody object
+func RandomBeaconBlockBody(rng *rand.Rand) *BeaconBlockBody {
+	b := new(BeaconBlockBody)
+	// Field (0) 'RandaoReveal'
+	b.RandaoReveal = ssz.RandomBytes(rng, 96)
+
+	// Field (1) 'Eth1Data'
+	b.Eth1Data = RandomEth1Data(rng)
+
+	// Field (2) 'Graffiti'
+	b.Graffiti = ssz.RandomBytes(rng, 32)
+
+	// Field (3) 'ProposerSlashings'
+	{
+		num := ssz.RandomLength(rng, 16)
+		if cap(b.ProposerSlashings) >= num {
+			b.ProposerSlashings = b.ProposerSlashings[:num]
+		} else {
+			b.ProposerSlashings = make([]*ProposerSlashing, num)
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			b.ProposerSlashings[ii] = RandomProposerSlashing(rng)
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		num := ssz.RandomLength(rng, 1)
+		if cap(b.AttesterSlashings) >= num {
+			b.AttesterSlashings = b.AttesterSlashings[:num]
+		} else {
+			b.AttesterSlashings = make([]*AttesterSlashing, num)
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			b.AttesterSlashings[ii] = RandomAttesterSlashing(rng)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		num := ssz.RandomLength(rng, 128)
+		if cap(b.Attestations) >= num {
+			b.Attestations = b.Attestations[:num]
+		} else {
+			b.Attestations = make([]*Attestation, num)
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			b.Attestations[ii] = RandomAttestation(rng)
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		num := ssz.RandomLength(rng, 16)
+		if cap(b.Deposits) >= num {
+			b.Deposits = b.Deposits[:num]
+		} else {
+			b.Deposits = make([]*Deposit, num)
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			b.Deposits[ii] = RandomDeposit(rng)
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		num := ssz.RandomLength(rng, 16)
+		if cap(b.VoluntaryExits) >= num {
+			b.VoluntaryExits = b.VoluntaryExits[:num]
+		} else {
+			b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			b.VoluntaryExits[ii] = RandomSignedVoluntaryExit(rng)
+		}
+	}
+
+	return b
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, s.SizeSSZ())
+	return s.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
+func (s *SignedBeaconBlockHeader) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		if dst, err = new(BeaconBlockHeader).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Signature'
+	if dst, err = ssz.MarshalFixedBytes(dst, s.Signature, 96); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the SignedBeaconBlockHeader object to the buffers, dst is the encoding since the last referenced field
+func (s *SignedBeaconBlockHeader) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		if dst, err = new(BeaconBlockHeader).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(s.Header, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Signature'
+	if len(s.Signature) != 96 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, s.Signature)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 200 {
+		return errSize
+	}
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if err = s.Header.UnmarshalSSZ(buf[0:104]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	s.Signature = append(s.Signature[:0], buf[104:200]...)
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the SignedBeaconBlockHeader object and fails if the input is not its canonical encoding
+func (s *SignedBeaconBlockHeader) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(s, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the SignedBeaconBlockHeader object with the nested objects of the pool
+func (s *SignedBeaconBlockHeader) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 200 {
+		return errSize
+	}
+
+	// Field (0) 'Header'
+	if s.Header == nil && pool != nil {
+		s.Header, _ = pool.Get((*BeaconBlockHeader)(nil)).(*BeaconBlockHeader)
+	}
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalWithPool(s.Header, buf[0:104], pool); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	s.Signature = append(s.Signature[:0], buf[104:200]...)
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the SignedBeaconBlockHeader object, the byte fields alias the input
+func (s *SignedBeaconBlockHeader) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 200 {
+		return errSize
+	}
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalNoCopy(s.Header, buf[0:104]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	s.Signature = ssz.Alias(buf[104:200])
+
+	return err
+}
+
+// CopySSZ returns a copy of the SignedBeaconBlockHeader object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (s *SignedBeaconBlockHeader) CopySSZ() (*SignedBeaconBlockHeader, error) {
+	buf, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(SignedBeaconBlockHeader)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) SizeSSZ() int {
+	return 200
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) MaxSizeSSZ() uint64 {
+	return 200
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlockHeader object with a hasher
+func (s *SignedBeaconBlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		if err = new(BeaconBlockHeader).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = s.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if len(s.Signature) != 96 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("SignedBeaconBlockHeader",
+		ssz.NewField("message", new(BeaconBlockHeader).SchemaSSZ()),
+		ssz.NewField("signature", ssz.ByteVectorSchema(96)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "message", Type: "BeaconBlockHeader", Size: 104, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "signature", Type: "Bytes96", Size: 96, Variable: false, Limit: 0, Offset: 104, Gindex: 3},
+	}
+}
+
+// RandomSignedBeaconBlockHeader returns a random SignedBeaconBlockHeader object
+func RandomSignedBeaconBlockHeader(rng *rand.Rand) *SignedBeaconBlockHeader {
+	s := new(SignedBeaconBlockHeader)
+	// Field (0) 'Header'
+	s.Header = RandomBeaconBlockHeader(rng)
+
+	// Field (1) 'Signature'
+	s.Signature = ssz.RandomBytes(rng, 96)
+
+	return s
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockHeader object
+func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ())
+	return b.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockHeader object to a target array
+func (b *BeaconBlockHeader) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ParentRoot'
+	if dst, err = ssz.MarshalFixedBytes(dst, b.ParentRoot, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (2) 'StateRoot'
+	if dst, err = ssz.MarshalFixedBytes(dst, b.StateRoot, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (3) 'BodyRoot'
+	if dst, err = ssz.MarshalFixedBytes(dst, b.BodyRoot, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the BeaconBlockHeader object to the buffers, dst is the encoding since the last referenced field
+func (b *BeaconBlockHeader) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ParentRoot'
+	if len(b.ParentRoot) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, b.ParentRoot)
+
+	// Field (2) 'StateRoot'
+	if len(b.StateRoot) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, b.StateRoot)
+
+	// Field (3) 'BodyRoot'
+	if len(b.BodyRoot) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, b.BodyRoot)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockHeader object
+func (b *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 104 {
+		return errSize
+	}
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	b.ParentRoot = append(b.ParentRoot[:0], buf[8:40]...)
+
+	// Field (2) 'StateRoot'
+	b.StateRoot = append(b.StateRoot[:0], buf[40:72]...)
+
+	// Field (3) 'BodyRoot'
+	b.BodyRoot = append(b.BodyRoot[:0], buf[72:104]...)
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the BeaconBlockHeader object and fails if the input is not its canonical encoding
+func (b *BeaconBlockHeader) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(b, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the BeaconBlockHeader object with the nested objects of the pool
+func (b *BeaconBlockHeader) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 104 {
+		return errSize
+	}
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	b.ParentRoot = append(b.ParentRoot[:0], buf[8:40]...)
+
+	// Field (2) 'StateRoot'
+	b.StateRoot = append(b.StateRoot[:0], buf[40:72]...)
+
+	// Field (3) 'BodyRoot'
+	b.BodyRoot = append(b.BodyRoot[:0], buf[72:104]...)
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the BeaconBlockHeader object, the byte fields alias the input
+func (b *BeaconBlockHeader) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 104 {
+		return errSize
+	}
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	b.ParentRoot = ssz.Alias(buf[8:40])
+
+	// Field (2) 'StateRoot'
+	b.StateRoot = ssz.Alias(buf[40:72])
+
+	// Field (3) 'BodyRoot'
+	b.BodyRoot = ssz.Alias(buf[72:104])
+
+	return err
+}
+
+// CopySSZ returns a copy of the BeaconBlockHeader object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (b *BeaconBlockHeader) CopySSZ() (*BeaconBlockHeader, error) {
+	buf, err := b.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(BeaconBlockHeader)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockHeader object
+func (b *BeaconBlockHeader) SizeSSZ() int {
+	return 104
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) MaxSizeSSZ() uint64 {
+	return 104
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockHeader object
+func (b *BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockHeader object with a hasher
+func (b *BeaconBlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ParentRoot'
+	if len(b.ParentRoot) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (2) 'StateRoot'
+	if len(b.StateRoot) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (3) 'BodyRoot'
+	if len(b.BodyRoot) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b.BodyRoot)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("BeaconBlockHeader",
+		ssz.NewField("slot", ssz.UintSchema(8)),
+		ssz.NewField("parent_root", ssz.ByteVectorSchema(32)),
+		ssz.NewField("state_root", ssz.ByteVectorSchema(32)),
+		ssz.NewField("body_root", ssz.ByteVectorSchema(32)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "slot", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "parent_root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 8, Gindex: 5},
+		{Name: "state_root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 40, Gindex: 6},
+		{Name: "body_root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 72, Gindex: 7},
+	}
+}
+
+// RandomBeaconBlockHeader returns a random BeaconBlockHeader object
+func RandomBeaconBlockHeader(rng *rand.Rand) *BeaconBlockHeader {
+	b := new(BeaconBlockHeader)
+	// Field (0) 'Slot'
+	b.Slot = rng.Uint64()
+
+	// Field (1) 'ParentRoot'
+	b.ParentRoot = ssz.RandomBytes(rng, 32)
+
+	// Field (2) 'StateRoot'
+	b.StateRoot = ssz.RandomBytes(rng, 32)
+
+	// Field (3) 'BodyRoot'
+	b.BodyRoot = ssz.RandomBytes(rng, 32)
+
+	return b
+}
+
+// MarshalSSZ ssz marshals the ForkedHeader object
+func (f *ForkedHeader) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, f.SizeSSZ())
+	return f.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the ForkedHeader object to a target array
+func (f *ForkedHeader) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(44)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, f.Slot)
+
+	// Field (1) 'ParentRoot'
+	if dst, err = ssz.MarshalFixedBytes(dst, f.ParentRoot, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Offset (2) 'Extra'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(f.Extra)
+
+	// Field (2) 'Extra'
+	if len(f.Extra) > 32 {
+		return nil, errMarshalDynamicBytes
+	}
+	dst = append(dst, f.Extra...)
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the ForkedHeader object to the buffers, dst is the encoding since the last referenced field
+func (f *ForkedHeader) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(44)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, f.Slot)
+
+	// Field (1) 'ParentRoot'
+	if len(f.ParentRoot) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, f.ParentRoot)
+
+	// Offset (2) 'Extra'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(f.Extra)
+
+	// Field (2) 'Extra'
+	if len(f.Extra) > 32 {
+		return nil, errMarshalDynamicBytes
+	}
+	dst = bufs.Append(dst, f.Extra)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the ForkedHeader object
+func (f *ForkedHeader) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 44 {
+		return errSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'Slot'
+	f.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	f.ParentRoot = append(f.ParentRoot[:0], buf[8:40]...)
+
+	// Offset (2) 'Extra'
+	if o2 = ssz.ReadOffset(buf[40:44]); o2 > size || o2 != 44 {
+		return errOffset
+	}
+
+	// Field (2) 'Extra'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return errListTooBig
+		}
+		f.Extra = append(f.Extra[:0], buf...)
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the ForkedHeader object and fails if the input is not its canonical encoding
+func (f *ForkedHeader) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(f, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the ForkedHeader object with the nested objects of the pool
+func (f *ForkedHeader) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 44 {
+		return errSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'Slot'
+	f.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	f.ParentRoot = append(f.ParentRoot[:0], buf[8:40]...)
+
+	// Offset (2) 'Extra'
+	if o2 = ssz.ReadOffset(buf[40:44]); o2 > size || o2 != 44 {
+		return errOffset
+	}
+
+	// Field (2) 'Extra'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return errListTooBig
+		}
+		f.Extra = append(f.Extra[:0], buf...)
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the ForkedHeader object, the byte fields alias the input
+func (f *ForkedHeader) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 44 {
+		return errSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'Slot'
+	f.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	f.ParentRoot = ssz.Alias(buf[8:40])
+
+	// Offset (2) 'Extra'
+	if o2 = ssz.ReadOffset(buf[40:44]); o2 > size || o2 != 44 {
+		return errOffset
+	}
+
+	// Field (2) 'Extra'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return errListTooBig
+		}
+		f.Extra = ssz.Alias(buf)
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the ForkedHeader object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (f *ForkedHeader) CopySSZ() (*ForkedHeader, error) {
+	buf, err := f.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(ForkedHeader)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkedHeader object
+func (f *ForkedHeader) SizeSSZ() (size int) {
+	size = 44
+
+	// Field (2) 'Extra'
+	size += len(f.Extra)
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the ForkedHeader object
+func (f *ForkedHeader) MaxSizeSSZ() uint64 {
+	return 76
+}
+
+// HashTreeRoot ssz hashes the ForkedHeader object
+func (f *ForkedHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkedHeader object with a hasher
+func (f *ForkedHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(f.Slot)
+
+	// Field (1) 'ParentRoot'
+	if len(f.ParentRoot) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(f.ParentRoot)
+
+	// Field (2) 'Extra'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(f.Extra))
+		if byteLen > 32 {
+			return ssz.ErrListTooBig
+		}
+		hh.Append(f.Extra)
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZPhase0 ssz marshals the ForkedHeader object
+func (f *ForkedHeader) MarshalSSZPhase0() ([]byte, error) {
+	buf := make([]byte, f.SizeSSZPhase0())
+	return f.MarshalSSZToPhase0(buf[:0])
+}
+
+// MarshalSSZToPhase0 ssz marshals the ForkedHeader object to a target array
+func (f *ForkedHeader) MarshalSSZToPhase0(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, f.Slot)
+
+	// Field (1) 'ParentRoot'
+	if dst, err = ssz.MarshalFixedBytes(dst, f.ParentRoot, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (2) 'Old'
+	dst = ssz.MarshalUint64(dst, f.Old)
+
+	return dst, err
+}
+
+// UnmarshalSSZPhase0 ssz unmarshals the ForkedHeader object
+func (f *ForkedHeader) UnmarshalSSZPhase0(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 48 {
+		return errSize
+	}
+
+	// Field (0) 'Slot'
+	f.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	f.ParentRoot = append(f.ParentRoot[:0], buf[8:40]...)
+
+	// Field (2) 'Old'
+	f.Old = ssz.UnmarshallUint64(buf[40:48])
+
+	return err
+}
+
+// SizeSSZPhase0 returns the ssz encoded size in bytes for the ForkedHeader object
+func (f *ForkedHeader) SizeSSZPhase0() int {
+	return 48
+}
+
+// MaxSizeSSZPhase0 returns the size in bytes of the largest ssz encoding of the ForkedHeader object
+func (f *ForkedHeader) MaxSizeSSZPhase0() uint64 {
+	return 48
+}
+
+// HashTreeRootPhase0 ssz hashes the ForkedHeader object
+func (f *ForkedHeader) HashTreeRootPhase0() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(ssz.HashFunc(f.HashTreeRootWithPhase0))
+}
+
+// HashTreeRootWithPhase0 ssz hashes the ForkedHeader object with a hasher
+func (f *ForkedHeader) HashTreeRootWithPhase0(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(f.Slot)
+
+	// Field (1) 'ParentRoot'
+	if len(f.ParentRoot) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(f.ParentRoot)
+
+	// Field (2) 'Old'
+	hh.PutUint64(f.Old)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZAltair ssz marshals the ForkedHeader object
+func (f *ForkedHeader) MarshalSSZAltair() ([]byte, error) {
+	buf := make([]byte, f.SizeSSZAltair())
+	return f.MarshalSSZToAltair(buf[:0])
+}
+
+// MarshalSSZToAltair ssz marshals the ForkedHeader object to a target array
+func (f *ForkedHeader) MarshalSSZToAltair(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(52)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, f.Slot)
+
+	// Field (1) 'ParentRoot'
+	if dst, err = ssz.MarshalFixedBytes(dst, f.ParentRoot, 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Offset (2) 'Extra'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(f.Extra)
+
+	// Field (3) 'Old'
+	dst = ssz.MarshalUint64(dst, f.Old)
+
+	// Field (2) 'Extra'
+	if len(f.Extra) > 32 {
+		return nil, errMarshalDynamicBytes
+	}
+	dst = append(dst, f.Extra...)
+
+	return dst, err
+}
+
+// UnmarshalSSZAltair ssz unmarshals the ForkedHeader object
+func (f *ForkedHeader) UnmarshalSSZAltair(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 52 {
+		return errSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'Slot'
+	f.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ParentRoot'
+	f.ParentRoot = append(f.ParentRoot[:0], buf[8:40]...)
+
+	// Offset (2) 'Extra'
+	if o2 = ssz.ReadOffset(buf[40:44]); o2 > size || o2 != 52 {
+		return errOffset
+	}
+
+	// Field (3) 'Old'
+	f.Old = ssz.UnmarshallUint64(buf[44:52])
+
+	// Field (2) 'Extra'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return errListTooBig
+		}
+		f.Extra = append(f.Extra[:0], buf...)
+	}
+	return err
+}
+
+// SizeSSZAltair returns the ssz encoded size in bytes for the ForkedHeader object
+func (f *ForkedHeader) SizeSSZAltair() (size int) {
+	size = 52
+
+	// Field (2) 'Extra'
+	size += len(f.Extra)
+
+	return
+}
+
+// MaxSizeSSZAltair returns the size in bytes of the largest ssz encoding of the ForkedHeader object
+func (f *ForkedHeader) MaxSizeSSZAltair() uint64 {
+	return 84
+}
+
+// HashTreeRootAltair ssz hashes the ForkedHeader object
+func (f *ForkedHeader) HashTreeRootAltair() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(ssz.HashFunc(f.HashTreeRootWithAltair))
+}
+
+// HashTreeRootWithAltair ssz hashes the ForkedHeader object with a hasher
+func (f *ForkedHeader) HashTreeRootWithAltair(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(f.Slot)
+
+	// Field (1) 'ParentRoot'
+	if len(f.ParentRoot) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(f.ParentRoot)
+
+	// Field (2) 'Extra'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(f.Extra))
+		if byteLen > 32 {
+			return ssz.ErrListTooBig
+		}
+		hh.Append(f.Extra)
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (3) 'Old'
+	hh.PutUint64(f.Old)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZBellatrix ssz marshals the ForkedHeader object
+func (f *ForkedHeader) MarshalSSZBellatrix() ([]byte, error) {
+	return f.MarshalSSZAltair()
+}
+
+// MarshalSSZToBellatrix ssz marshals the ForkedHeader object to a target array
+func (f *ForkedHeader) MarshalSSZToBellatrix(dst []byte) ([]byte, error) {
+	return f.MarshalSSZToAltair(dst)
+}
+
+// UnmarshalSSZBellatrix ssz unmarshals the ForkedHeader object
+func (f *ForkedHeader) UnmarshalSSZBellatrix(buf []byte) error {
+	return f.UnmarshalSSZAltair(buf)
+}
+
+// SizeSSZBellatrix returns the ssz encoded size in bytes for the ForkedHeader object
+func (f *ForkedHeader) SizeSSZBellatrix() int {
+	return f.SizeSSZAltair()
+}
+
+// MaxSizeSSZBellatrix returns the size in bytes of the largest ssz encoding of the ForkedHeader object
+func (f *ForkedHeader) MaxSizeSSZBellatrix() uint64 {
+	return f.MaxSizeSSZAltair()
+}
+
+// HashTreeRootBellatrix ssz hashes the ForkedHeader object
+func (f *ForkedHeader) HashTreeRootBellatrix() ([32]byte, error) {
+	return f.HashTreeRootAltair()
+}
+
+// HashTreeRootWithBellatrix ssz hashes the ForkedHeader object with a hasher
+func (f *ForkedHeader) HashTreeRootWithBellatrix(hh *ssz.Hasher) error {
+	return f.HashTreeRootWithAltair(hh)
+}
+
+// MarshalSSZCapella ssz marshals the ForkedHeader object
+func (f *ForkedHeader) MarshalSSZCapella() ([]byte, error) {
+	return f.MarshalSSZ()
+}
+
+// MarshalSSZToCapella ssz marshals the ForkedHeader object to a target array
+func (f *ForkedHeader) MarshalSSZToCapella(dst []byte) ([]byte, error) {
+	return f.MarshalSSZTo(dst)
+}
+
+// UnmarshalSSZCapella ssz unmarshals the ForkedHeader object
+func (f *ForkedHeader) UnmarshalSSZCapella(buf []byte) error {
+	return f.UnmarshalSSZ(buf)
+}
+
+// SizeSSZCapella returns the ssz encoded size in bytes for the ForkedHeader object
+func (f *ForkedHeader) SizeSSZCapella() int {
+	return f.SizeSSZ()
+}
+
+// MaxSizeSSZCapella returns the size in bytes of the largest ssz encoding of the ForkedHeader object
+func (f *ForkedHeader) MaxSizeSSZCapella() uint64 {
+	return f.MaxSizeSSZ()
+}
+
+// HashTreeRootCapella ssz hashes the ForkedHeader object
+func (f *ForkedHeader) HashTreeRootCapella() ([32]byte, error) {
+	return f.HashTreeRoot()
+}
+
+// HashTreeRootWithCapella ssz hashes the ForkedHeader object with a hasher
+func (f *ForkedHeader) HashTreeRootWithCapella(hh *ssz.Hasher) error {
+	return f.HashTreeRootWith(hh)
+}
+
+// MarshalSSZDeneb ssz marshals the ForkedHeader object
+func (f *ForkedHeader) MarshalSSZDeneb() ([]byte, error) {
+	return f.MarshalSSZ()
+}
+
+// MarshalSSZToDeneb ssz marshals the ForkedHeader object to a target array
+func (f *ForkedHeader) MarshalSSZToDeneb(dst []byte) ([]byte, error) {
+	return f.MarshalSSZTo(dst)
+}
+
+// UnmarshalSSZDeneb ssz unmarshals the ForkedHeader object
+func (f *ForkedHeader) UnmarshalSSZDeneb(buf []byte) error {
+	return f.UnmarshalSSZ(buf)
+}
+
+// SizeSSZDeneb returns the ssz encoded size in bytes for the ForkedHeader object
+func (f *ForkedHeader) SizeSSZDeneb() int {
+	return f.SizeSSZ()
+}
+
+// MaxSizeSSZDeneb returns the size in bytes of the largest ssz encoding of the ForkedHeader object
+func (f *ForkedHeader) MaxSizeSSZDeneb() uint64 {
+	return f.MaxSizeSSZ()
+}
+
+// HashTreeRootDeneb ssz hashes the ForkedHeader object
+func (f *ForkedHeader) HashTreeRootDeneb() ([32]byte, error) {
+	return f.HashTreeRoot()
+}
+
+// HashTreeRootWithDeneb ssz hashes the ForkedHeader object with a hasher
+func (f *ForkedHeader) HashTreeRootWithDeneb(hh *ssz.Hasher) error {
+	return f.HashTreeRootWith(hh)
+}
+
+// MarshalSSZElectra ssz marshals the ForkedHeader object
+func (f *ForkedHeader) MarshalSSZElectra() ([]byte, error) {
+	return f.MarshalSSZ()
+}
+
+// MarshalSSZToElectra ssz marshals the ForkedHeader object to a target array
+func (f *ForkedHeader) MarshalSSZToElectra(dst []byte) ([]byte, error) {
+	return f.MarshalSSZTo(dst)
+}
+
+// UnmarshalSSZElectra ssz unmarshals the ForkedHeader object
+func (f *ForkedHeader) UnmarshalSSZElectra(buf []byte) error {
+	return f.UnmarshalSSZ(buf)
+}
+
+// SizeSSZElectra returns the ssz encoded size in bytes for the ForkedHeader object
+func (f *ForkedHeader) SizeSSZElectra() int {
+	return f.SizeSSZ()
+}
+
+// MaxSizeSSZElectra returns the size in bytes of the largest ssz encoding of the ForkedHeader object
+func (f *ForkedHeader) MaxSizeSSZElectra() uint64 {
+	return f.MaxSizeSSZ()
+}
+
+// HashTreeRootElectra ssz hashes the ForkedHeader object
+func (f *ForkedHeader) HashTreeRootElectra() ([32]byte, error) {
+	return f.HashTreeRoot()
+}
+
+// HashTreeRootWithElectra ssz hashes the ForkedHeader object with a hasher
+func (f *ForkedHeader) HashTreeRootWithElectra(hh *ssz.Hasher) error {
+	return f.HashTreeRootWith(hh)
+}
+
+// SchemaSSZ returns the ssz schema of the ForkedHeader object
+func (f *ForkedHeader) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("ForkedHeader",
+		ssz.NewField("slot", ssz.UintSchema(8)),
+		ssz.NewField("parent_root", ssz.ByteVectorSchema(32)),
+		ssz.NewField("extra", ssz.ByteListSchema(32)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the ForkedHeader object
+func (f *ForkedHeader) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "slot", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "parent_root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 8, Gindex: 5},
+		{Name: "extra", Type: "ByteList[32]", Size: 4, Variable: true, Limit: 32, Offset: 40, Gindex: 6},
+	}
+}
+
+// RandomForkedHeader returns a random ForkedHeader object
+func RandomForkedHeader(rng *rand.Rand) *ForkedHeader {
+	f := new(ForkedHeader)
+	// Field (0) 'Slot'
+	f.Slot = rng.Uint64()
+
+	// Field (1) 'ParentRoot'
+	f.ParentRoot = ssz.RandomBytes(rng, 32)
+
+	// Field (2) 'Extra'
+	f.Extra = ssz.RandomBytes(rng, ssz.RandomLength(rng, 32))
+
+	return f
+}
+
+// MarshalSSZ ssz marshals the Blob object
+func (b *Blob) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ())
+	return b.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Blob object to a target array
+func (b *Blob) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(131132)
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, b.Index)
+
+	// Field (1) 'Blob'
+	if dst, err = ssz.MarshalFixedBytes(dst, b.Blob, 131072); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (2) 'Commitment'
+	if dst, err = ssz.MarshalFixedBytes(dst, b.Commitment, 48); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Offset (3) 'Data'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.Data)
+
+	// Field (3) 'Data'
+	if len(b.Data) > 1048576 {
+		return nil, errMarshalDynamicBytes
+	}
+	dst = append(dst, b.Data...)
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Blob object to the buffers, dst is the encoding since the last referenced field
+func (b *Blob) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(131132)
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, b.Index)
+
+	// Field (1) 'Blob'
+	if len(b.Blob) != 131072 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, b.Blob)
+
+	// Field (2) 'Commitment'
+	if len(b.Commitment) != 48 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, b.Commitment)
+
+	// Offset (3) 'Data'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(b.Data)
+
+	// Field (3) 'Data'
+	if len(b.Data) > 1048576 {
+		return nil, errMarshalDynamicBytes
+	}
+	dst = bufs.Append(dst, b.Data)
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Blob object
+func (b *Blob) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 131132 {
+		return errSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'Index'
+	b.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Blob'
+	b.Blob = append(b.Blob[:0], buf[8:131080]...)
+
+	// Field (2) 'Commitment'
+	b.Commitment = append(b.Commitment[:0], buf[131080:131128]...)
+
+	// Offset (3) 'Data'
+	if o3 = ssz.ReadOffset(buf[131128:131132]); o3 > size || o3 != 131132 {
+		return errOffset
+	}
+
+	// Field (3) 'Data'
+	{
+		buf = tail[o3:]
+		if len(buf) > 1048576 {
+			return errListTooBig
+		}
+		b.Data = append(b.Data[:0], buf...)
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Blob object and fails if the input is not its canonical encoding
+func (b *Blob) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(b, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Blob object with the nested objects of the pool
+func (b *Blob) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 131132 {
+		return errSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'Index'
+	b.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Blob'
+	b.Blob = append(b.Blob[:0], buf[8:131080]...)
+
+	// Field (2) 'Commitment'
+	b.Commitment = append(b.Commitment[:0], buf[131080:131128]...)
+
+	// Offset (3) 'Data'
+	if o3 = ssz.ReadOffset(buf[131128:131132]); o3 > size || o3 != 131132 {
+		return errOffset
+	}
+
+	// Field (3) 'Data'
+	{
+		buf = tail[o3:]
+		if len(buf) > 1048576 {
+			return errListTooBig
+		}
+		b.Data = append(b.Data[:0], buf...)
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Blob object, the byte fields alias the input
+func (b *Blob) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 131132 {
+		return errSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'Index'
+	b.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Blob'
+	b.Blob = ssz.Alias(buf[8:131080])
+
+	// Field (2) 'Commitment'
+	b.Commitment = ssz.Alias(buf[131080:131128])
+
+	// Offset (3) 'Data'
+	if o3 = ssz.ReadOffset(buf[131128:131132]); o3 > size || o3 != 131132 {
+		return errOffset
+	}
+
+	// Field (3) 'Data'
+	{
+		buf = tail[o3:]
+		if len(buf) > 1048576 {
+			return errListTooBig
+		}
+		b.Data = ssz.Alias(buf)
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the Blob object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (b *Blob) CopySSZ() (*Blob, error) {
+	buf, err := b.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Blob)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Blob object
+func (b *Blob) SizeSSZ() (size int) {
+	size = 131132
+
+	// Field (3) 'Data'
+	size += len(b.Data)
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Blob object
+func (b *Blob) MaxSizeSSZ() uint64 {
+	return 1179708
+}
+
+// HashTreeRoot ssz hashes the Blob object
+func (b *Blob) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Blob object with a hasher
+func (b *Blob) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(b.Index)
+
+	// Field (1) 'Blob'
+	if len(b.Blob) != 131072 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b.Blob)
+
+	// Field (2) 'Commitment'
+	if len(b.Commitment) != 48 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b.Commitment)
+
+	// Field (3) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.Data))
+		if byteLen > 1048576 {
+			return ssz.ErrListTooBig
+		}
+		hh.Append(b.Data)
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1048576+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Blob object
+func (b *Blob) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Blob",
+		ssz.NewField("index", ssz.UintSchema(8)),
+		ssz.NewField("blob", ssz.ByteVectorSchema(131072)),
+		ssz.NewField("kzg_commitment", ssz.ByteVectorSchema(48)),
+		ssz.NewField("data", ssz.ByteListSchema(1048576)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Blob object
+func (b *Blob) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "index", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "blob", Type: "Bytes131072", Size: 131072, Variable: false, Limit: 0, Offset: 8, Gindex: 5},
+		{Name: "kzg_commitment", Type: "Bytes48", Size: 48, Variable: false, Limit: 0, Offset: 131080, Gindex: 6},
+		{Name: "data", Type: "ByteList[1048576]", Size: 4, Variable: true, Limit: 1048576, Offset: 131128, Gindex: 7},
+	}
+}
+
+// RandomBlob returns a random Blob object
+func RandomBlob(rng *rand.Rand) *Blob {
+	b := new(Blob)
+	// Field (0) 'Index'
+	b.Index = rng.Uint64()
+
+	// Field (1) 'Blob'
+	b.Blob = ssz.RandomBytes(rng, 131072)
+
+	// Field (2) 'Commitment'
+	b.Commitment = ssz.RandomBytes(rng, 48)
+
+	// Field (3) 'Data'
+	b.Data = ssz.RandomBytes(rng, ssz.RandomLength(rng, 1048576))
+
+	return b
+}
+
+// MarshalSSZ ssz marshals the CommitteeBits object
+func (c *CommitteeBits) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, c.SizeSSZ())
+	return c.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the CommitteeBits object to a target array
+func (c *CommitteeBits) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(20)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, c.Slot)
+
+	// Offset (1) 'Bits'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(c.Bits); ii++ {
+		offset += 4
+		offset += len(c.Bits[ii])
+	}
+
+	// Offset (2) 'Previous'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(c.Previous); ii++ {
+		offset += 4
+		offset += len(c.Previous[ii])
+	}
+
+	// Offset (3) 'Justifications'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(c.Justifications) * 1
+
+	// Field (1) 'Bits'
+	if len(c.Bits) > 64 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(c.Bits)
+		for ii := 0; ii < len(c.Bits); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += len(c.Bits[ii])
+		}
+	}
+	for ii := 0; ii < len(c.Bits); ii++ {
+		if err = ssz.ValidateBitlist(c.Bits[ii], 2048); err != nil {
+			return nil, err
+		}
+		dst = append(dst, c.Bits[ii]...)
+	}
+
+	// Field (2) 'Previous'
+	if len(c.Previous) != 4 {
+		return nil, errMarshalVector
+	}
+	{
+		offset = 4 * len(c.Previous)
+		for ii := 0; ii < len(c.Previous); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += len(c.Previous[ii])
+		}
+	}
+	for ii := 0; ii < len(c.Previous); ii++ {
+		if err = ssz.ValidateBitlist(c.Previous[ii], 2048); err != nil {
+			return nil, err
+		}
+		dst = append(dst, c.Previous[ii]...)
+	}
+
+	// Field (3) 'Justifications'
+	if len(c.Justifications) > 16 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(c.Justifications); ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, c.Justifications[ii], 1); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the CommitteeBits object to the buffers, dst is the encoding since the last referenced field
+func (c *CommitteeBits) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(20)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, c.Slot)
+
+	// Offset (1) 'Bits'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(c.Bits); ii++ {
+		offset += 4
+		offset += len(c.Bits[ii])
+	}
+
+	// Offset (2) 'Previous'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(c.Previous); ii++ {
+		offset += 4
+		offset += len(c.Previous[ii])
+	}
+
+	// Offset (3) 'Justifications'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(c.Justifications) * 1
+
+	// Field (1) 'Bits'
+	if len(c.Bits) > 64 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(c.Bits)
+		for ii := 0; ii < len(c.Bits); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += len(c.Bits[ii])
+		}
+	}
+	for ii := 0; ii < len(c.Bits); ii++ {
+		if err = ssz.ValidateBitlist(c.Bits[ii], 2048); err != nil {
+			return nil, err
+		}
+		dst = append(dst, c.Bits[ii]...)
+	}
+
+	// Field (2) 'Previous'
+	if len(c.Previous) != 4 {
+		return nil, errMarshalVector
+	}
+	{
+		offset = 4 * len(c.Previous)
+		for ii := 0; ii < len(c.Previous); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += len(c.Previous[ii])
+		}
+	}
+	for ii := 0; ii < len(c.Previous); ii++ {
+		if err = ssz.ValidateBitlist(c.Previous[ii], 2048); err != nil {
+			return nil, err
+		}
+		dst = append(dst, c.Previous[ii]...)
+	}
+
+	// Field (3) 'Justifications'
+	if len(c.Justifications) > 16 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(c.Justifications); ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, c.Justifications[ii], 1); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the CommitteeBits object
+func (c *CommitteeBits) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return errSize
+	}
+
+	tail := buf
+	var o1, o2, o3 uint64
+
+	// Field (0) 'Slot'
+	c.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Bits'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 20 {
+		return errOffset
+	}
+
+	// Offset (2) 'Previous'
+	if o2 = ssz.ReadOffset(buf[12:16]); o2 > size || o1 > o2 {
+		return errOffset
+	}
+
+	// Offset (3) 'Justifications'
+	if o3 = ssz.ReadOffset(buf[16:20]); o3 > size || o2 > o3 {
+		return errOffset
+	}
+
+	// Field (1) 'Bits'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		if cap(c.Bits) >= num {
+			c.Bits = c.Bits[:0]
+		} else {
+			c.Bits = make([]bitfield.Bitlist, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(c.Bits) < cap(c.Bits) {
+				c.Bits = c.Bits[:len(c.Bits)+1]
+			} else {
+				c.Bits = append(c.Bits, nil)
+			}
+			if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+				return err
+			}
+			c.Bits[indx] = append(c.Bits[indx][:0], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Previous'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return err
+		}
+		if num != 4 {
+			return errOffset
+		}
+		if cap(c.Previous) >= num {
+			c.Previous = c.Previous[:0]
+		} else {
+			c.Previous = make([]bitfield.Bitlist, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(c.Previous) < cap(c.Previous) {
+				c.Previous = c.Previous[:len(c.Previous)+1]
+			} else {
+				c.Previous = append(c.Previous, nil)
+			}
+			if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+				return err
+			}
+			c.Previous[indx] = append(c.Previous[indx][:0], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'Justifications'
+	{
+		buf = tail[o3:]
+		num, ok := ssz.DivideInt(len(buf), 1)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(c.Justifications) >= num {
+			c.Justifications = c.Justifications[:num]
+		} else {
+			c.Justifications = make([]bitfield.Bitvector4, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if err = ssz.ValidateBitvector(buf[ii*1:(ii+1)*1], 4); err != nil {
+				return err
+			}
+			c.Justifications[ii] = append(c.Justifications[ii][:0], buf[ii*1:(ii+1)*1]...)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the CommitteeBits object and fails if the input is not its canonical encoding
+func (c *CommitteeBits) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(c, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the CommitteeBits object with the nested objects of the pool
+func (c *CommitteeBits) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return errSize
+	}
+
+	tail := buf
+	var o1, o2, o3 uint64
+
+	// Field (0) 'Slot'
+	c.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Bits'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 20 {
+		return errOffset
+	}
+
+	// Offset (2) 'Previous'
+	if o2 = ssz.ReadOffset(buf[12:16]); o2 > size || o1 > o2 {
+		return errOffset
+	}
+
+	// Offset (3) 'Justifications'
+	if o3 = ssz.ReadOffset(buf[16:20]); o3 > size || o2 > o3 {
+		return errOffset
+	}
+
+	// Field (1) 'Bits'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		if cap(c.Bits) >= num {
+			c.Bits = c.Bits[:0]
+		} else {
+			c.Bits = make([]bitfield.Bitlist, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(c.Bits) < cap(c.Bits) {
+				c.Bits = c.Bits[:len(c.Bits)+1]
+			} else {
+				c.Bits = append(c.Bits, nil)
+			}
+			if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+				return err
+			}
+			c.Bits[indx] = append(c.Bits[indx][:0], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Previous'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return err
+		}
+		if num != 4 {
+			return errOffset
+		}
+		if cap(c.Previous) >= num {
+			c.Previous = c.Previous[:0]
+		} else {
+			c.Previous = make([]bitfield.Bitlist, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(c.Previous) < cap(c.Previous) {
+				c.Previous = c.Previous[:len(c.Previous)+1]
+			} else {
+				c.Previous = append(c.Previous, nil)
+			}
+			if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+				return err
+			}
+			c.Previous[indx] = append(c.Previous[indx][:0], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'Justifications'
+	{
+		buf = tail[o3:]
+		num, ok := ssz.DivideInt(len(buf), 1)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(c.Justifications) >= num {
+			c.Justifications = c.Justifications[:num]
+		} else {
+			c.Justifications = make([]bitfield.Bitvector4, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if err = ssz.ValidateBitvector(buf[ii*1:(ii+1)*1], 4); err != nil {
+				return err
+			}
+			c.Justifications[ii] = append(c.Justifications[ii][:0], buf[ii*1:(ii+1)*1]...)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the CommitteeBits object, the byte fields alias the input
+func (c *CommitteeBits) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return errSize
+	}
+
+	tail := buf
+	var o1, o2, o3 uint64
+
+	// Field (0) 'Slot'
+	c.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Bits'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 20 {
+		return errOffset
+	}
+
+	// Offset (2) 'Previous'
+	if o2 = ssz.ReadOffset(buf[12:16]); o2 > size || o1 > o2 {
+		return errOffset
+	}
+
+	// Offset (3) 'Justifications'
+	if o3 = ssz.ReadOffset(buf[16:20]); o3 > size || o2 > o3 {
+		return errOffset
+	}
+
+	// Field (1) 'Bits'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		if cap(c.Bits) >= num {
+			c.Bits = c.Bits[:0]
+		} else {
+			c.Bits = make([]bitfield.Bitlist, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(c.Bits) < cap(c.Bits) {
+				c.Bits = c.Bits[:len(c.Bits)+1]
+			} else {
+				c.Bits = append(c.Bits, nil)
+			}
+			if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+				return err
+			}
+			c.Bits[indx] = ssz.Alias(buf)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Previous'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return err
+		}
+		if num != 4 {
+			return errOffset
+		}
+		if cap(c.Previous) >= num {
+			c.Previous = c.Previous[:0]
+		} else {
+			c.Previous = make([]bitfield.Bitlist, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(c.Previous) < cap(c.Previous) {
+				c.Previous = c.Previous[:len(c.Previous)+1]
+			} else {
+				c.Previous = append(c.Previous, nil)
+			}
+			if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+				return err
+			}
+			c.Previous[indx] = ssz.Alias(buf)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'Justifications'
+	{
+		buf = tail[o3:]
+		num, ok := ssz.DivideInt(len(buf), 1)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 16 {
+			return errListTooBig
+		}
+		if cap(c.Justifications) >= num {
+			c.Justifications = c.Justifications[:num]
+		} else {
+			c.Justifications = make([]bitfield.Bitvector4, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			if err = ssz.ValidateBitvector(buf[ii*1:(ii+1)*1], 4); err != nil {
+				return err
+			}
+			c.Justifications[ii] = ssz.Alias(buf[ii*1 : (ii+1)*1])
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the CommitteeBits object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (c *CommitteeBits) CopySSZ() (*CommitteeBits, error) {
+	buf, err := c.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(CommitteeBits)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CommitteeBits object
+func (c *CommitteeBits) SizeSSZ() (size int) {
+	size = 20
+
+	// Field (1) 'Bits'
+	for ii := 0; ii < len(c.Bits); ii++ {
+		size += 4
+		size += len(c.Bits[ii])
+	}
+
+	// Field (2) 'Previous'
+	for ii := 0; ii < len(c.Previous); ii++ {
+		size += 4
+		size += len(c.Previous[ii])
+	}
+
+	// Field (3) 'Justifications'
+	size += len(c.Justifications) * 1
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the CommitteeBits object
+func (c *CommitteeBits) MaxSizeSSZ() uint64 {
+	return 17784
+}
+
+// HashTreeRoot ssz hashes the CommitteeBits object
+func (c *CommitteeBits) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CommitteeBits object with a hasher
+func (c *CommitteeBits) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(c.Slot)
+
+	// Field (1) 'Bits'
+	{
+		if len(c.Bits) > 64 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(c.Bits); ii++ {
+			if err = ssz.ValidateBitlist(c.Bits[ii], 2048); err != nil {
+				return
+			}
+			hh.PutBitlist(c.Bits[ii], 2048)
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(c.Bits)), 64)
+	}
+
+	// Field (2) 'Previous'
+	{
+		if len(c.Previous) != 4 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(c.Previous); ii++ {
+			if err = ssz.ValidateBitlist(c.Previous[ii], 2048); err != nil {
+				return
+			}
+			hh.PutBitlist(c.Previous[ii], 2048)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'Justifications'
+	{
+		if len(c.Justifications) > 16 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(c.Justifications); ii++ {
+			if len(c.Justifications[ii]) != 1 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(c.Justifications[ii])
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(c.Justifications)), 16)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the CommitteeBits object
+func (c *CommitteeBits) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("CommitteeBits",
+		ssz.NewField("slot", ssz.UintSchema(8)),
+		ssz.NewField("bits", ssz.ListSchema(ssz.BitlistSchema(2048), 64)),
+		ssz.NewField("previous", ssz.VectorSchema(ssz.BitlistSchema(2048), 4)),
+		ssz.NewField("justifications", ssz.ListSchema(ssz.BitvectorSchema(4), 16)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the CommitteeBits object
+func (c *CommitteeBits) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "slot", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "bits", Type: "List[Bitlist[2048], 64]", Size: 4, Variable: true, Limit: 64, Offset: 8, Gindex: 5},
+		{Name: "previous", Type: "Vector[Bitlist[2048], 4]", Size: 4, Variable: true, Limit: 0, Offset: 12, Gindex: 6},
+		{Name: "justifications", Type: "List[Bitvector[4], 16]", Size: 4, Variable: true, Limit: 16, Offset: 16, Gindex: 7},
+	}
+}
+
+// RandomCommitteeBits returns a random CommitteeBits object
+func RandomCommitteeBits(rng *rand.Rand) *CommitteeBits {
+	c := new(CommitteeBits)
+	// Field (0) 'Slot'
+	c.Slot = rng.Uint64()
+
+	// Field (1) 'Bits'
+	{
+		num := ssz.RandomLength(rng, 64)
+		if cap(c.Bits) >= num {
+			c.Bits = c.Bits[:num]
+		} else {
+			c.Bits = make([]bitfield.Bitlist, num)
+		}
+		for ii := 0; ii < len(c.Bits); ii++ {
+			c.Bits[ii] = ssz.RandomBitlist(rng, 2048)
+		}
+	}
+
+	// Field (2) 'Previous'
+	{
+		if cap(c.Previous) >= 4 {
+			c.Previous = c.Previous[:4]
+		} else {
+			c.Previous = make([]bitfield.Bitlist, 4)
+		}
+		for ii := 0; ii < len(c.Previous); ii++ {
+			c.Previous[ii] = ssz.RandomBitlist(rng, 2048)
+		}
+	}
+
+	// Field (3) 'Justifications'
+	{
+		num := ssz.RandomLength(rng, 16)
+		if cap(c.Justifications) >= num {
+			c.Justifications = c.Justifications[:num]
+		} else {
+			c.Justifications = make([]bitfield.Bitvector4, num)
+		}
+		for ii := 0; ii < len(c.Justifications); ii++ {
+			c.Justifications[ii] = ssz.RandomBitvector(rng, 4)
+		}
+	}
+
+	return c
+}
+
+// MarshalSSZ ssz marshals the OptionalBytes object
+func (o *OptionalBytes) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, o.SizeSSZ())
+	return o.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the OptionalBytes object to a target array
+func (o *OptionalBytes) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(36)
+
+	// Field (0) 'Root'
+	if dst, err = ssz.MarshalFixedBytes(dst, ssz.DerefBytes(o.Root, 32), 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Offset (1) 'Extra'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(ssz.DerefBytes(o.Extra, 0))
+
+	// Field (1) 'Extra'
+	if len(ssz.DerefBytes(o.Extra, 0)) > 256 {
+		return nil, errMarshalDynamicBytes
+	}
+	dst = append(dst, ssz.DerefBytes(o.Extra, 0)...)
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the OptionalBytes object to the buffers, dst is the encoding since the last referenced field
+func (o *OptionalBytes) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(36)
+
+	// Field (0) 'Root'
+	if len(ssz.DerefBytes(o.Root, 32)) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, ssz.DerefBytes(o.Root, 32))
+
+	// Offset (1) 'Extra'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(ssz.DerefBytes(o.Extra, 0))
+
+	// Field (1) 'Extra'
+	if len(ssz.DerefBytes(o.Extra, 0)) > 256 {
+		return nil, errMarshalDynamicBytes
+	}
+	dst = bufs.Append(dst, ssz.DerefBytes(o.Extra, 0))
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the OptionalBytes object
+func (o *OptionalBytes) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 36 {
+		return errSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Root'
+	if o.Root == nil {
+		o.Root = new([]byte)
+	}
+	*o.Root = append((*o.Root)[:0], buf[0:32]...)
+
+	// Offset (1) 'Extra'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size || o1 != 36 {
+		return errOffset
+	}
+
+	// Field (1) 'Extra'
+	{
+		buf = tail[o1:]
+		if len(buf) > 256 {
+			return errListTooBig
+		}
+		if o.Extra == nil {
+			o.Extra = new([]byte)
+		}
+		*o.Extra = append((*o.Extra)[:0], buf...)
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the OptionalBytes object and fails if the input is not its canonical encoding
+func (o *OptionalBytes) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(o, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the OptionalBytes object with the nested objects of the pool
+func (o *OptionalBytes) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 36 {
+		return errSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Root'
+	if o.Root == nil {
+		o.Root = new([]byte)
+	}
+	*o.Root = append((*o.Root)[:0], buf[0:32]...)
+
+	// Offset (1) 'Extra'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size || o1 != 36 {
+		return errOffset
+	}
+
+	// Field (1) 'Extra'
+	{
+		buf = tail[o1:]
+		if len(buf) > 256 {
+			return errListTooBig
+		}
+		if o.Extra == nil {
+			o.Extra = new([]byte)
+		}
+		*o.Extra = append((*o.Extra)[:0], buf...)
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the OptionalBytes object, the byte fields alias the input
+func (o *OptionalBytes) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 36 {
+		return errSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Root'
+	if o.Root == nil {
+		o.Root = new([]byte)
+	}
+	*o.Root = ssz.Alias(buf[0:32])
+
+	// Offset (1) 'Extra'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size || o1 != 36 {
+		return errOffset
+	}
+
+	// Field (1) 'Extra'
+	{
+		buf = tail[o1:]
+		if len(buf) > 256 {
+			return errListTooBig
+		}
+		if o.Extra == nil {
+			o.Extra = new([]byte)
+		}
+		*o.Extra = ssz.Alias(buf)
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the OptionalBytes object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (o *OptionalBytes) CopySSZ() (*OptionalBytes, error) {
+	buf, err := o.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(OptionalBytes)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the OptionalBytes object
+func (o *OptionalBytes) SizeSSZ() (size int) {
+	size = 36
+
+	// Field (1) 'Extra'
+	size += len(ssz.DerefBytes(o.Extra, 0))
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the OptionalBytes object
+func (o *OptionalBytes) MaxSizeSSZ() uint64 {
+	return 292
+}
+
+// HashTreeRoot ssz hashes the OptionalBytes object
+func (o *OptionalBytes) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+// HashTreeRootWith ssz hashes the OptionalBytes object with a hasher
+func (o *OptionalBytes) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Root'
+	if len(ssz.DerefBytes(o.Root, 32)) != 32 {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(ssz.DerefBytes(o.Root, 32))
+
+	// Field (1) 'Extra'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(ssz.DerefBytes(o.Extra, 0)))
+		if byteLen > 256 {
+			return ssz.ErrListTooBig
+		}
+		hh.Append(ssz.DerefBytes(o.Extra, 0))
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the OptionalBytes object
+func (o *OptionalBytes) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("OptionalBytes",
+		ssz.NewField("root", ssz.ByteVectorSchema(32)),
+		ssz.NewField("extra", ssz.ByteListSchema(256)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the OptionalBytes object
+func (o *OptionalBytes) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "extra", Type: "ByteList[256]", Size: 4, Variable: true, Limit: 256, Offset: 32, Gindex: 3},
+	}
+}
+
+// RandomOptionalBytes returns a random OptionalBytes object
+func RandomOptionalBytes(rng *rand.Rand) *OptionalBytes {
+	o := new(OptionalBytes)
+	// Field (0) 'Root'
+	o.Root = new([]byte)
+	*o.Root = ssz.RandomBytes(rng, 32)
+
+	// Field (1) 'Extra'
+	o.Extra = new([]byte)
+	*o.Extra = ssz.RandomBytes(rng, ssz.RandomLength(rng, 256))
+
+	return o
+}
+
+// MarshalSSZ ssz marshals the SlotRoots object
+func (s *SlotRoots) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, s.SizeSSZ())
+	return s.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the SlotRoots object to a target array
+func (s *SlotRoots) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(20)
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, s.Epoch)
+
+	// Offset (1) 'PerSlot'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(s.PerSlot); ii++ {
+		offset += 4
+		offset += len(s.PerSlot[ii]) * 32
+	}
+
+	// Offset (2) 'Blobs'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(s.Blobs); ii++ {
+		offset += 4
+		for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+			offset += 4
+			offset += len(s.Blobs[ii][jj])
+		}
+	}
+
+	// Offset (3) 'Parents'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(s.Parents) * 32
+
+	// Field (1) 'PerSlot'
+	if len(s.PerSlot) > 32 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(s.PerSlot)
+		for ii := 0; ii < len(s.PerSlot); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += len(s.PerSlot[ii]) * 32
+		}
+	}
+	for ii := 0; ii < len(s.PerSlot); ii++ {
+		if len(s.PerSlot[ii]) > 16 {
+			return nil, errMarshalList
+		}
+		for jj := 0; jj < len(s.PerSlot[ii]); jj++ {
+			if dst, err = ssz.MarshalFixedBytes(dst, s.PerSlot[ii][jj], 32); err != nil {
+				return nil, errMarshalFixedBytes
+			}
+		}
+	}
+
+	// Field (2) 'Blobs'
+	if len(s.Blobs) != 2 {
+		return nil, errMarshalVector
+	}
+	{
+		offset = 4 * len(s.Blobs)
+		for ii := 0; ii < len(s.Blobs); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+				offset += 4
+				offset += len(s.Blobs[ii][jj])
+			}
+		}
+	}
+	for ii := 0; ii < len(s.Blobs); ii++ {
+		if len(s.Blobs[ii]) > 4 {
+			return nil, errMarshalList
+		}
+		{
+			offset = 4 * len(s.Blobs[ii])
+			for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+				if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+					return nil, err
+				}
+				offset += len(s.Blobs[ii][jj])
+			}
+		}
+		for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+			if len(s.Blobs[ii][jj]) > 64 {
+				return nil, errMarshalDynamicBytes
+			}
+			dst = append(dst, s.Blobs[ii][jj]...)
+		}
+	}
+
+	// Field (3) 'Parents'
+	if len(s.Parents) > 1024 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(s.Parents); ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, s.Parents[ii], 32); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the SlotRoots object to the buffers, dst is the encoding since the last referenced field
+func (s *SlotRoots) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(20)
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, s.Epoch)
+
+	// Offset (1) 'PerSlot'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(s.PerSlot); ii++ {
+		offset += 4
+		offset += len(s.PerSlot[ii]) * 32
+	}
+
+	// Offset (2) 'Blobs'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(s.Blobs); ii++ {
+		offset += 4
+		for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+			offset += 4
+			offset += len(s.Blobs[ii][jj])
+		}
+	}
+
+	// Offset (3) 'Parents'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(s.Parents) * 32
+
+	// Field (1) 'PerSlot'
+	if len(s.PerSlot) > 32 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(s.PerSlot)
+		for ii := 0; ii < len(s.PerSlot); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += len(s.PerSlot[ii]) * 32
+		}
+	}
+	for ii := 0; ii < len(s.PerSlot); ii++ {
+		if len(s.PerSlot[ii]) > 16 {
+			return nil, errMarshalList
+		}
+		for jj := 0; jj < len(s.PerSlot[ii]); jj++ {
+			if len(s.PerSlot[ii][jj]) != 32 {
+				return nil, errMarshalFixedBytes
+			}
+			dst = bufs.Append(dst, s.PerSlot[ii][jj])
+		}
+	}
+
+	// Field (2) 'Blobs'
+	if len(s.Blobs) != 2 {
+		return nil, errMarshalVector
+	}
+	{
+		offset = 4 * len(s.Blobs)
+		for ii := 0; ii < len(s.Blobs); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+				offset += 4
+				offset += len(s.Blobs[ii][jj])
+			}
+		}
+	}
+	for ii := 0; ii < len(s.Blobs); ii++ {
+		if len(s.Blobs[ii]) > 4 {
+			return nil, errMarshalList
+		}
+		{
+			offset = 4 * len(s.Blobs[ii])
+			for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+				if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+					return nil, err
+				}
+				offset += len(s.Blobs[ii][jj])
+			}
+		}
+		for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+			if len(s.Blobs[ii][jj]) > 64 {
+				return nil, errMarshalDynamicBytes
+			}
+			dst = bufs.Append(dst, s.Blobs[ii][jj])
+		}
+	}
+
+	// Field (3) 'Parents'
+	if len(s.Parents) > 1024 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(s.Parents); ii++ {
+		if len(s.Parents[ii]) != 32 {
+			return nil, errMarshalFixedBytes
+		}
+		dst = bufs.Append(dst, s.Parents[ii])
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the SlotRoots object
+func (s *SlotRoots) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return errSize
+	}
+
+	tail := buf
+	var o1, o2, o3 uint64
+
+	// Field (0) 'Epoch'
+	s.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'PerSlot'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 20 {
+		return errOffset
+	}
+
+	// Offset (2) 'Blobs'
+	if o2 = ssz.ReadOffset(buf[12:16]); o2 > size || o1 > o2 {
+		return errOffset
+	}
+
+	// Offset (3) 'Parents'
+	if o3 = ssz.ReadOffset(buf[16:20]); o3 > size || o2 > o3 {
+		return errOffset
+	}
+
+	// Field (1) 'PerSlot'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 32)
+		if err != nil {
+			return err
+		}
+		if cap(s.PerSlot) >= num {
+			s.PerSlot = s.PerSlot[:0]
+		} else {
+			s.PerSlot = make([][][]byte, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(s.PerSlot) < cap(s.PerSlot) {
+				s.PerSlot = s.PerSlot[:len(s.PerSlot)+1]
+			} else {
+				s.PerSlot = append(s.PerSlot, nil)
+			}
+			num, ok := ssz.DivideInt(len(buf), 32)
+			if !ok {
+				return errDivideInt
+			}
+			if num > 16 {
+				return errListTooBig
+			}
+			if cap(s.PerSlot[indx]) >= num {
+				s.PerSlot[indx] = s.PerSlot[indx][:num]
+			} else {
+				s.PerSlot[indx] = make([][]byte, num)
+			}
+			for jj := 0; jj < num; jj++ {
+				s.PerSlot[indx][jj] = append(s.PerSlot[indx][jj][:0], buf[jj*32:(jj+1)*32]...)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Blobs'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		if num != 2 {
+			return errOffset
+		}
+		if cap(s.Blobs) >= num {
+			s.Blobs = s.Blobs[:0]
+		} else {
+			s.Blobs = make([][][]byte, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(s.Blobs) < cap(s.Blobs) {
+				s.Blobs = s.Blobs[:len(s.Blobs)+1]
+			} else {
+				s.Blobs = append(s.Blobs, nil)
+			}
+			num, err := ssz.DecodeDynamicLength(buf, 4)
+			if err != nil {
+				return err
+			}
+			if cap(s.Blobs[indx]) >= num {
+				s.Blobs[indx] = s.Blobs[indx][:0]
+			} else {
+				s.Blobs[indx] = make([][]byte, 0, ssz.ListCapacity(num))
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(jj int, buf []byte) (err error) {
+				if len(s.Blobs[indx]) < cap(s.Blobs[indx]) {
+					s.Blobs[indx] = s.Blobs[indx][:len(s.Blobs[indx])+1]
+				} else {
+					s.Blobs[indx] = append(s.Blobs[indx], nil)
+				}
+				if len(buf) > 64 {
+					return errListTooBig
+				}
+				s.Blobs[indx][jj] = append(s.Blobs[indx][jj][:0], buf...)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'Parents'
+	{
+		buf = tail[o3:]
+		num, ok := ssz.DivideInt(len(buf), 32)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 1024 {
+			return errListTooBig
+		}
+		if cap(s.Parents) >= num {
+			s.Parents = s.Parents[:num]
+		} else {
+			s.Parents = make([][]byte, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			s.Parents[ii] = append(s.Parents[ii][:0], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the SlotRoots object and fails if the input is not its canonical encoding
+func (s *SlotRoots) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(s, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the SlotRoots object with the nested objects of the pool
+func (s *SlotRoots) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return errSize
+	}
+
+	tail := buf
+	var o1, o2, o3 uint64
+
+	// Field (0) 'Epoch'
+	s.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'PerSlot'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 20 {
+		return errOffset
+	}
+
+	// Offset (2) 'Blobs'
+	if o2 = ssz.ReadOffset(buf[12:16]); o2 > size || o1 > o2 {
+		return errOffset
+	}
+
+	// Offset (3) 'Parents'
+	if o3 = ssz.ReadOffset(buf[16:20]); o3 > size || o2 > o3 {
+		return errOffset
+	}
+
+	// Field (1) 'PerSlot'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 32)
+		if err != nil {
+			return err
+		}
+		if cap(s.PerSlot) >= num {
+			s.PerSlot = s.PerSlot[:0]
+		} else {
+			s.PerSlot = make([][][]byte, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(s.PerSlot) < cap(s.PerSlot) {
+				s.PerSlot = s.PerSlot[:len(s.PerSlot)+1]
+			} else {
+				s.PerSlot = append(s.PerSlot, nil)
+			}
+			num, ok := ssz.DivideInt(len(buf), 32)
+			if !ok {
+				return errDivideInt
+			}
+			if num > 16 {
+				return errListTooBig
+			}
+			if cap(s.PerSlot[indx]) >= num {
+				s.PerSlot[indx] = s.PerSlot[indx][:num]
+			} else {
+				s.PerSlot[indx] = make([][]byte, num)
+			}
+			for jj := 0; jj < num; jj++ {
+				s.PerSlot[indx][jj] = append(s.PerSlot[indx][jj][:0], buf[jj*32:(jj+1)*32]...)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Blobs'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		if num != 2 {
+			return errOffset
+		}
+		if cap(s.Blobs) >= num {
+			s.Blobs = s.Blobs[:0]
+		} else {
+			s.Blobs = make([][][]byte, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(s.Blobs) < cap(s.Blobs) {
+				s.Blobs = s.Blobs[:len(s.Blobs)+1]
+			} else {
+				s.Blobs = append(s.Blobs, nil)
+			}
+			num, err := ssz.DecodeDynamicLength(buf, 4)
+			if err != nil {
+				return err
+			}
+			if cap(s.Blobs[indx]) >= num {
+				s.Blobs[indx] = s.Blobs[indx][:0]
+			} else {
+				s.Blobs[indx] = make([][]byte, 0, ssz.ListCapacity(num))
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(jj int, buf []byte) (err error) {
+				if len(s.Blobs[indx]) < cap(s.Blobs[indx]) {
+					s.Blobs[indx] = s.Blobs[indx][:len(s.Blobs[indx])+1]
+				} else {
+					s.Blobs[indx] = append(s.Blobs[indx], nil)
+				}
+				if len(buf) > 64 {
+					return errListTooBig
+				}
+				s.Blobs[indx][jj] = append(s.Blobs[indx][jj][:0], buf...)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'Parents'
+	{
+		buf = tail[o3:]
+		num, ok := ssz.DivideInt(len(buf), 32)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 1024 {
+			return errListTooBig
+		}
+		if cap(s.Parents) >= num {
+			s.Parents = s.Parents[:num]
+		} else {
+			s.Parents = make([][]byte, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			s.Parents[ii] = append(s.Parents[ii][:0], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the SlotRoots object, the byte fields alias the input
+func (s *SlotRoots) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return errSize
+	}
+
+	tail := buf
+	var o1, o2, o3 uint64
+
+	// Field (0) 'Epoch'
+	s.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'PerSlot'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 20 {
+		return errOffset
+	}
+
+	// Offset (2) 'Blobs'
+	if o2 = ssz.ReadOffset(buf[12:16]); o2 > size || o1 > o2 {
+		return errOffset
+	}
+
+	// Offset (3) 'Parents'
+	if o3 = ssz.ReadOffset(buf[16:20]); o3 > size || o2 > o3 {
+		return errOffset
+	}
+
+	// Field (1) 'PerSlot'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 32)
+		if err != nil {
+			return err
+		}
+		if cap(s.PerSlot) >= num {
+			s.PerSlot = s.PerSlot[:0]
+		} else {
+			s.PerSlot = make([][][]byte, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(s.PerSlot) < cap(s.PerSlot) {
+				s.PerSlot = s.PerSlot[:len(s.PerSlot)+1]
+			} else {
+				s.PerSlot = append(s.PerSlot, nil)
+			}
+			num, ok := ssz.DivideInt(len(buf), 32)
+			if !ok {
+				return errDivideInt
+			}
+			if num > 16 {
+				return errListTooBig
+			}
+			if cap(s.PerSlot[indx]) >= num {
+				s.PerSlot[indx] = s.PerSlot[indx][:num]
+			} else {
+				s.PerSlot[indx] = make([][]byte, num)
+			}
+			for jj := 0; jj < num; jj++ {
+				s.PerSlot[indx][jj] = ssz.Alias(buf[jj*32 : (jj+1)*32])
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Blobs'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		if num != 2 {
+			return errOffset
+		}
+		if cap(s.Blobs) >= num {
+			s.Blobs = s.Blobs[:0]
+		} else {
+			s.Blobs = make([][][]byte, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(s.Blobs) < cap(s.Blobs) {
+				s.Blobs = s.Blobs[:len(s.Blobs)+1]
+			} else {
+				s.Blobs = append(s.Blobs, nil)
+			}
+			num, err := ssz.DecodeDynamicLength(buf, 4)
+			if err != nil {
+				return err
+			}
+			if cap(s.Blobs[indx]) >= num {
+				s.Blobs[indx] = s.Blobs[indx][:0]
+			} else {
+				s.Blobs[indx] = make([][]byte, 0, ssz.ListCapacity(num))
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(jj int, buf []byte) (err error) {
+				if len(s.Blobs[indx]) < cap(s.Blobs[indx]) {
+					s.Blobs[indx] = s.Blobs[indx][:len(s.Blobs[indx])+1]
+				} else {
+					s.Blobs[indx] = append(s.Blobs[indx], nil)
+				}
+				if len(buf) > 64 {
+					return errListTooBig
+				}
+				s.Blobs[indx][jj] = ssz.Alias(buf)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'Parents'
+	{
+		buf = tail[o3:]
+		num, ok := ssz.DivideInt(len(buf), 32)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 1024 {
+			return errListTooBig
+		}
+		if cap(s.Parents) >= num {
+			s.Parents = s.Parents[:num]
+		} else {
+			s.Parents = make([][]byte, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			s.Parents[ii] = ssz.Alias(buf[ii*32 : (ii+1)*32])
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the SlotRoots object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (s *SlotRoots) CopySSZ() (*SlotRoots, error) {
+	buf, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(SlotRoots)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SlotRoots object
+func (s *SlotRoots) SizeSSZ() (size int) {
+	size = 20
+
+	// Field (1) 'PerSlot'
+	for ii := 0; ii < len(s.PerSlot); ii++ {
+		size += 4
+		size += len(s.PerSlot[ii]) * 32
+	}
+
+	// Field (2) 'Blobs'
+	for ii := 0; ii < len(s.Blobs); ii++ {
+		size += 4
+		for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+			size += 4
+			size += len(s.Blobs[ii][jj])
+		}
+	}
+
+	// Field (3) 'Parents'
+	size += len(s.Parents) * 32
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the SlotRoots object
+func (s *SlotRoots) MaxSizeSSZ() uint64 {
+	return 49852
+}
+
+// HashTreeRoot ssz hashes the SlotRoots object
+func (s *SlotRoots) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SlotRoots object with a hasher
+func (s *SlotRoots) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(s.Epoch)
+
+	// Field (1) 'PerSlot'
+	{
+		if len(s.PerSlot) > 32 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(s.PerSlot); ii++ {
+			{
+				if len(s.PerSlot[ii]) > 16 {
+					return ssz.ErrListTooBig
+				}
+				subIndx := hh.Index()
+				for jj := 0; jj < len(s.PerSlot[ii]); jj++ {
+					if len(s.PerSlot[ii][jj]) != 32 {
+						return ssz.ErrBytesLength
+					}
+					hh.PutBytes(s.PerSlot[ii][jj])
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(len(s.PerSlot[ii])), 16)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(s.PerSlot)), 32)
+	}
+
+	// Field (2) 'Blobs'
+	{
+		if len(s.Blobs) != 2 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(s.Blobs); ii++ {
+			{
+				if len(s.Blobs[ii]) > 4 {
+					return ssz.ErrListTooBig
+				}
+				subIndx := hh.Index()
+				for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+					{
+						elemIndx := hh.Index()
+						byteLen := uint64(len(s.Blobs[ii][jj]))
+						if byteLen > 64 {
+							return ssz.ErrListTooBig
+						}
+						hh.Append(s.Blobs[ii][jj])
+						hh.FillUpTo32()
+						hh.MerkleizeWithMixin(elemIndx, byteLen, (64+31)/32)
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(len(s.Blobs[ii])), 4)
+			}
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'Parents'
+	{
+		if len(s.Parents) > 1024 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(s.Parents); ii++ {
+			if len(s.Parents[ii]) != 32 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(s.Parents[ii])
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(s.Parents)), 1024)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the SlotRoots object
+func (s *SlotRoots) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("SlotRoots",
+		ssz.NewField("epoch", ssz.UintSchema(8)),
+		ssz.NewField("per_slot", ssz.ListSchema(ssz.ListSchema(ssz.ByteVectorSchema(32), 16), 32)),
+		ssz.NewField("blobs", ssz.VectorSchema(ssz.ListSchema(ssz.ByteListSchema(64), 4), 2)),
+		ssz.NewField("parents", ssz.ListSchema(ssz.ByteVectorSchema(32), 1024)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the SlotRoots object
+func (s *SlotRoots) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "epoch", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "per_slot", Type: "List[List[Bytes32, 16], 32]", Size: 4, Variable: true, Limit: 32, Offset: 8, Gindex: 5},
+		{Name: "blobs", Type: "Vector[List[ByteList[64], 4], 2]", Size: 4, Variable: true, Limit: 0, Offset: 12, Gindex: 6},
+		{Name: "parents", Type: "List[Bytes32, 1024]", Size: 4, Variable: true, Limit: 1024, Offset: 16, Gindex: 7},
+	}
+}
+
+// RandomSlotRoots returns a random SlotRoots object
+func RandomSlotRoots(rng *rand.Rand) *SlotRoots {
+	s := new(SlotRoots)
+	// Field (0) 'Epoch'
+	s.Epoch = rng.Uint64()
+
+	// Field (1) 'PerSlot'
+	{
+		num := ssz.RandomLength(rng, 32)
+		if cap(s.PerSlot) >= num {
+			s.PerSlot = s.PerSlot[:num]
+		} else {
+			s.PerSlot = make([][][]byte, num)
+		}
+		for ii := 0; ii < len(s.PerSlot); ii++ {
+			{
+				num := ssz.RandomLength(rng, 16)
+				if cap(s.PerSlot[ii]) >= num {
+					s.PerSlot[ii] = s.PerSlot[ii][:num]
+				} else {
+					s.PerSlot[ii] = make([][]byte, num)
+				}
+				for jj := 0; jj < len(s.PerSlot[ii]); jj++ {
+					s.PerSlot[ii][jj] = ssz.RandomBytes(rng, 32)
+				}
+			}
+		}
+	}
+
+	// Field (2) 'Blobs'
+	{
+		if cap(s.Blobs) >= 2 {
+			s.Blobs = s.Blobs[:2]
+		} else {
+			s.Blobs = make([][][]byte, 2)
+		}
+		for ii := 0; ii < len(s.Blobs); ii++ {
+			{
+				num := ssz.RandomLength(rng, 4)
+				if cap(s.Blobs[ii]) >= num {
+					s.Blobs[ii] = s.Blobs[ii][:num]
+				} else {
+					s.Blobs[ii] = make([][]byte, num)
+				}
+				for jj := 0; jj < len(s.Blobs[ii]); jj++ {
+					s.Blobs[ii][jj] = ssz.RandomBytes(rng, ssz.RandomLength(rng, 64))
+				}
+			}
+		}
+	}
+
+	// Field (3) 'Parents'
+	{
+		num := ssz.RandomLength(rng, 1024)
+		if cap(s.Parents) >= num {
+			s.Parents = s.Parents[:num]
+		} else {
+			s.Parents = make([][]byte, num)
+		}
+		for ii := 0; ii < len(s.Parents); ii++ {
+			s.Parents[ii] = ssz.RandomBytes(rng, 32)
+		}
+	}
+
+	return s
+}
+
+// MarshalSSZ ssz marshals the Transactions object
+func (t *Transactions) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, t.SizeSSZ())
+	return t.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Transactions object to a target array
+func (t *Transactions) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(12)
+
+	// Field (0) 'BlockNumber'
+	dst = ssz.MarshalUint64(dst, t.BlockNumber)
+
+	// Offset (1) 'Transactions'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(t.Transactions); ii++ {
+		offset += 4
+		offset += len(t.Transactions[ii])
+	}
+
+	// Field (1) 'Transactions'
+	if len(t.Transactions) > 1048576 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(t.Transactions)
+		for ii := 0; ii < len(t.Transactions); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += len(t.Transactions[ii])
+		}
+	}
+	for ii := 0; ii < len(t.Transactions); ii++ {
+		if len(t.Transactions[ii]) > 1073741824 {
+			return nil, errMarshalDynamicBytes
+		}
+		dst = append(dst, t.Transactions[ii]...)
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Transactions object to the buffers, dst is the encoding since the last referenced field
+func (t *Transactions) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(12)
+
+	// Field (0) 'BlockNumber'
+	dst = ssz.MarshalUint64(dst, t.BlockNumber)
+
+	// Offset (1) 'Transactions'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(t.Transactions); ii++ {
+		offset += 4
+		offset += len(t.Transactions[ii])
+	}
+
+	// Field (1) 'Transactions'
+	if len(t.Transactions) > 1048576 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(t.Transactions)
+		for ii := 0; ii < len(t.Transactions); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += len(t.Transactions[ii])
+		}
+	}
+	for ii := 0; ii < len(t.Transactions); ii++ {
+		if len(t.Transactions[ii]) > 1073741824 {
+			return nil, errMarshalDynamicBytes
+		}
+		dst = bufs.Append(dst, t.Transactions[ii])
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Transactions object
+func (t *Transactions) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return errSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'BlockNumber'
+	t.BlockNumber = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Transactions'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
+		return errOffset
+	}
+
+	// Field (1) 'Transactions'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 1048576)
+		if err != nil {
+			return err
+		}
+		if cap(t.Transactions) >= num {
+			t.Transactions = t.Transactions[:0]
+		} else {
+			t.Transactions = make([][]byte, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(t.Transactions) < cap(t.Transactions) {
+				t.Transactions = t.Transactions[:len(t.Transactions)+1]
+			} else {
+				t.Transactions = append(t.Transactions, nil)
+			}
+			if len(buf) > 1073741824 {
+				return errListTooBig
+			}
+			t.Transactions[indx] = append(t.Transactions[indx][:0], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Transactions object and fails if the input is not its canonical encoding
+func (t *Transactions) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(t, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Transactions object with the nested objects of the pool
+func (t *Transactions) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return errSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'BlockNumber'
+	t.BlockNumber = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Transactions'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
+		return errOffset
+	}
+
+	// Field (1) 'Transactions'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 1048576)
+		if err != nil {
+			return err
+		}
+		if cap(t.Transactions) >= num {
+			t.Transactions = t.Transactions[:0]
+		} else {
+			t.Transactions = make([][]byte, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(t.Transactions) < cap(t.Transactions) {
+				t.Transactions = t.Transactions[:len(t.Transactions)+1]
+			} else {
+				t.Transactions = append(t.Transactions, nil)
+			}
+			if len(buf) > 1073741824 {
+				return errListTooBig
+			}
+			t.Transactions[indx] = append(t.Transactions[indx][:0], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Transactions object, the byte fields alias the input
+func (t *Transactions) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return errSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'BlockNumber'
+	t.BlockNumber = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Transactions'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
+		return errOffset
+	}
+
+	// Field (1) 'Transactions'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 1048576)
+		if err != nil {
+			return err
+		}
+		if cap(t.Transactions) >= num {
+			t.Transactions = t.Transactions[:0]
+		} else {
+			t.Transactions = make([][]byte, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(t.Transactions) < cap(t.Transactions) {
+				t.Transactions = t.Transactions[:len(t.Transactions)+1]
+			} else {
+				t.Transactions = append(t.Transactions, nil)
+			}
+			if len(buf) > 1073741824 {
+				return errListTooBig
+			}
+			t.Transactions[indx] = ssz.Alias(buf)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the Transactions object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (t *Transactions) CopySSZ() (*Transactions, error) {
+	buf, err := t.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Transactions)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Transactions object
+func (t *Transactions) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'Transactions'
+	for ii := 0; ii < len(t.Transactions); ii++ {
+		size += 4
+		size += len(t.Transactions[ii])
+	}
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Transactions object
+func (t *Transactions) MaxSizeSSZ() uint64 {
+	return 1125899911036940
+}
+
+// HashTreeRoot ssz hashes the Transactions object
+func (t *Transactions) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(t)
+}
+
+// HashTreeRootWith ssz hashes the Transactions object with a hasher
+func (t *Transactions) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'BlockNumber'
+	hh.PutUint64(t.BlockNumber)
+
+	// Field (1) 'Transactions'
+	{
+		if len(t.Transactions) > 1048576 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(t.Transactions); ii++ {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(t.Transactions[ii]))
+				if byteLen > 1073741824 {
+					return ssz.ErrListTooBig
+				}
+				hh.Append(t.Transactions[ii])
+				hh.FillUpTo32()
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(t.Transactions)), 1048576)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Transactions object
+func (t *Transactions) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Transactions",
+		ssz.NewField("block_number", ssz.UintSchema(8)),
+		ssz.NewField("transactions", ssz.ListSchema(ssz.ByteListSchema(1073741824), 1048576)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Transactions object
+func (t *Transactions) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "block_number", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "transactions", Type: "List[ByteList[1073741824], 1048576]", Size: 4, Variable: true, Limit: 1048576, Offset: 8, Gindex: 3},
+	}
+}
+
+// RandomTransactions returns a random Transactions object
+func RandomTransactions(rng *rand.Rand) *Transactions {
+	t := new(Transactions)
+	// Field (0) 'BlockNumber'
+	t.BlockNumber = rng.Uint64()
+
+	// Field (1) 'Transactions'
+	{
+		num := ssz.RandomLength(rng, 1048576)
+		if cap(t.Transactions) >= num {
+			t.Transactions = t.Transactions[:num]
+		} else {
+			t.Transactions = make([][]byte, num)
+		}
+		for ii := 0; ii < len(t.Transactions); ii++ {
+			t.Transactions[ii] = ssz.RandomBytes(rng, ssz.RandomLength(rng, 1073741824))
+		}
+	}
+
+	return t
+}
+
+// MarshalSSZ ssz marshals the Votes object
+func (v *Votes) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, v.SizeSSZ())
+	return v.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Votes object to a target array
+func (v *Votes) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(13)
+
+	// Offset (0) 'Voted'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(v.Voted) * 1
+
+	// Field (1) 'Quorum'
+	if len(v.Quorum) != 4 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 4; ii++ {
+		dst = ssz.MarshalBool(dst, v.Quorum[ii])
+	}
+
+	// Offset (2) 'Rounds'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(v.Rounds); ii++ {
+		offset += 4
+		offset += len(v.Rounds[ii]) * 1
+	}
+
+	// Field (3) 'Approved'
+	dst = ssz.MarshalBool(dst, v.Approved)
+
+	// Field (0) 'Voted'
+	if len(v.Voted) > 64 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(v.Voted); ii++ {
+		dst = ssz.MarshalBool(dst, v.Voted[ii])
+	}
+
+	// Field (2) 'Rounds'
+	if len(v.Rounds) > 8 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(v.Rounds)
+		for ii := 0; ii < len(v.Rounds); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += len(v.Rounds[ii]) * 1
+		}
+	}
+	for ii := 0; ii < len(v.Rounds); ii++ {
+		if len(v.Rounds[ii]) > 16 {
+			return nil, errMarshalList
+		}
+		for jj := 0; jj < len(v.Rounds[ii]); jj++ {
+			dst = ssz.MarshalBool(dst, v.Rounds[ii][jj])
+		}
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Votes object to the buffers, dst is the encoding since the last referenced field
+func (v *Votes) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(13)
+
+	// Offset (0) 'Voted'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(v.Voted) * 1
+
+	// Field (1) 'Quorum'
+	if len(v.Quorum) != 4 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 4; ii++ {
+		dst = ssz.MarshalBool(dst, v.Quorum[ii])
+	}
+
+	// Offset (2) 'Rounds'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(v.Rounds); ii++ {
+		offset += 4
+		offset += len(v.Rounds[ii]) * 1
+	}
+
+	// Field (3) 'Approved'
+	dst = ssz.MarshalBool(dst, v.Approved)
+
+	// Field (0) 'Voted'
+	if len(v.Voted) > 64 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(v.Voted); ii++ {
+		dst = ssz.MarshalBool(dst, v.Voted[ii])
+	}
+
+	// Field (2) 'Rounds'
+	if len(v.Rounds) > 8 {
+		return nil, errMarshalList
+	}
+	{
+		offset = 4 * len(v.Rounds)
+		for ii := 0; ii < len(v.Rounds); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += len(v.Rounds[ii]) * 1
+		}
+	}
+	for ii := 0; ii < len(v.Rounds); ii++ {
+		if len(v.Rounds[ii]) > 16 {
+			return nil, errMarshalList
+		}
+		for jj := 0; jj < len(v.Rounds[ii]); jj++ {
+			dst = ssz.MarshalBool(dst, v.Rounds[ii][jj])
+		}
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Votes object
+func (v *Votes) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 13 {
+		return errSize
+	}
+
+	tail := buf
+	var o0, o2 uint64
+
+	// Offset (0) 'Voted'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 13 {
+		return errOffset
+	}
+
+	// Field (1) 'Quorum'
+	if err = ssz.ValidateBools(buf[4:8]); err != nil {
+		return err
+	}
+	if cap(v.Quorum) >= 4 {
+		v.Quorum = v.Quorum[:4]
+	} else {
+		v.Quorum = make([]bool, 4)
+	}
+	for ii := 0; ii < 4; ii++ {
+		v.Quorum[ii] = ssz.UnmarshalBool(buf[4:8][ii*1 : (ii+1)*1])
+	}
+
+	// Offset (2) 'Rounds'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o0 > o2 {
+		return errOffset
+	}
+
+	// Field (3) 'Approved'
+	v.Approved = ssz.UnmarshalBool(buf[12:13])
+
+	// Field (0) 'Voted'
+	{
+		buf = tail[o0:o2]
+		num, ok := ssz.DivideInt(len(buf), 1)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 64 {
+			return errListTooBig
+		}
+		if err = ssz.ValidateBools(buf); err != nil {
+			return err
+		}
+		if cap(v.Voted) >= num {
+			v.Voted = v.Voted[:num]
+		} else {
+			v.Voted = make([]bool, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			v.Voted[ii] = ssz.UnmarshalBool(buf[ii*1 : (ii+1)*1])
+		}
+	}
+
+	// Field (2) 'Rounds'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DecodeDynamicLength(buf, 8)
+		if err != nil {
+			return err
+		}
+		if cap(v.Rounds) >= num {
+			v.Rounds = v.Rounds[:0]
+		} else {
+			v.Rounds = make([][]bool, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(v.Rounds) < cap(v.Rounds) {
+				v.Rounds = v.Rounds[:len(v.Rounds)+1]
+			} else {
+				v.Rounds = append(v.Rounds, nil)
+			}
+			num, ok := ssz.DivideInt(len(buf), 1)
+			if !ok {
+				return errDivideInt
+			}
+			if num > 16 {
+				return errListTooBig
+			}
+			if err = ssz.ValidateBools(buf); err != nil {
+				return err
+			}
+			if cap(v.Rounds[indx]) >= num {
+				v.Rounds[indx] = v.Rounds[indx][:num]
+			} else {
+				v.Rounds[indx] = make([]bool, num)
+			}
+			for jj := 0; jj < num; jj++ {
+				v.Rounds[indx][jj] = ssz.UnmarshalBool(buf[jj*1 : (jj+1)*1])
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Votes object and fails if the input is not its canonical encoding
+func (v *Votes) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(v, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Votes object with the nested objects of the pool
+func (v *Votes) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 13 {
+		return errSize
+	}
+
+	tail := buf
+	var o0, o2 uint64
+
+	// Offset (0) 'Voted'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 13 {
+		return errOffset
+	}
+
+	// Field (1) 'Quorum'
+	if err = ssz.ValidateBools(buf[4:8]); err != nil {
+		return err
+	}
+	if cap(v.Quorum) >= 4 {
+		v.Quorum = v.Quorum[:4]
+	} else {
+		v.Quorum = make([]bool, 4)
+	}
+	for ii := 0; ii < 4; ii++ {
+		v.Quorum[ii] = ssz.UnmarshalBool(buf[4:8][ii*1 : (ii+1)*1])
+	}
+
+	// Offset (2) 'Rounds'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o0 > o2 {
+		return errOffset
+	}
+
+	// Field (3) 'Approved'
+	v.Approved = ssz.UnmarshalBool(buf[12:13])
+
+	// Field (0) 'Voted'
+	{
+		buf = tail[o0:o2]
+		num, ok := ssz.DivideInt(len(buf), 1)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 64 {
+			return errListTooBig
+		}
+		if err = ssz.ValidateBools(buf); err != nil {
+			return err
+		}
+		if cap(v.Voted) >= num {
+			v.Voted = v.Voted[:num]
+		} else {
+			v.Voted = make([]bool, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			v.Voted[ii] = ssz.UnmarshalBool(buf[ii*1 : (ii+1)*1])
+		}
+	}
+
+	// Field (2) 'Rounds'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DecodeDynamicLength(buf, 8)
+		if err != nil {
+			return err
+		}
+		if cap(v.Rounds) >= num {
+			v.Rounds = v.Rounds[:0]
+		} else {
+			v.Rounds = make([][]bool, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(v.Rounds) < cap(v.Rounds) {
+				v.Rounds = v.Rounds[:len(v.Rounds)+1]
+			} else {
+				v.Rounds = append(v.Rounds, nil)
+			}
+			num, ok := ssz.DivideInt(len(buf), 1)
+			if !ok {
+				return errDivideInt
+			}
+			if num > 16 {
+				return errListTooBig
+			}
+			if err = ssz.ValidateBools(buf); err != nil {
+				return err
+			}
+			if cap(v.Rounds[indx]) >= num {
+				v.Rounds[indx] = v.Rounds[indx][:num]
+			} else {
+				v.Rounds[indx] = make([]bool, num)
+			}
+			for jj := 0; jj < num; jj++ {
+				v.Rounds[indx][jj] = ssz.UnmarshalBool(buf[jj*1 : (jj+1)*1])
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Votes object, the byte fields alias the input
+func (v *Votes) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 13 {
+		return errSize
+	}
+
+	tail := buf
+	var o0, o2 uint64
+
+	// Offset (0) 'Voted'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size || o0 != 13 {
+		return errOffset
+	}
+
+	// Field (1) 'Quorum'
+	if err = ssz.ValidateBools(buf[4:8]); err != nil {
+		return err
+	}
+	if cap(v.Quorum) >= 4 {
+		v.Quorum = v.Quorum[:4]
+	} else {
+		v.Quorum = make([]bool, 4)
+	}
+	for ii := 0; ii < 4; ii++ {
+		v.Quorum[ii] = ssz.UnmarshalBool(buf[4:8][ii*1 : (ii+1)*1])
+	}
+
+	// Offset (2) 'Rounds'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o0 > o2 {
+		return errOffset
+	}
+
+	// Field (3) 'Approved'
+	v.Approved = ssz.UnmarshalBool(buf[12:13])
+
+	// Field (0) 'Voted'
+	{
+		buf = tail[o0:o2]
+		num, ok := ssz.DivideInt(len(buf), 1)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 64 {
+			return errListTooBig
+		}
+		if err = ssz.ValidateBools(buf); err != nil {
+			return err
+		}
+		if cap(v.Voted) >= num {
+			v.Voted = v.Voted[:num]
+		} else {
+			v.Voted = make([]bool, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			v.Voted[ii] = ssz.UnmarshalBool(buf[ii*1 : (ii+1)*1])
+		}
+	}
+
+	// Field (2) 'Rounds'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DecodeDynamicLength(buf, 8)
+		if err != nil {
+			return err
+		}
+		if cap(v.Rounds) >= num {
+			v.Rounds = v.Rounds[:0]
+		} else {
+			v.Rounds = make([][]bool, 0, ssz.ListCapacity(num))
+		}
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(v.Rounds) < cap(v.Rounds) {
+				v.Rounds = v.Rounds[:len(v.Rounds)+1]
+			} else {
+				v.Rounds = append(v.Rounds, nil)
+			}
+			num, ok := ssz.DivideInt(len(buf), 1)
+			if !ok {
+				return errDivideInt
+			}
+			if num > 16 {
+				return errListTooBig
+			}
+			if err = ssz.ValidateBools(buf); err != nil {
+				return err
+			}
+			if cap(v.Rounds[indx]) >= num {
+				v.Rounds[indx] = v.Rounds[indx][:num]
+			} else {
+				v.Rounds[indx] = make([]bool, num)
+			}
+			for jj := 0; jj < num; jj++ {
+				v.Rounds[indx][jj] = ssz.UnmarshalBool(buf[jj*1 : (jj+1)*1])
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// CopySSZ returns a copy of the Votes object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (v *Votes) CopySSZ() (*Votes, error) {
+	buf, err := v.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Votes)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Votes object
+func (v *Votes) SizeSSZ() (size int) {
+	size = 13
+
+	// Field (0) 'Voted'
+	size += len(v.Voted) * 1
+
+	// Field (2) 'Rounds'
+	for ii := 0; ii < len(v.Rounds); ii++ {
+		size += 4
+		size += len(v.Rounds[ii]) * 1
+	}
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Votes object
+func (v *Votes) MaxSizeSSZ() uint64 {
+	return 237
+}
+
+// HashTreeRoot ssz hashes the Votes object
+func (v *Votes) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the Votes object with a hasher
+func (v *Votes) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Voted'
+	{
+		if len(v.Voted) > 64 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(v.Voted); ii++ {
+			hh.AppendBool(v.Voted[ii])
+		}
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(subIndx, uint64(len(v.Voted)), 2)
+	}
+
+	// Field (1) 'Quorum'
+	{
+		if len(v.Quorum) != 4 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(v.Quorum); ii++ {
+			hh.AppendBool(v.Quorum[ii])
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (2) 'Rounds'
+	{
+		if len(v.Rounds) > 8 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(v.Rounds); ii++ {
+			{
+				if len(v.Rounds[ii]) > 16 {
+					return ssz.ErrListTooBig
+				}
+				subIndx := hh.Index()
+				for jj := 0; jj < len(v.Rounds[ii]); jj++ {
+					hh.AppendBool(v.Rounds[ii][jj])
+				}
+				hh.FillUpTo32()
+				hh.MerkleizeWithMixin(subIndx, uint64(len(v.Rounds[ii])), 1)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(v.Rounds)), 8)
+	}
+
+	// Field (3) 'Approved'
+	hh.PutBool(v.Approved)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Votes object
+func (v *Votes) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Votes",
+		ssz.NewField("voted", ssz.ListSchema(ssz.BoolSchema(), 64)),
+		ssz.NewField("quorum", ssz.VectorSchema(ssz.BoolSchema(), 4)),
+		ssz.NewField("rounds", ssz.ListSchema(ssz.ListSchema(ssz.BoolSchema(), 16), 8)),
+		ssz.NewField("approved", ssz.BoolSchema()),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Votes object
+func (v *Votes) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "voted", Type: "List[boolean, 64]", Size: 4, Variable: true, Limit: 64, Offset: 0, Gindex: 4},
+		{Name: "quorum", Type: "Vector[boolean, 4]", Size: 4, Variable: false, Limit: 0, Offset: 4, Gindex: 5},
+		{Name: "rounds", Type: "List[List[boolean, 16], 8]", Size: 4, Variable: true, Limit: 8, Offset: 8, Gindex: 6},
+		{Name: "approved", Type: "boolean", Size: 1, Variable: false, Limit: 0, Offset: 12, Gindex: 7},
+	}
+}
+
+// RandomVotes returns a random Votes object
+func RandomVotes(rng *rand.Rand) *Votes {
+	v := new(Votes)
+	// Field (0) 'Voted'
+	{
+		num := ssz.RandomLength(rng, 64)
+		if cap(v.Voted) >= num {
+			v.Voted = v.Voted[:num]
+		} else {
+			v.Voted = make([]bool, num)
+		}
+		for ii := 0; ii < len(v.Voted); ii++ {
+			v.Voted[ii] = rng.Intn(2) == 1
+		}
+	}
+
+	// Field (1) 'Quorum'
+	{
+		if cap(v.Quorum) >= 4 {
+			v.Quorum = v.Quorum[:4]
+		} else {
+			v.Quorum = make([]bool, 4)
+		}
+		for ii := 0; ii < len(v.Quorum); ii++ {
+			v.Quorum[ii] = rng.Intn(2) == 1
+		}
+	}
+
+	// Field (2) 'Rounds'
+	{
+		num := ssz.RandomLength(rng, 8)
+		if cap(v.Rounds) >= num {
+			v.Rounds = v.Rounds[:num]
+		} else {
+			v.Rounds = make([][]bool, num)
+		}
+		for ii := 0; ii < len(v.Rounds); ii++ {
+			{
+				num := ssz.RandomLength(rng, 16)
+				if cap(v.Rounds[ii]) >= num {
+					v.Rounds[ii] = v.Rounds[ii][:num]
+				} else {
+					v.Rounds[ii] = make([]bool, num)
+				}
+				for jj := 0; jj < len(v.Rounds[ii]); jj++ {
+					v.Rounds[ii][jj] = rng.Intn(2) == 1
+				}
+			}
+		}
+	}
+
+	// Field (3) 'Approved'
+	v.Approved = rng.Intn(2) == 1
+
+	return v
+}
+
+// MarshalSSZ ssz marshals the Event object
+func (e *Event) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ())
+	return e.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Event object to a target array
+func (e *Event) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'ID'
+	dst = ssz.MarshalUint64(dst, e.ID)
+
+	// Field (1) 'Created'
+	if dst, err = ssz.MarshalTime(dst, e.Created, false); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Updated'
+	if dst, err = ssz.MarshalTime(dst, e.Updated, true); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Event object to the buffers, dst is the encoding since the last referenced field
+func (e *Event) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+
+	// Field (0) 'ID'
+	dst = ssz.MarshalUint64(dst, e.ID)
+
+	// Field (1) 'Created'
+	if dst, err = ssz.MarshalTime(dst, e.Created, false); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Updated'
+	if dst, err = ssz.MarshalTime(dst, e.Updated, true); err != nil {
+		return nil, err
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Event object
+func (e *Event) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 24 {
+		return errSize
+	}
+
+	// Field (0) 'ID'
+	e.ID = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Created'
+	if e.Created, err = ssz.UnmarshalTime(buf[8:16], false); err != nil {
+		return err
+	}
+
+	// Field (2) 'Updated'
+	if e.Updated, err = ssz.UnmarshalTime(buf[16:24], true); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Event object and fails if the input is not its canonical encoding
+func (e *Event) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(e, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Event object with the nested objects of the pool
+func (e *Event) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 24 {
+		return errSize
+	}
+
+	// Field (0) 'ID'
+	e.ID = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Created'
+	if e.Created, err = ssz.UnmarshalTime(buf[8:16], false); err != nil {
+		return err
+	}
+
+	// Field (2) 'Updated'
+	if e.Updated, err = ssz.UnmarshalTime(buf[16:24], true); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Event object, the byte fields alias the input
+func (e *Event) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 24 {
+		return errSize
+	}
+
+	// Field (0) 'ID'
+	e.ID = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Created'
+	if e.Created, err = ssz.UnmarshalTime(buf[8:16], false); err != nil {
+		return err
+	}
+
+	// Field (2) 'Updated'
+	if e.Updated, err = ssz.UnmarshalTime(buf[16:24], true); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// CopySSZ returns a copy of the Event object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (e *Event) CopySSZ() (*Event, error) {
+	buf, err := e.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Event)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Event object
+func (e *Event) SizeSSZ() int {
+	return 24
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Event object
+func (e *Event) MaxSizeSSZ() uint64 {
+	return 24
+}
+
+// HashTreeRoot ssz hashes the Event object
+func (e *Event) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the Event object with a hasher
+func (e *Event) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ID'
+	hh.PutUint64(e.ID)
+
+	// Field (1) 'Created'
+	if err = hh.PutTime(e.Created, false); err != nil {
+		return
+	}
+
+	// Field (2) 'Updated'
+	if err = hh.PutTime(e.Updated, true); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Event object
+func (e *Event) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Event",
+		ssz.NewField("id", ssz.UintSchema(8)),
+		ssz.NewField("created", ssz.UintSchema(8)),
+		ssz.NewField("updated", ssz.UintSchema(8)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Event object
+func (e *Event) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "id", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "created", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 8, Gindex: 5},
+		{Name: "updated", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 16, Gindex: 6},
+	}
+}
+
+// RandomEvent returns a random Event object
+func RandomEvent(rng *rand.Rand) *Event {
+	e := new(Event)
+	// Field (0) 'ID'
+	e.ID = rng.Uint64()
+
+	// Field (1) 'Created'
+	e.Created = ssz.RandomTime(rng, false)
+
+	// Field (2) 'Updated'
+	e.Updated = ssz.RandomTime(rng, true)
+
+	return e
+}
+
+// MarshalSSZ ssz marshals the Arrays object
+func (a *Arrays) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, a.SizeSSZ())
+	return a.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Arrays object to a target array
+func (a *Arrays) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(132)
+
+	// Field (0) 'Root'
+	if dst, err = ssz.MarshalFixedBytes(dst, a.Root[:], 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Field (1) 'Hash'
+	if dst, err = ssz.MarshalFixedBytes(dst, a.Hash[:], 32); err != nil {
+		return nil, errMarshalFixedBytes
+	}
+
+	// Offset (2) 'Pubkeys'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(a.Pubkeys) * 48
+
+	// Field (3) 'Hashes'
+	if len(a.Hashes) != 2 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 2; ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, a.Hashes[ii][:], 32); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+
+	// Field (2) 'Pubkeys'
+	if len(a.Pubkeys) > 4 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(a.Pubkeys); ii++ {
+		if dst, err = ssz.MarshalFixedBytes(dst, a.Pubkeys[ii][:], 48); err != nil {
+			return nil, errMarshalFixedBytes
+		}
+	}
+
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Arrays object to the buffers, dst is the encoding since the last referenced field
+func (a *Arrays) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(132)
+
+	// Field (0) 'Root'
+	if len(a.Root[:]) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, a.Root[:])
+
+	// Field (1) 'Hash'
+	if len(a.Hash[:]) != 32 {
+		return nil, errMarshalFixedBytes
+	}
+	dst = bufs.Append(dst, a.Hash[:])
+
+	// Offset (2) 'Pubkeys'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(a.Pubkeys) * 48
+
+	// Field (3) 'Hashes'
+	if len(a.Hashes) != 2 {
+		return nil, errMarshalVector
+	}
+	for ii := 0; ii < 2; ii++ {
+		if len(a.Hashes[ii][:]) != 32 {
+			return nil, errMarshalFixedBytes
+		}
+		dst = bufs.Append(dst, a.Hashes[ii][:])
+	}
+
+	// Field (2) 'Pubkeys'
+	if len(a.Pubkeys) > 4 {
+		return nil, errMarshalList
+	}
+	for ii := 0; ii < len(a.Pubkeys); ii++ {
+		if len(a.Pubkeys[ii][:]) != 48 {
+			return nil, errMarshalFixedBytes
+		}
+		dst = bufs.Append(dst, a.Pubkeys[ii][:])
+	}
+
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Arrays object
+func (a *Arrays) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 132 {
+		return errSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'Root'
+	copy(a.Root[:], buf[0:32])
+
+	// Field (1) 'Hash'
+	copy(a.Hash[:], buf[32:64])
+
+	// Offset (2) 'Pubkeys'
+	if o2 = ssz.ReadOffset(buf[64:68]); o2 > size || o2 != 132 {
+		return errOffset
+	}
+
+	// Field (3) 'Hashes'
+	if cap(a.Hashes) >= 2 {
+		a.Hashes = a.Hashes[:2]
+	} else {
+		a.Hashes = make([]Hash, 2)
+	}
+	for ii := 0; ii < 2; ii++ {
+		copy(a.Hashes[ii][:], buf[68:132][ii*32:(ii+1)*32])
+	}
+
+	// Field (2) 'Pubkeys'
+	{
+		buf = tail[o2:]
+		num, ok := ssz.DivideInt(len(buf), 48)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 4 {
+			return errListTooBig
+		}
+		if cap(a.Pubkeys) >= num {
+			a.Pubkeys = a.Pubkeys[:num]
+		} else {
+			a.Pubkeys = make([][48]byte, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			copy(a.Pubkeys[ii][:], buf[ii*48:(ii+1)*48])
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZVerify ssz unmarshals the Arrays object and fails if the input is not its canonical encoding
+func (a *Arrays) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(a, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Arrays object with the nested objects of the pool
+func (a *Arrays) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 132 {
+		return errSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'Root'
+	copy(a.Root[:], buf[0:32])
+
+	// Field (1) 'Hash'
+	copy(a.Hash[:], buf[32:64])
+
+	// Offset (2) 'Pubkeys'
+	if o2 = ssz.ReadOffset(buf[64:68]); o2 > size || o2 != 132 {
+		return errOffset
+	}
+
+	// Field (3) 'Hashes'
+	if cap(a.Hashes) >= 2 {
+		a.Hashes = a.Hashes[:2]
+	} else {
+		a.Hashes = make([]Hash, 2)
+	}
+	for ii := 0; ii < 2; ii++ {
+		copy(a.Hashes[ii][:], buf[68:132][ii*32:(ii+1)*32])
+	}
+
+	// Field (2) 'Pubkeys'
+	{
+		buf = tail[o2:]
+		num, ok := ssz.DivideInt(len(buf), 48)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 4 {
+			return errListTooBig
+		}
+		if cap(a.Pubkeys) >= num {
+			a.Pubkeys = a.Pubkeys[:num]
+		} else {
+			a.Pubkeys = make([][48]byte, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			copy(a.Pubkeys[ii][:], buf[ii*48:(ii+1)*48])
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the Arrays object, the byte fields alias the input
+func (a *Arrays) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 132 {
+		return errSize
+	}
+
+	tail := buf
+	var o2 uint64
 
-	// Field (9) 'Eth1DepositIndex'
-	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+	// Field (0) 'Root'
+	copy(a.Root[:], buf[0:32])
 
-	// Offset (10) 'Validators'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Validators) * 121
+	// Field (1) 'Hash'
+	copy(a.Hash[:], buf[32:64])
 
-	// Offset (11) 'Balances'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Balances) * 8
+	// Offset (2) 'Pubkeys'
+	if o2 = ssz.ReadOffset(buf[64:68]); o2 > size || o2 != 132 {
+		return errOffset
+	}
 
-	// Field (12) 'RandaoMixes'
-	if len(b.RandaoMixes) != 64 {
-		return nil, errMarshalVector
+	// Field (3) 'Hashes'
+	if cap(a.Hashes) >= 2 {
+		a.Hashes = a.Hashes[:2]
+	} else {
+		a.Hashes = make([]Hash, 2)
 	}
-	for ii := 0; ii < 64; ii++ {
-		if dst, err = ssz.MarshalFixedBytes(dst, b.RandaoMixes[ii], 32); err != nil {
-			return nil, errMarshalFixedBytes
+	for ii := 0; ii < 2; ii++ {
+		copy(a.Hashes[ii][:], buf[68:132][ii*32:(ii+1)*32])
+	}
+
+	// Field (2) 'Pubkeys'
+	{
+		buf = tail[o2:]
+		num, ok := ssz.DivideInt(len(buf), 48)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 4 {
+			return errListTooBig
+		}
+		if cap(a.Pubkeys) >= num {
+			a.Pubkeys = a.Pubkeys[:num]
+		} else {
+			a.Pubkeys = make([][48]byte, num)
+		}
+		for ii := 0; ii < num; ii++ {
+			copy(a.Pubkeys[ii][:], buf[ii*48:(ii+1)*48])
 		}
 	}
+	return err
+}
 
-	// Field (13) 'Slashings'
-	if len(b.Slashings) != 64 {
-		return nil, errMarshalVector
+// CopySSZ returns a copy of the Arrays object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (a *Arrays) CopySSZ() (*Arrays, error) {
+	buf, err := a.MarshalSSZ()
+	if err != nil {
+		return nil, err
 	}
-	for ii := 0; ii < 64; ii++ {
-		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+	obj := new(Arrays)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
 	}
+	return obj, nil
+}
 
-	// Offset (14) 'PreviousEpochAttestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-		offset += 4
-		offset += b.PreviousEpochAttestations[ii].SizeSSZ()
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the Arrays object
+func (a *Arrays) SizeSSZ() (size int) {
+	size = 132
 
-	// Offset (15) 'CurrentEpochAttestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-		offset += 4
-		offset += b.CurrentEpochAttestations[ii].SizeSSZ()
-	}
+	// Field (2) 'Pubkeys'
+	size += len(a.Pubkeys) * 48
 
-	// Field (16) 'JustificationBits'
-	if dst, err = ssz.MarshalFixedBytes(dst, b.JustificationBits, 1); err != nil {
-		return nil, errMarshalFixedBytes
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Arrays object
+func (a *Arrays) MaxSizeSSZ() uint64 {
+	return 324
+}
+
+// HashTreeRoot ssz hashes the Arrays object
+func (a *Arrays) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the Arrays object with a hasher
+func (a *Arrays) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Root'
+	if len(a.Root[:]) != 32 {
+		return ssz.ErrBytesLength
 	}
+	hh.PutBytes(a.Root[:])
 
-	// Field (17) 'PreviousJustifiedCheckpoint'
-	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return nil, err
+	// Field (1) 'Hash'
+	if len(a.Hash[:]) != 32 {
+		return ssz.ErrBytesLength
 	}
+	hh.PutBytes(a.Hash[:])
 
-	// Field (18) 'CurrentJustifiedCheckpoint'
-	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return nil, err
+	// Field (2) 'Pubkeys'
+	{
+		if len(a.Pubkeys) > 4 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(a.Pubkeys); ii++ {
+			if len(a.Pubkeys[ii][:]) != 48 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(a.Pubkeys[ii][:])
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(a.Pubkeys)), 4)
 	}
 
-	// Field (19) 'FinalizedCheckpoint'
-	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return nil, err
+	// Field (3) 'Hashes'
+	{
+		if len(a.Hashes) != 2 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(a.Hashes); ii++ {
+			if len(a.Hashes[ii][:]) != 32 {
+				return ssz.ErrBytesLength
+			}
+			hh.PutBytes(a.Hashes[ii][:])
+		}
+		hh.Merkleize(subIndx)
 	}
 
-	// Field (6) 'HistoricalRoots'
-	if len(b.HistoricalRoots) > 16777216 {
-		return nil, errMarshalList
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Arrays object
+func (a *Arrays) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Arrays",
+		ssz.NewField("root", ssz.ByteVectorSchema(32)),
+		ssz.NewField("hash", ssz.ByteVectorSchema(32)),
+		ssz.NewField("pubkeys", ssz.ListSchema(ssz.ByteVectorSchema(48), 4)),
+		ssz.NewField("hashes", ssz.VectorSchema(ssz.ByteVectorSchema(32), 2)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Arrays object
+func (a *Arrays) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "root", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "hash", Type: "Bytes32", Size: 32, Variable: false, Limit: 0, Offset: 32, Gindex: 5},
+		{Name: "pubkeys", Type: "List[Bytes48, 4]", Size: 4, Variable: true, Limit: 4, Offset: 64, Gindex: 6},
+		{Name: "hashes", Type: "Vector[Bytes32, 2]", Size: 64, Variable: false, Limit: 0, Offset: 68, Gindex: 7},
 	}
-	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
-		if dst, err = ssz.MarshalFixedBytes(dst, b.HistoricalRoots[ii], 32); err != nil {
-			return nil, errMarshalFixedBytes
+}
+
+// RandomArrays returns a random Arrays object
+func RandomArrays(rng *rand.Rand) *Arrays {
+	a := new(Arrays)
+	// Field (0) 'Root'
+	copy(a.Root[:], ssz.RandomBytes(rng, 32))
+
+	// Field (1) 'Hash'
+	copy(a.Hash[:], ssz.RandomBytes(rng, 32))
+
+	// Field (2) 'Pubkeys'
+	{
+		num := ssz.RandomLength(rng, 4)
+		if cap(a.Pubkeys) >= num {
+			a.Pubkeys = a.Pubkeys[:num]
+		} else {
+			a.Pubkeys = make([][48]byte, num)
+		}
+		for ii := 0; ii < len(a.Pubkeys); ii++ {
+			copy(a.Pubkeys[ii][:], ssz.RandomBytes(rng, 48))
 		}
 	}
 
-	// Field (8) 'Eth1DataVotes'
-	if len(b.Eth1DataVotes) > 1024 {
-		return nil, errMarshalList
-	}
-	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
-		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
-			return nil, err
+	// Field (3) 'Hashes'
+	{
+		if cap(a.Hashes) >= 2 {
+			a.Hashes = a.Hashes[:2]
+		} else {
+			a.Hashes = make([]Hash, 2)
+		}
+		for ii := 0; ii < len(a.Hashes); ii++ {
+			copy(a.Hashes[ii][:], ssz.RandomBytes(rng, 32))
 		}
 	}
 
-	// Field (10) 'Validators'
-	if len(b.Validators) > 1099511627776 {
+	return a
+}
+
+// MarshalSSZ ssz marshals the Outer object
+func (o *Outer) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, o.SizeSSZ())
+	return o.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Outer object to a target array
+func (o *Outer) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, o.A)
+
+	// Offset (1) 'B'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(o.B) * 8
+
+	// Field (1) 'B'
+	if len(o.B) > 4 {
 		return nil, errMarshalList
 	}
-	for ii := 0; ii < len(b.Validators); ii++ {
-		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
-			return nil, err
-		}
+	for ii := 0; ii < len(o.B); ii++ {
+		dst = ssz.MarshalUint64(dst, o.B[ii])
 	}
 
-	// Field (11) 'Balances'
-	if len(b.Balances) > 1099511627776 {
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Outer object to the buffers, dst is the encoding since the last referenced field
+func (o *Outer) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, o.A)
+
+	// Offset (1) 'B'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	offset += len(o.B) * 8
+
+	// Field (1) 'B'
+	if len(o.B) > 4 {
 		return nil, errMarshalList
 	}
-	for ii := 0; ii < len(b.Balances); ii++ {
-		dst = ssz.MarshalUint64(dst, b.Balances[ii])
+	for ii := 0; ii < len(o.B); ii++ {
+		dst = ssz.MarshalUint64(dst, o.B[ii])
 	}
 
-	// Field (14) 'PreviousEpochAttestations'
-	if len(b.PreviousEpochAttestations) > 4096 {
-		return nil, errMarshalList
+	return dst, err
+}
+
+// UnmarshalSSZ ssz unmarshals the Outer object
+func (o *Outer) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return errSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	o.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
+		return errOffset
 	}
+
+	// Field (1) 'B'
 	{
-		offset = 4 * len(b.PreviousEpochAttestations)
-		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+		buf = tail[o1:]
+		num, ok := ssz.DivideInt(len(buf), 8)
+		if !ok {
+			return errDivideInt
 		}
-	}
-	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-		if dst, err = b.PreviousEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
-			return nil, err
+		if num > 4 {
+			return errListTooBig
+		}
+		o.B = ssz.ExtendUint64(o.B, num)
+		for ii := 0; ii < num; ii++ {
+			o.B[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
 		}
 	}
+	return err
+}
 
-	// Field (15) 'CurrentEpochAttestations'
-	if len(b.CurrentEpochAttestations) > 4096 {
-		return nil, errMarshalList
+// UnmarshalSSZVerify ssz unmarshals the Outer object and fails if the input is not its canonical encoding
+func (o *Outer) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(o, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Outer object with the nested objects of the pool
+func (o *Outer) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return errSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	o.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
+		return errOffset
 	}
+
+	// Field (1) 'B'
 	{
-		offset = 4 * len(b.CurrentEpochAttestations)
-		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.CurrentEpochAttestations[ii].SizeSSZ()
+		buf = tail[o1:]
+		num, ok := ssz.DivideInt(len(buf), 8)
+		if !ok {
+			return errDivideInt
 		}
-	}
-	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-		if dst, err = b.CurrentEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
-			return nil, err
+		if num > 4 {
+			return errListTooBig
+		}
+		o.B = ssz.ExtendUint64(o.B, num)
+		for ii := 0; ii < num; ii++ {
+			o.B[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
 		}
 	}
-
-	return dst, err
+	return err
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconState object
-func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZNoCopy ssz unmarshals the Outer object, the byte fields alias the input
+func (o *Outer) UnmarshalSSZNoCopy(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 7017 {
+	if size < 12 {
 		return errSize
 	}
 
 	tail := buf
-	var o6, o8, o10, o11, o14, o15 uint64
+	var o1 uint64
 
-	// Field (0) 'GenesisTime'
-	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+	// Field (0) 'A'
+	o.A = ssz.UnmarshallUint64(buf[0:8])
 
-	// Field (1) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[8:16])
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
+		return errOffset
+	}
 
-	// Field (2) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		num, ok := ssz.DivideInt(len(buf), 8)
+		if !ok {
+			return errDivideInt
+		}
+		if num > 4 {
+			return errListTooBig
+		}
+		o.B = ssz.ExtendUint64(o.B, num)
+		for ii := 0; ii < num; ii++ {
+			o.B[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
 	}
-	if err = b.Fork.UnmarshalSSZ(buf[16:32]); err != nil {
-		return err
+	return err
+}
+
+// CopySSZ returns a copy of the Outer object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (o *Outer) CopySSZ() (*Outer, error) {
+	buf, err := o.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Outer)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
 	}
+	return obj, nil
+}
 
-	// Field (3) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
+// SizeSSZ returns the ssz encoded size in bytes for the Outer object
+func (o *Outer) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'B'
+	size += len(o.B) * 8
+
+	return
+}
+
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Outer object
+func (o *Outer) MaxSizeSSZ() uint64 {
+	return 44
+}
+
+// HashTreeRoot ssz hashes the Outer object
+func (o *Outer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+// HashTreeRootWith ssz hashes the Outer object with a hasher
+func (o *Outer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(o.A)
+
+	// Field (1) 'B'
+	{
+		if len(o.B) > 4 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(o.B); ii++ {
+			hh.AppendUint64(o.B[ii])
+		}
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(subIndx, uint64(len(o.B)), 1)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// SchemaSSZ returns the ssz schema of the Outer object
+func (o *Outer) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Outer",
+		ssz.NewField("a", ssz.UintSchema(8)),
+		ssz.NewField("b", ssz.ListSchema(ssz.UintSchema(8), 4)),
+	)
+}
+
+// SSZFields returns the layout of the fields of the Outer object
+func (o *Outer) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "a", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "b", Type: "List[uint64, 4]", Size: 4, Variable: true, Limit: 4, Offset: 8, Gindex: 3},
+	}
+}
+
+// RandomOuter returns a random Outer object
+func RandomOuter(rng *rand.Rand) *Outer {
+	o := new(Outer)
+	// Field (0) 'A'
+	o.A = rng.Uint64()
+
+	// Field (1) 'B'
+	{
+		num := ssz.RandomLength(rng, 4)
+		o.B = ssz.ExtendUint64(o.B, num)
+		for ii := 0; ii < len(o.B); ii++ {
+			o.B[ii] = rng.Uint64()
+		}
+	}
+
+	return o
+}
+
+// MarshalSSZ ssz marshals the ByValue object
+func (b ByValue) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ())
+	return b.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the ByValue object to a target array
+func (b ByValue) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var err error
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, b.A)
+
+	// Offset (1) 'O'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
 	}
-	if err = b.LatestBlockHeader.UnmarshalSSZ(buf[32:136]); err != nil {
-		return err
+	if b.O == nil {
+		offset += new(Outer).SizeSSZ()
+	} else {
+		offset += b.O.SizeSSZ()
 	}
 
-	// Field (4) 'BlockRoots'
-	b.BlockRoots = make([][]byte, 64)
-	for ii := 0; ii < 64; ii++ {
-		b.BlockRoots[ii] = append(b.BlockRoots[ii], buf[136:2184][ii*32:(ii+1)*32]...)
+	// Field (1) 'O'
+	if b.O == nil {
+		if dst, err = new(Outer).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = b.O.MarshalSSZTo(dst); err != nil {
+		return nil, err
 	}
 
-	// Field (5) 'StateRoots'
-	b.StateRoots = make([][]byte, 64)
-	for ii := 0; ii < 64; ii++ {
-		b.StateRoots[ii] = append(b.StateRoots[ii], buf[2184:4232][ii*32:(ii+1)*32]...)
-	}
+	return dst, err
+}
 
-	// Offset (6) 'HistoricalRoots'
-	if o6 = ssz.ReadOffset(buf[4232:4236]); o6 > size {
-		return errOffset
-	}
+// MarshalSSZToBuffers ssz marshals the ByValue object to the buffers, dst is the encoding since the last referenced field
+func (b ByValue) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(12)
 
-	// Field (7) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, b.A)
+
+	// Offset (1) 'O'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
 	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[4236:4308]); err != nil {
-		return err
+	if b.O == nil {
+		offset += new(Outer).SizeSSZ()
+	} else {
+		offset += b.O.SizeSSZ()
 	}
 
-	// Offset (8) 'Eth1DataVotes'
-	if o8 = ssz.ReadOffset(buf[4308:4312]); o8 > size || o6 > o8 {
-		return errOffset
+	// Field (1) 'O'
+	if b.O == nil {
+		if dst, err = new(Outer).MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	} else if dst, err = bufs.MarshalTo(b.O, dst); err != nil {
+		return nil, err
 	}
 
-	// Field (9) 'Eth1DepositIndex'
-	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[4312:4320])
-
-	// Offset (10) 'Validators'
-	if o10 = ssz.ReadOffset(buf[4320:4324]); o10 > size || o8 > o10 {
-		return errOffset
-	}
+	return dst, err
+}
 
-	// Offset (11) 'Balances'
-	if o11 = ssz.ReadOffset(buf[4324:4328]); o11 > size || o10 > o11 {
-		return errOffset
+// UnmarshalSSZ ssz unmarshals the ByValue object
+func (b *ByValue) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return errSize
 	}
 
-	// Field (12) 'RandaoMixes'
-	b.RandaoMixes = make([][]byte, 64)
-	for ii := 0; ii < 64; ii++ {
-		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[4328:6376][ii*32:(ii+1)*32]...)
-	}
+	tail := buf
+	var o1 uint64
 
-	// Field (13) 'Slashings'
-	b.Slashings = ssz.ExtendUint64(b.Slashings, 64)
-	for ii := 0; ii < 64; ii++ {
-		b.Slashings[ii] = ssz.UnmarshallUint64(buf[6376:6888][ii*8 : (ii+1)*8])
-	}
+	// Field (0) 'A'
+	b.A = ssz.UnmarshallUint64(buf[0:8])
 
-	// Offset (14) 'PreviousEpochAttestations'
-	if o14 = ssz.ReadOffset(buf[6888:6892]); o14 > size || o11 > o14 {
+	// Offset (1) 'O'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
 		return errOffset
 	}
 
-	// Offset (15) 'CurrentEpochAttestations'
-	if o15 = ssz.ReadOffset(buf[6892:6896]); o15 > size || o14 > o15 {
-		return errOffset
+	// Field (1) 'O'
+	{
+		buf = tail[o1:]
+		if b.O == nil {
+			b.O = new(Outer)
+		}
+		if err = b.O.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
 	}
+	return err
+}
 
-	// Field (16) 'JustificationBits'
-	b.JustificationBits = append(b.JustificationBits, buf[6896:6897]...)
+// UnmarshalSSZVerify ssz unmarshals the ByValue object and fails if the input is not its canonical encoding
+func (b *ByValue) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(b, buf)
+}
 
-	// Field (17) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[6897:6937]); err != nil {
-		return err
+// UnmarshalSSZWithPool ssz unmarshals the ByValue object with the nested objects of the pool
+func (b *ByValue) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return errSize
 	}
 
-	// Field (18) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[6937:6977]); err != nil {
-		return err
-	}
+	tail := buf
+	var o1 uint64
 
-	// Field (19) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[6977:7017]); err != nil {
-		return err
-	}
+	// Field (0) 'A'
+	b.A = ssz.UnmarshallUint64(buf[0:8])
 
-	// Field (6) 'HistoricalRoots'
-	{
-		buf = tail[o6:o8]
-		num, ok := ssz.DivideInt(len(buf), 32)
-		if !ok {
-			return errDivideInt
-		}
-		if num > 16777216 {
-			return errListTooBig
-		}
-		b.HistoricalRoots = make([][]byte, num)
-		for ii := 0; ii < num; ii++ {
-			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
-		}
+	// Offset (1) 'O'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
+		return errOffset
 	}
 
-	// Field (8) 'Eth1DataVotes'
+	// Field (1) 'O'
 	{
-		buf = tail[o8:o10]
-		num, ok := ssz.DivideInt(len(buf), 72)
-		if !ok {
-			return errDivideInt
+		buf = tail[o1:]
+		if b.O == nil && pool != nil {
+			b.O, _ = pool.Get((*Outer)(nil)).(*Outer)
 		}
-		if num > 1024 {
-			return errListTooBig
+		if b.O == nil {
+			b.O = new(Outer)
 		}
-		b.Eth1DataVotes = make([]*Eth1Data, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Eth1DataVotes[ii] == nil {
-				b.Eth1DataVotes[ii] = new(Eth1Data)
-			}
-			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
-				return err
-			}
+		if err = ssz.UnmarshalWithPool(b.O, buf, pool); err != nil {
+			return err
 		}
 	}
+	return err
+}
 
-	// Field (10) 'Validators'
-	{
-		buf = tail[o10:o11]
-		num, ok := ssz.DivideInt(len(buf), 121)
-		if !ok {
-			return errDivideInt
-		}
-		if num > 1099511627776 {
-			return errListTooBig
-		}
-		b.Validators = make([]*Validator, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Validators[ii] == nil {
-				b.Validators[ii] = new(Validator)
-			}
-			if err = b.Validators[ii].UnmarshalSSZ(buf[ii*121 : (ii+1)*121]); err != nil {
-				return err
-			}
-		}
+// UnmarshalSSZNoCopy ssz unmarshals the ByValue object, the byte fields alias the input
+func (b *ByValue) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return errSize
 	}
 
-	// Field (11) 'Balances'
-	{
-		buf = tail[o11:o14]
-		num, ok := ssz.DivideInt(len(buf), 8)
-		if !ok {
-			return errDivideInt
-		}
-		if num > 1099511627776 {
-			return errListTooBig
-		}
-		b.Balances = ssz.ExtendUint64(b.Balances, num)
-		for ii := 0; ii < num; ii++ {
-			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
-		}
-	}
+	tail := buf
+	var o1 uint64
 
-	// Field (14) 'PreviousEpochAttestations'
-	{
-		buf = tail[o14:o15]
-		num, err := ssz.DecodeDynamicLength(buf, 4096)
-		if err != nil {
-			return err
-		}
-		b.PreviousEpochAttestations = make([]*PendingAttestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.PreviousEpochAttestations[indx] == nil {
-				b.PreviousEpochAttestations[indx] = new(PendingAttestation)
-			}
-			if err = b.PreviousEpochAttestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+	// Field (0) 'A'
+	b.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'O'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
+		return errOffset
 	}
 
-	// Field (15) 'CurrentEpochAttestations'
+	// Field (1) 'O'
 	{
-		buf = tail[o15:]
-		num, err := ssz.DecodeDynamicLength(buf, 4096)
-		if err != nil {
-			return err
+		buf = tail[o1:]
+		if b.O == nil {
+			b.O = new(Outer)
 		}
-		b.CurrentEpochAttestations = make([]*PendingAttestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.CurrentEpochAttestations[indx] == nil {
-				b.CurrentEpochAttestations[indx] = new(PendingAttestation)
-			}
-			if err = b.CurrentEpochAttestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
+		if err = ssz.UnmarshalNoCopy(b.O, buf); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
-func (b *BeaconState) SizeSSZ() (size int) {
-	size = 7017
+// CopySSZ returns a copy of the ByValue object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (b *ByValue) CopySSZ() (*ByValue, error) {
+	buf, err := b.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(ByValue)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
 
-	// Field (6) 'HistoricalRoots'
-	size += len(b.HistoricalRoots) * 32
+// SizeSSZ returns the ssz encoded size in bytes for the ByValue object
+func (b ByValue) SizeSSZ() (size int) {
+	size = 12
 
-	// Field (8) 'Eth1DataVotes'
-	size += len(b.Eth1DataVotes) * 72
+	// Field (1) 'O'
+	if b.O == nil {
+		size += new(Outer).SizeSSZ()
+	} else {
+		size += b.O.SizeSSZ()
+	}
 
-	// Field (10) 'Validators'
-	size += len(b.Validators) * 121
+	return
+}
 
-	// Field (11) 'Balances'
-	size += len(b.Balances) * 8
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the ByValue object
+func (b ByValue) MaxSizeSSZ() uint64 {
+	return 56
+}
 
-	// Field (14) 'PreviousEpochAttestations'
-	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-		size += 4
-		size += b.PreviousEpochAttestations[ii].SizeSSZ()
-	}
+// HashTreeRoot ssz hashes the ByValue object
+func (b ByValue) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
 
-	// Field (15) 'CurrentEpochAttestations'
-	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-		size += 4
-		size += b.CurrentEpochAttestations[ii].SizeSSZ()
+// HashTreeRootWith ssz hashes the ByValue object with a hasher
+func (b ByValue) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(b.A)
+
+	// Field (1) 'O'
+	if b.O == nil {
+		if err = new(Outer).HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else if err = b.O.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
+	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconBlock object
-func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, b.SizeSSZ())
-	return b.MarshalSSZTo(buf[:0])
+// SchemaSSZ returns the ssz schema of the ByValue object
+func (b ByValue) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("ByValue",
+		ssz.NewField("a", ssz.UintSchema(8)),
+		ssz.NewField("o", new(Outer).SchemaSSZ()),
+	)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlock object to a target array
-func (b *BeaconBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
+// SSZFields returns the layout of the fields of the ByValue object
+func (b ByValue) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "a", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "o", Type: "Outer", Size: 4, Variable: true, Limit: 0, Offset: 8, Gindex: 3},
+	}
+}
+
+// RandomByValue returns a random ByValue object
+func RandomByValue(rng *rand.Rand) *ByValue {
+	b := new(ByValue)
+	// Field (0) 'A'
+	b.A = rng.Uint64()
+
+	// Field (1) 'O'
+	b.O = RandomOuter(rng)
+
+	return b
+}
+
+// MarshalSSZ ssz marshals the Inner object
+func (i Inner) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, i.SizeSSZ())
+	return i.MarshalSSZTo(buf[:0])
+}
+
+// MarshalSSZTo ssz marshals the Inner object to a target array
+func (i Inner) MarshalSSZTo(dst []byte) ([]byte, error) {
 	var err error
-	offset := int(76)
+	offset := int(12)
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, i.A)
 
-	// Field (1) 'ParentRoot'
-	if dst, err = ssz.MarshalFixedBytes(dst, b.ParentRoot, 32); err != nil {
-		return nil, errMarshalFixedBytes
+	// Offset (1) 'B'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
 	}
+	offset += len(i.B)
 
-	// Field (2) 'StateRoot'
-	if dst, err = ssz.MarshalFixedBytes(dst, b.StateRoot, 32); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (1) 'B'
+	if len(i.B) > 8 {
+		return nil, errMarshalDynamicBytes
 	}
+	dst = append(dst, i.B...)
 
-	// Offset (3) 'Body'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += b.Body.SizeSSZ()
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Inner object to the buffers, dst is the encoding since the last referenced field
+func (i Inner) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, i.A)
 
-	// Field (3) 'Body'
-	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
+	// Offset (1) 'B'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
 		return nil, err
 	}
+	offset += len(i.B)
+
+	// Field (1) 'B'
+	if len(i.B) > 8 {
+		return nil, errMarshalDynamicBytes
+	}
+	dst = bufs.Append(dst, i.B)
 
 	return dst, err
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlock object
-func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Inner object
+func (i *Inner) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 76 {
+	if size < 12 {
 		return errSize
 	}
 
 	tail := buf
-	var o3 uint64
-
-	// Field (0) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'ParentRoot'
-	b.ParentRoot = append(b.ParentRoot, buf[8:40]...)
+	var o1 uint64
 
-	// Field (2) 'StateRoot'
-	b.StateRoot = append(b.StateRoot, buf[40:72]...)
+	// Field (0) 'A'
+	i.A = ssz.UnmarshallUint64(buf[0:8])
 
-	// Offset (3) 'Body'
-	if o3 = ssz.ReadOffset(buf[72:76]); o3 > size {
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
 		return errOffset
 	}
 
-	// Field (3) 'Body'
+	// Field (1) 'B'
 	{
-		buf = tail[o3:]
-		if b.Body == nil {
-			b.Body = new(BeaconBlockBody)
-		}
-		if err = b.Body.UnmarshalSSZ(buf); err != nil {
-			return err
+		buf = tail[o1:]
+		if len(buf) > 8 {
+			return errListTooBig
 		}
+		i.B = append(i.B[:0], buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlock object
-func (b *BeaconBlock) SizeSSZ() (size int) {
-	size = 76
-
-	// Field (3) 'Body'
-	size += b.Body.SizeSSZ()
-
-	return
-}
-
-// MarshalSSZ ssz marshals the SignedBeaconBlock object
-func (s *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, s.SizeSSZ())
-	return s.MarshalSSZTo(buf[:0])
+// UnmarshalSSZVerify ssz unmarshals the Inner object and fails if the input is not its canonical encoding
+func (i *Inner) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(i, buf)
 }
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlock object to a target array
-func (s *SignedBeaconBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
+// UnmarshalSSZWithPool ssz unmarshals the Inner object with the nested objects of the pool
+func (i *Inner) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
 	var err error
-	offset := int(100)
+	size := uint64(len(buf))
+	if size < 12 {
+		return errSize
+	}
 
-	// Offset (0) 'Block'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += s.Block.SizeSSZ()
+	tail := buf
+	var o1 uint64
 
-	// Field (1) 'Signature'
-	if dst, err = ssz.MarshalFixedBytes(dst, s.Signature, 96); err != nil {
-		return nil, errMarshalFixedBytes
-	}
+	// Field (0) 'A'
+	i.A = ssz.UnmarshallUint64(buf[0:8])
 
-	// Field (0) 'Block'
-	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
-		return nil, err
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
+		return errOffset
 	}
 
-	return dst, err
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 8 {
+			return errListTooBig
+		}
+		i.B = append(i.B[:0], buf...)
+	}
+	return err
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedBeaconBlock object
-func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZNoCopy ssz unmarshals the Inner object, the byte fields alias the input
+func (i *Inner) UnmarshalSSZNoCopy(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 100 {
+	if size < 12 {
 		return errSize
 	}
 
 	tail := buf
-	var o0 uint64
+	var o1 uint64
 
-	// Offset (0) 'Block'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+	// Field (0) 'A'
+	i.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size || o1 != 12 {
 		return errOffset
 	}
 
-	// Field (1) 'Signature'
-	s.Signature = append(s.Signature, buf[4:100]...)
-
-	// Field (0) 'Block'
+	// Field (1) 'B'
 	{
-		buf = tail[o0:]
-		if s.Block == nil {
-			s.Block = new(BeaconBlock)
-		}
-		if err = s.Block.UnmarshalSSZ(buf); err != nil {
-			return err
+		buf = tail[o1:]
+		if len(buf) > 8 {
+			return errListTooBig
 		}
+		i.B = ssz.Alias(buf)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlock object
-func (s *SignedBeaconBlock) SizeSSZ() (size int) {
-	size = 100
+// CopySSZ returns a copy of the Inner object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (i *Inner) CopySSZ() (*Inner, error) {
+	buf, err := i.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Inner)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
 
-	// Field (0) 'Block'
-	size += s.Block.SizeSSZ()
+// SizeSSZ returns the ssz encoded size in bytes for the Inner object
+func (i Inner) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'B'
+	size += len(i.B)
 
 	return
 }
 
-// MarshalSSZ ssz marshals the Transfer object
-func (t *Transfer) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, t.SizeSSZ())
-	return t.MarshalSSZTo(buf[:0])
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Inner object
+func (i Inner) MaxSizeSSZ() uint64 {
+	return 20
 }
 
-// MarshalSSZTo ssz marshals the Transfer object to a target array
-func (t *Transfer) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
-
-	// Field (0) 'Sender'
-	dst = ssz.MarshalUint64(dst, t.Sender)
-
-	// Field (1) 'Recipient'
-	dst = ssz.MarshalUint64(dst, t.Recipient)
-
-	// Field (2) 'Amount'
-	dst = ssz.MarshalUint64(dst, t.Amount)
+// HashTreeRoot ssz hashes the Inner object
+func (i Inner) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(i)
+}
 
-	// Field (3) 'Fee'
-	dst = ssz.MarshalUint64(dst, t.Fee)
+// HashTreeRootWith ssz hashes the Inner object with a hasher
+func (i Inner) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
 
-	// Field (4) 'Slot'
-	dst = ssz.MarshalUint64(dst, t.Slot)
+	// Field (0) 'A'
+	hh.PutUint64(i.A)
 
-	// Field (5) 'Pubkey'
-	if dst, err = ssz.MarshalFixedBytes(dst, t.Pubkey, 48); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(i.B))
+		if byteLen > 8 {
+			return ssz.ErrListTooBig
+		}
+		hh.Append(i.B)
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (8+31)/32)
 	}
 
-	// Field (6) 'Signature'
-	if dst, err = ssz.MarshalFixedBytes(dst, t.Signature, 96); err != nil {
-		return nil, errMarshalFixedBytes
-	}
+	hh.Merkleize(indx)
+	return
+}
 
-	return dst, err
+// SchemaSSZ returns the ssz schema of the Inner object
+func (i Inner) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Inner",
+		ssz.NewField("a", ssz.UintSchema(8)),
+		ssz.NewField("b", ssz.ByteListSchema(8)),
+	)
 }
 
-// UnmarshalSSZ ssz unmarshals the Transfer object
-func (t *Transfer) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 184 {
-		return errSize
+// SSZFields returns the layout of the fields of the Inner object
+func (i Inner) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "a", Type: "uint64", Size: 8, Variable: false, Limit: 0, Offset: 0, Gindex: 2},
+		{Name: "b", Type: "ByteList[8]", Size: 4, Variable: true, Limit: 8, Offset: 8, Gindex: 3},
 	}
+}
 
-	// Field (0) 'Sender'
-	t.Sender = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'Recipient'
-	t.Recipient = ssz.UnmarshallUint64(buf[8:16])
-
-	// Field (2) 'Amount'
-	t.Amount = ssz.UnmarshallUint64(buf[16:24])
-
-	// Field (3) 'Fee'
-	t.Fee = ssz.UnmarshallUint64(buf[24:32])
-
-	// Field (4) 'Slot'
-	t.Slot = ssz.UnmarshallUint64(buf[32:40])
-
-	// Field (5) 'Pubkey'
-	t.Pubkey = append(t.Pubkey, buf[40:88]...)
-
-	// Field (6) 'Signature'
-	t.Signature = append(t.Signature, buf[88:184]...)
+// RandomInner returns a random Inner object
+func RandomInner(rng *rand.Rand) *Inner {
+	i := new(Inner)
+	// Field (0) 'A'
+	i.A = rng.Uint64()
 
-	return err
-}
+	// Field (1) 'B'
+	i.B = ssz.RandomBytes(rng, ssz.RandomLength(rng, 8))
 
-// SizeSSZ returns the ssz encoded size in bytes for the Transfer object
-func (t *Transfer) SizeSSZ() (size int) {
-	size = 184
-	return
+	return i
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBody object
-func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, b.SizeSSZ())
-	return b.MarshalSSZTo(buf[:0])
+// MarshalSSZ ssz marshals the Values object
+func (v *Values) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, v.SizeSSZ())
+	return v.MarshalSSZTo(buf[:0])
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockBody object to a target array
-func (b *BeaconBlockBody) MarshalSSZTo(dst []byte) ([]byte, error) {
+// MarshalSSZTo ssz marshals the Values object to a target array
+func (v *Values) MarshalSSZTo(dst []byte) ([]byte, error) {
 	var err error
-	offset := int(220)
+	offset := int(128)
 
-	// Field (0) 'RandaoReveal'
-	if dst, err = ssz.MarshalFixedBytes(dst, b.RandaoReveal, 96); err != nil {
-		return nil, errMarshalFixedBytes
-	}
-
-	// Field (1) 'Eth1Data'
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+	// Field (0) 'Checkpoint'
+	if dst, err = v.Checkpoint.MarshalSSZTo(dst); err != nil {
 		return nil, err
 	}
 
-	// Field (2) 'Graffiti'
-	if dst, err = ssz.MarshalFixedBytes(dst, b.Graffiti, 32); err != nil {
-		return nil, errMarshalFixedBytes
+	// Offset (1) 'Inner'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
 	}
+	offset += v.Inner.SizeSSZ()
 
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 408
-
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
+	// Offset (2) 'Inners'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
 	}
-
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
+	for ii := 0; ii < len(v.Inners); ii++ {
 		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
+		offset += v.Inners[ii].SizeSSZ()
 	}
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1240
-
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.VoluntaryExits) * 112
-
-	// Field (3) 'ProposerSlashings'
-	if len(b.ProposerSlashings) > 16 {
-		return nil, errMarshalList
+	// Field (3) 'Checkpoints'
+	if len(v.Checkpoints) != 2 {
+		return nil, errMarshalVector
 	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+	for ii := 0; ii < 2; ii++ {
+		if dst, err = v.Checkpoints[ii].MarshalSSZTo(dst); err != nil {
 			return nil, err
 		}
 	}
 
-	// Field (4) 'AttesterSlashings'
-	if len(b.AttesterSlashings) > 1 {
+	// Field (1) 'Inner'
+	if dst, err = v.Inner.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Inners'
+	if len(v.Inners) > 4 {
 		return nil, errMarshalList
 	}
 	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
+		offset = 4 * len(v.Inners)
+		for ii := 0; ii < len(v.Inners); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += v.Inners[ii].SizeSSZ()
 		}
 	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+	for ii := 0; ii < len(v.Inners); ii++ {
+		if dst, err = v.Inners[ii].MarshalSSZTo(dst); err != nil {
 			return nil, err
 		}
 	}
 
-	// Field (5) 'Attestations'
-	if len(b.Attestations) > 128 {
-		return nil, errMarshalList
+	return dst, err
+}
+
+// MarshalSSZToBuffers ssz marshals the Values object to the buffers, dst is the encoding since the last referenced field
+func (v *Values) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+	var err error
+	offset := int(128)
+
+	// Field (0) 'Checkpoint'
+	if dst, err = bufs.MarshalTo(&v.Checkpoint, dst); err != nil {
+		return nil, err
 	}
-	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
-		}
+
+	// Offset (1) 'Inner'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
 	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return nil, err
-		}
+	offset += v.Inner.SizeSSZ()
+
+	// Offset (2) 'Inners'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return nil, err
+	}
+	for ii := 0; ii < len(v.Inners); ii++ {
+		offset += 4
+		offset += v.Inners[ii].SizeSSZ()
 	}
 
-	// Field (6) 'Deposits'
-	if len(b.Deposits) > 16 {
-		return nil, errMarshalList
+	// Field (3) 'Checkpoints'
+	if len(v.Checkpoints) != 2 {
+		return nil, errMarshalVector
 	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+	for ii := 0; ii < 2; ii++ {
+		if dst, err = bufs.MarshalTo(&v.Checkpoints[ii], dst); err != nil {
 			return nil, err
 		}
 	}
 
-	// Field (7) 'VoluntaryExits'
-	if len(b.VoluntaryExits) > 16 {
+	// Field (1) 'Inner'
+	if dst, err = bufs.MarshalTo(&v.Inner, dst); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Inners'
+	if len(v.Inners) > 4 {
 		return nil, errMarshalList
 	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+	{
+		offset = 4 * len(v.Inners)
+		for ii := 0; ii < len(v.Inners); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += v.Inners[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(v.Inners); ii++ {
+		if dst, err = bufs.MarshalTo(&v.Inners[ii], dst); err != nil {
 			return nil, err
 		}
 	}
@@ -2033,90 +14801,71 @@ func (b *BeaconBlockBody) MarshalSSZTo(dst []byte) ([]byte, error) {
 	return dst, err
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBody object
-func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Values object
+func (v *Values) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 220 {
-		return errSize
-	}
-
-	tail := buf
-	var o3, o4, o5, o6, o7 uint64
-
-	// Field (0) 'RandaoReveal'
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
-
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
+	if size < 128 {
+		return errSize
 	}
 
-	// Field (2) 'Graffiti'
-	b.Graffiti = append(b.Graffiti, buf[168:200]...)
+	tail := buf
+	var o1, o2 uint64
 
-	// Offset (3) 'ProposerSlashings'
-	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
-		return errOffset
+	// Field (0) 'Checkpoint'
+	if err = v.Checkpoint.UnmarshalSSZ(buf[0:40]); err != nil {
+		return err
 	}
 
-	// Offset (4) 'AttesterSlashings'
-	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+	// Offset (1) 'Inner'
+	if o1 = ssz.ReadOffset(buf[40:44]); o1 > size || o1 != 128 {
 		return errOffset
 	}
 
-	// Offset (5) 'Attestations'
-	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+	// Offset (2) 'Inners'
+	if o2 = ssz.ReadOffset(buf[44:48]); o2 > size || o1 > o2 {
 		return errOffset
 	}
 
-	// Offset (6) 'Deposits'
-	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
-		return errOffset
+	// Field (3) 'Checkpoints'
+	if cap(v.Checkpoints) >= 2 {
+		v.Checkpoints = v.Checkpoints[:2]
+	} else {
+		v.Checkpoints = make([]Checkpoint, 2)
 	}
-
-	// Offset (7) 'VoluntaryExits'
-	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
-		return errOffset
+	for ii := 0; ii < 2; ii++ {
+		if err = v.Checkpoints[ii].UnmarshalSSZ(buf[48:128][ii*40 : (ii+1)*40]); err != nil {
+			return err
+		}
 	}
 
-	// Field (3) 'ProposerSlashings'
+	// Field (1) 'Inner'
 	{
-		buf = tail[o3:o4]
-		num, ok := ssz.DivideInt(len(buf), 408)
-		if !ok {
-			return errDivideInt
-		}
-		if num > 16 {
-			return errListTooBig
-		}
-		b.ProposerSlashings = make([]*ProposerSlashing, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(ProposerSlashing)
-			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*408 : (ii+1)*408]); err != nil {
-				return err
-			}
+		buf = tail[o1:o2]
+		if err = v.Inner.UnmarshalSSZ(buf); err != nil {
+			return err
 		}
 	}
 
-	// Field (4) 'AttesterSlashings'
+	// Field (2) 'Inners'
 	{
-		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 1)
+		buf = tail[o2:]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
 		if err != nil {
 			return err
 		}
-		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		if cap(v.Inners) >= num {
+			v.Inners = v.Inners[:0]
+		} else {
+			v.Inners = make([]Inner, 0, ssz.ListCapacity(num))
+		}
 		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			if len(v.Inners) < cap(v.Inners) {
+				v.Inners = v.Inners[:len(v.Inners)+1]
+			} else {
+				v.Inners = append(v.Inners, Inner{})
 			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+			if err = v.Inners[indx].UnmarshalSSZ(buf); err != nil {
 				return err
 			}
 			return nil
@@ -2125,20 +14874,79 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 			return err
 		}
 	}
+	return err
+}
 
-	// Field (5) 'Attestations'
+// UnmarshalSSZVerify ssz unmarshals the Values object and fails if the input is not its canonical encoding
+func (v *Values) UnmarshalSSZVerify(buf []byte) error {
+	return ssz.UnmarshalVerify(v, buf)
+}
+
+// UnmarshalSSZWithPool ssz unmarshals the Values object with the nested objects of the pool
+func (v *Values) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 128 {
+		return errSize
+	}
+
+	tail := buf
+	var o1, o2 uint64
+
+	// Field (0) 'Checkpoint'
+	if err = ssz.UnmarshalWithPool(&v.Checkpoint, buf[0:40], pool); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Inner'
+	if o1 = ssz.ReadOffset(buf[40:44]); o1 > size || o1 != 128 {
+		return errOffset
+	}
+
+	// Offset (2) 'Inners'
+	if o2 = ssz.ReadOffset(buf[44:48]); o2 > size || o1 > o2 {
+		return errOffset
+	}
+
+	// Field (3) 'Checkpoints'
+	if cap(v.Checkpoints) >= 2 {
+		v.Checkpoints = v.Checkpoints[:2]
+	} else {
+		v.Checkpoints = make([]Checkpoint, 2)
+	}
+	for ii := 0; ii < 2; ii++ {
+		if err = ssz.UnmarshalWithPool(&v.Checkpoints[ii], buf[48:128][ii*40:(ii+1)*40], pool); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Inner'
 	{
-		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
+		buf = tail[o1:o2]
+		if err = ssz.UnmarshalWithPool(&v.Inner, buf, pool); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Inners'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
 		if err != nil {
 			return err
 		}
-		b.Attestations = make([]*Attestation, num)
+		if cap(v.Inners) >= num {
+			v.Inners = v.Inners[:0]
+		} else {
+			v.Inners = make([]Inner, 0, ssz.ListCapacity(num))
+		}
 		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(Attestation)
+			if len(v.Inners) < cap(v.Inners) {
+				v.Inners = v.Inners[:len(v.Inners)+1]
+			} else {
+				v.Inners = append(v.Inners, Inner{})
 			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+			if err = ssz.UnmarshalWithPool(&v.Inners[indx], buf, pool); err != nil {
 				return err
 			}
 			return nil
@@ -2147,186 +14955,224 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 			return err
 		}
 	}
+	return err
+}
 
-	// Field (6) 'Deposits'
-	{
-		buf = tail[o6:o7]
-		num, ok := ssz.DivideInt(len(buf), 1240)
-		if !ok {
-			return errDivideInt
-		}
-		if num > 16 {
-			return errListTooBig
+// UnmarshalSSZNoCopy ssz unmarshals the Values object, the byte fields alias the input
+func (v *Values) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 128 {
+		return errSize
+	}
+
+	tail := buf
+	var o1, o2 uint64
+
+	// Field (0) 'Checkpoint'
+	if err = ssz.UnmarshalNoCopy(&v.Checkpoint, buf[0:40]); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Inner'
+	if o1 = ssz.ReadOffset(buf[40:44]); o1 > size || o1 != 128 {
+		return errOffset
+	}
+
+	// Offset (2) 'Inners'
+	if o2 = ssz.ReadOffset(buf[44:48]); o2 > size || o1 > o2 {
+		return errOffset
+	}
+
+	// Field (3) 'Checkpoints'
+	if cap(v.Checkpoints) >= 2 {
+		v.Checkpoints = v.Checkpoints[:2]
+	} else {
+		v.Checkpoints = make([]Checkpoint, 2)
+	}
+	for ii := 0; ii < 2; ii++ {
+		if err = ssz.UnmarshalNoCopy(&v.Checkpoints[ii], buf[48:128][ii*40:(ii+1)*40]); err != nil {
+			return err
 		}
-		b.Deposits = make([]*Deposit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(Deposit)
-			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
-				return err
-			}
+	}
+
+	// Field (1) 'Inner'
+	{
+		buf = tail[o1:o2]
+		if err = ssz.UnmarshalNoCopy(&v.Inner, buf); err != nil {
+			return err
 		}
 	}
 
-	// Field (7) 'VoluntaryExits'
+	// Field (2) 'Inners'
 	{
-		buf = tail[o7:]
-		num, ok := ssz.DivideInt(len(buf), 112)
-		if !ok {
-			return errDivideInt
+		buf = tail[o2:]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return err
 		}
-		if num > 16 {
-			return errListTooBig
+		if cap(v.Inners) >= num {
+			v.Inners = v.Inners[:0]
+		} else {
+			v.Inners = make([]Inner, 0, ssz.ListCapacity(num))
 		}
-		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(v.Inners) < cap(v.Inners) {
+				v.Inners = v.Inners[:len(v.Inners)+1]
+			} else {
+				v.Inners = append(v.Inners, Inner{})
 			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+			if err = ssz.UnmarshalNoCopy(&v.Inners[indx], buf); err != nil {
 				return err
 			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBody object
-func (b *BeaconBlockBody) SizeSSZ() (size int) {
-	size = 220
+// CopySSZ returns a copy of the Values object that does not share memory with it
+// (i.e. with the input of UnmarshalSSZNoCopy)
+func (v *Values) CopySSZ() (*Values, error) {
+	buf, err := v.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	obj := new(Values)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
 
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 408
+// SizeSSZ returns the ssz encoded size in bytes for the Values object
+func (v *Values) SizeSSZ() (size int) {
+	size = 128
 
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
-	}
+	// Field (1) 'Inner'
+	size += v.Inner.SizeSSZ()
 
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
+	// Field (2) 'Inners'
+	for ii := 0; ii < len(v.Inners); ii++ {
 		size += 4
-		size += b.Attestations[ii].SizeSSZ()
+		size += v.Inners[ii].SizeSSZ()
 	}
 
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1240
-
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
-
 	return
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, s.SizeSSZ())
-	return s.MarshalSSZTo(buf[:0])
+// MaxSizeSSZ returns the size in bytes of the largest ssz encoding of the Values object
+func (v *Values) MaxSizeSSZ() uint64 {
+	return 244
 }
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
-func (s *SignedBeaconBlockHeader) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
+// HashTreeRoot ssz hashes the Values object
+func (v *Values) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
 
-	// Field (0) 'Header'
-	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
-		return nil, err
-	}
+// HashTreeRootWith ssz hashes the Values object with a hasher
+func (v *Values) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
 
-	// Field (1) 'Signature'
-	if dst, err = ssz.MarshalFixedBytes(dst, s.Signature, 96); err != nil {
-		return nil, errMarshalFixedBytes
+	// Field (0) 'Checkpoint'
+	if err = v.Checkpoint.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	return dst, err
-}
-
-// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 200 {
-		return errSize
+	// Field (1) 'Inner'
+	if err = v.Inner.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	// Field (0) 'Header'
-	if s.Header == nil {
-		s.Header = new(BeaconBlockHeader)
-	}
-	if err = s.Header.UnmarshalSSZ(buf[0:104]); err != nil {
-		return err
+	// Field (2) 'Inners'
+	{
+		if len(v.Inners) > 4 {
+			return ssz.ErrListTooBig
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(v.Inners); ii++ {
+			if err = v.Inners[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(v.Inners)), 4)
 	}
 
-	// Field (1) 'Signature'
-	s.Signature = append(s.Signature, buf[104:200]...)
-
-	return err
-}
+	// Field (3) 'Checkpoints'
+	{
+		if len(v.Checkpoints) != 2 {
+			return ssz.ErrVectorLength
+		}
+		subIndx := hh.Index()
+		for ii := 0; ii < len(v.Checkpoints); ii++ {
+			if err = v.Checkpoints[ii].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.Merkleize(subIndx)
+	}
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) SizeSSZ() (size int) {
-	size = 200
+	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockHeader object
-func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, b.SizeSSZ())
-	return b.MarshalSSZTo(buf[:0])
+// SchemaSSZ returns the ssz schema of the Values object
+func (v *Values) SchemaSSZ() *ssz.Schema {
+	return ssz.ContainerSchema("Values",
+		ssz.NewField("checkpoint", new(Checkpoint).SchemaSSZ()),
+		ssz.NewField("inner", new(Inner).SchemaSSZ()),
+		ssz.NewField("inners", ssz.ListSchema(new(Inner).SchemaSSZ(), 4)),
+		ssz.NewField("checkpoints", ssz.VectorSchema(new(Checkpoint).SchemaSSZ(), 2)),
+	)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockHeader object to a target array
-func (b *BeaconBlockHeader) MarshalSSZTo(dst []byte) ([]byte, error) {
-	var err error
-
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
-
-	// Field (1) 'ParentRoot'
-	if dst, err = ssz.MarshalFixedBytes(dst, b.ParentRoot, 32); err != nil {
-		return nil, errMarshalFixedBytes
-	}
-
-	// Field (2) 'StateRoot'
-	if dst, err = ssz.MarshalFixedBytes(dst, b.StateRoot, 32); err != nil {
-		return nil, errMarshalFixedBytes
-	}
-
-	// Field (3) 'BodyRoot'
-	if dst, err = ssz.MarshalFixedBytes(dst, b.BodyRoot, 32); err != nil {
-		return nil, errMarshalFixedBytes
+// SSZFields returns the layout of the fields of the Values object
+func (v *Values) SSZFields() []ssz.FieldInfo {
+	return []ssz.FieldInfo{
+		{Name: "checkpoint", Type: "Checkpoint", Size: 40, Variable: false, Limit: 0, Offset: 0, Gindex: 4},
+		{Name: "inner", Type: "Inner", Size: 4, Variable: true, Limit: 0, Offset: 40, Gindex: 5},
+		{Name: "inners", Type: "List[Inner, 4]", Size: 4, Variable: true, Limit: 4, Offset: 44, Gindex: 6},
+		{Name: "checkpoints", Type: "Vector[Checkpoint, 2]", Size: 80, Variable: false, Limit: 0, Offset: 48, Gindex: 7},
 	}
-
-	return dst, err
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockHeader object
-func (b *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 104 {
-		return errSize
-	}
-
-	// Field (0) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'ParentRoot'
-	b.ParentRoot = append(b.ParentRoot, buf[8:40]...)
+// RandomValues returns a random Values object
+func RandomValues(rng *rand.Rand) *Values {
+	v := new(Values)
+	// Field (0) 'Checkpoint'
+	v.Checkpoint = *RandomCheckpoint(rng)
 
-	// Field (2) 'StateRoot'
-	b.StateRoot = append(b.StateRoot, buf[40:72]...)
+	// Field (1) 'Inner'
+	v.Inner = *RandomInner(rng)
 
-	// Field (3) 'BodyRoot'
-	b.BodyRoot = append(b.BodyRoot, buf[72:104]...)
+	// Field (2) 'Inners'
+	{
+		num := ssz.RandomLength(rng, 4)
+		if cap(v.Inners) >= num {
+			v.Inners = v.Inners[:num]
+		} else {
+			v.Inners = make([]Inner, num)
+		}
+		for ii := 0; ii < len(v.Inners); ii++ {
+			v.Inners[ii] = *RandomInner(rng)
+		}
+	}
 
-	return err
-}
+	// Field (3) 'Checkpoints'
+	{
+		if cap(v.Checkpoints) >= 2 {
+			v.Checkpoints = v.Checkpoints[:2]
+		} else {
+			v.Checkpoints = make([]Checkpoint, 2)
+		}
+		for ii := 0; ii < len(v.Checkpoints); ii++ {
+			v.Checkpoints[ii] = *RandomCheckpoint(rng)
+		}
+	}
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockHeader object
-func (b *BeaconBlockHeader) SizeSSZ() (size int) {
-	size = 104
-	return
+	return v
 }
@@ -2,9 +2,15 @@ package spectests
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"math/big"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -12,9 +18,13 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	ssz "github.com/ferranbt/fastssz"
+	"github.com/ferranbt/fastssz/consensus"
 	"github.com/ferranbt/fastssz/fuzz"
+	"github.com/ferranbt/fastssz/reqresp"
+	"github.com/ferranbt/fastssz/spectypes"
 	"github.com/ghodss/yaml"
 	baseSSZ "github.com/prysmaticlabs/go-ssz"
 )
@@ -22,6 +32,8 @@ import (
 type codec interface {
 	ssz.Marshaler
 	ssz.Unmarshaler
+	ssz.HashRoot
+	ssz.SchemaProvider
 }
 
 type testCallback func() codec
@@ -135,6 +147,2748 @@ func TestFuzzEncoding(t *testing.T) {
 	}
 }
 
+func TestFuzzEncodingVerify(t *testing.T) {
+	checkIsFuzzEnabled(t)
+
+	// the encodings of the objects are canonical
+	for name, codec := range codecs {
+		count := fuzzTestCount(t, name)
+		for i := 0; i < count; i++ {
+			obj := codec()
+			f := fuzz.New()
+			f.Fuzz(obj)
+
+			dst, err := obj.MarshalSSZTo(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			obj2 := codec()
+			if err := ssz.UnmarshalVerify(obj2, dst); err != nil {
+				t.Fatal(err)
+			}
+			if !deepEqual(obj, obj2) {
+				t.Fatal("bad")
+			}
+		}
+	}
+}
+
+func TestFuzzDifferential(t *testing.T) {
+	checkIsFuzzEnabled(t)
+
+	// Both fastssz and go-ssz must decode the valid encodings to the same object
+	for name, codec := range codecs {
+		codec := codec
+		newObj := func() ssz.MarshalUnmarshaler {
+			return codec()
+		}
+		for i := 0; i < 5; i++ {
+			obj := codec()
+			f := fuzz.New()
+			f.Fuzz(obj)
+
+			dst, err := obj.MarshalSSZTo(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := fuzz.Differential(fuzz.GoSSZ, newObj, dst); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+		}
+	}
+}
+
+func TestRandomEncoding(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	randoms := map[string]func() codec{
+		"BeaconBlock": func() codec { return RandomBeaconBlock(rng) },
+		"BeaconState": func() codec { return RandomBeaconState(rng) },
+		"Attestation": func() codec { return RandomAttestation(rng) },
+	}
+	for name, random := range randoms {
+		for i := 0; i < 10; i++ {
+			obj := random()
+			dst, err := obj.MarshalSSZ()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			obj2 := codecs[name]()
+			if err := ssz.UnmarshalVerify(obj2, dst); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !deepEqual(obj, obj2) {
+				t.Fatalf("%s: bad", name)
+			}
+		}
+	}
+}
+
+func TestHashTreeRoot(t *testing.T) {
+	// go-ssz only hashes the bitlists of the go-bitfield type
+	withBitlists := map[string]bool{
+		"AggregateAndProof":  true,
+		"Attestation":        true,
+		"BeaconBlock":        true,
+		"BeaconBlockBody":    true,
+		"BeaconState":        true,
+		"PendingAttestation": true,
+		"SignedBeaconBlock":  true,
+	}
+	for name, codec := range codecs {
+		if withBitlists[name] {
+			continue
+		}
+		for i := 0; i < 5; i++ {
+			obj := codec()
+			fuzz.New().Fuzz(obj)
+
+			root, err := obj.HashTreeRoot()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			expected, err := baseSSZ.HashTreeRoot(obj)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if root != expected {
+				t.Fatalf("%s: expected root %x but found %x", name, expected, root)
+			}
+		}
+	}
+}
+
+func TestView(t *testing.T) {
+	for name, codec := range codecs {
+		for i := 0; i < 5; i++ {
+			obj := codec()
+			fuzz.New().Fuzz(obj)
+
+			buf, err := obj.MarshalSSZ()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			view, err := ssz.NewViewFromSSZ(obj.SchemaSSZ(), buf)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			root, err := view.HashTreeRoot()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			expected, err := obj.HashTreeRoot()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if root != expected {
+				t.Fatalf("%s: expected root %x but found %x", name, expected, root)
+			}
+			buf2, err := view.MarshalSSZ()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !bytes.Equal(buf, buf2) {
+				t.Fatalf("%s: bad encoding of the view", name)
+			}
+		}
+	}
+}
+
+func TestViewMutation(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(1)))
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	view, err := ssz.NewViewFromSSZ(state.SchemaSSZ(), buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig, err := view.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	origRoot, _ := state.HashTreeRoot()
+
+	// the same changes to the struct and the view
+	state.Slot++
+	state.Balances = append(state.Balances, 32)
+	state.Validators = append(state.Validators, &Validator{Pubkey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32), EffectiveBalance: 32})
+	state.Fork.Epoch = 10
+
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	field := func(v *ssz.View, name string) *ssz.View {
+		t.Helper()
+		res, err := v.Field(name)
+		must(err)
+		return res
+	}
+
+	must(field(view, "slot").SetUint(state.Slot))
+	balance, err := field(view, "balances").Append()
+	must(err)
+	must(balance.SetUint(32))
+	validator, err := field(view, "validators").Append()
+	must(err)
+	must(field(validator, "effective_balance").SetUint(32))
+	must(field(field(view, "fork"), "epoch").SetUint(10))
+
+	root, err := view.HashTreeRoot()
+	must(err)
+	expected, err := state.HashTreeRoot()
+	must(err)
+	if root != expected {
+		t.Fatalf("expected root %x but found %x", expected, root)
+	}
+	buf, err = view.MarshalSSZ()
+	must(err)
+	expectedBuf, err := state.MarshalSSZ()
+	must(err)
+	if !bytes.Equal(buf, expectedBuf) {
+		t.Fatal("bad encoding of the view")
+	}
+
+	// the copy is not modified
+	if root, _ := orig.HashTreeRoot(); root != origRoot {
+		t.Fatal("the copy has been modified")
+	}
+}
+
+func TestViewPath(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(2)))
+	for len(state.Validators) < 3 {
+		state.Validators = append(state.Validators, RandomValidator(rand.New(rand.NewSource(3))))
+		state.Balances = append(state.Balances, 1)
+	}
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		path  string
+		value uint64
+	}{
+		{"slot", state.Slot},
+		{"fork.epoch", state.Fork.Epoch},
+		{"validators[2].effective_balance", state.Validators[2].EffectiveBalance},
+		{"balances[1]", state.Balances[1]},
+		{"randao_mixes[0]", 0},
+	}
+	for _, c := range cases {
+		view, err := ssz.Query(state.SchemaSSZ(), buf, c.path)
+		if err != nil {
+			t.Fatalf("%s: %v", c.path, err)
+		}
+		if view.Schema().Kind == ssz.KindUint {
+			if value, _ := view.Uint(); value != c.value {
+				t.Fatalf("%s: expected %d but found %d", c.path, c.value, value)
+			}
+		}
+		gindex, _, err := state.SchemaSSZ().Gindex(c.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gindex != view.Gindex() {
+			t.Fatalf("%s: expected gindex %d but found %d", c.path, view.Gindex(), gindex)
+		}
+		proof, err := view.Prove()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ssz.VerifyProof(root, proof); err != nil {
+			t.Fatalf("%s: %v", c.path, err)
+		}
+	}
+
+	if _, err := ssz.Query(state.SchemaSSZ(), buf, "validators["+strconv.Itoa(len(state.Validators))+"]"); err == nil {
+		t.Fatal("index out of range expected")
+	}
+}
+
+func TestPartialView(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(4)))
+	state.Validators = append(state.Validators, RandomValidator(rand.New(rand.NewSource(5))))
+	indx := strconv.Itoa(len(state.Validators) - 1)
+
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	view, err := ssz.NewViewFromSSZ(state.SchemaSSZ(), buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proofs, err := view.ProvePaths("slot", "fork", "validators["+indx+"]")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	partial, err := ssz.NewPartialView(state.SchemaSSZ(), root, proofs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slot, err := partial.Path("slot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num, _ := slot.Uint(); num != state.Slot {
+		t.Fatalf("expected slot %d but found %d", state.Slot, num)
+	}
+	fork, err := partial.Path("fork")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f Fork
+	if err := fork.Decode(&f); err != nil {
+		t.Fatal(err)
+	}
+	if f.Epoch != state.Fork.Epoch || !bytes.Equal(f.CurrentVersion, state.Fork.CurrentVersion) {
+		t.Fatal("bad fork")
+	}
+	validator, err := partial.Path("validators[" + indx + "]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v Validator
+	if err := validator.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.EffectiveBalance != state.Validators[len(state.Validators)-1].EffectiveBalance {
+		t.Fatal("bad validator")
+	}
+
+	// the values without proofs cannot be read
+	count, err := partial.Path("eth1_data.deposit_count")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := count.Uint(); err == nil {
+		t.Fatal("eth1 data is not covered")
+	}
+
+	// a proof with another leaf does not verify
+	proofs[0].Leaf = make([]byte, 32)
+	proofs[0].Leaf[0] = 1
+	if _, err := ssz.NewPartialView(state.SchemaSSZ(), root, proofs); err == nil {
+		t.Fatal("invalid proof expected")
+	}
+}
+
+func TestPatch(t *testing.T) {
+	rng := rand.New(rand.NewSource(6))
+	for i := 0; i < 10; i++ {
+		a, b := RandomBeaconState(rng), RandomBeaconState(rng)
+		if i%2 == 0 {
+			// small changes over the same state
+			b = new(BeaconState)
+			buf, _ := a.MarshalSSZ()
+			if err := b.UnmarshalSSZ(buf); err != nil {
+				t.Fatal(err)
+			}
+			b.Slot++
+			b.Balances = b.Balances[:len(b.Balances)/2]
+			b.Validators = append(b.Validators, RandomValidator(rng))
+		}
+		bufA, _ := a.MarshalSSZ()
+		bufB, _ := b.MarshalSSZ()
+
+		viewA, err := ssz.NewViewFromSSZ(a.SchemaSSZ(), bufA)
+		if err != nil {
+			t.Fatal(err)
+		}
+		viewB, err := ssz.NewViewFromSSZ(b.SchemaSSZ(), bufB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		patch, err := ssz.Diff(viewA, viewB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		enc, err := patch.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var patch2 ssz.Patch
+		if err := patch2.UnmarshalSSZ(enc); err != nil {
+			t.Fatal(err)
+		}
+		if err := patch2.Apply(viewA); err != nil {
+			t.Fatal(err)
+		}
+
+		root, _ := viewA.HashTreeRoot()
+		expected, _ := b.HashTreeRoot()
+		if root != expected {
+			t.Fatal("bad root after the patch")
+		}
+		buf, err := viewA.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf, bufB) {
+			t.Fatal("bad encoding after the patch")
+		}
+	}
+}
+
+func TestStoredTree(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(7)))
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	view, err := ssz.NewViewFromSSZ(state.SchemaSSZ(), buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	node, _ := view.Node()
+
+	var stored bytes.Buffer
+	if err := ssz.WriteTree(&stored, node); err != nil {
+		t.Fatal(err)
+	}
+	node, err = ssz.ReadTree(bytes.NewReader(stored.Bytes()), int64(stored.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	view = ssz.NewViewFromNode(state.SchemaSSZ(), node)
+
+	root, _ := view.HashTreeRoot()
+	expected, _ := state.HashTreeRoot()
+	if root != expected {
+		t.Fatal("bad root of the stored tree")
+	}
+	slot, err := view.Path("slot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num, _ := slot.Uint(); num != state.Slot {
+		t.Fatalf("expected slot %d but found %d", state.Slot, num)
+	}
+	buf2, err := view.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, buf2) {
+		t.Fatal("bad encoding of the stored tree")
+	}
+}
+
+func TestLazyValue(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(8)))
+	state.Validators = append(state.Validators, RandomValidator(rand.New(rand.NewSource(9))))
+	last := len(state.Validators) - 1
+
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := ssz.NewLazyValue(state.SchemaSSZ(), bytes.NewReader(buf), int64(len(buf)))
+
+	slot, err := value.Path("slot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num, _ := slot.Uint(); num != state.Slot {
+		t.Fatalf("expected slot %d but found %d", state.Slot, num)
+	}
+	validators, err := value.Field("validators")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num, _ := validators.Len(); num != uint64(len(state.Validators)) {
+		t.Fatalf("expected %d validators but found %d", len(state.Validators), num)
+	}
+	elem, err := validators.Index(uint64(last))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v Validator
+	if err := elem.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.EffectiveBalance != state.Validators[last].EffectiveBalance || !bytes.Equal(v.Pubkey, state.Validators[last].Pubkey) {
+		t.Fatal("bad validator")
+	}
+
+	// the dynamic fields between others
+	for _, path := range []string{"historical_roots", "eth1_data_votes", "balances", "previous_epoch_attestations", "justification_bits"} {
+		field, err := value.Path(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		view, err := field.View()
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		expected, _ := ssz.NewViewFromSSZ(state.SchemaSSZ(), buf)
+		if expected, err = expected.Path(path); err != nil {
+			t.Fatal(err)
+		}
+		root, _ := view.HashTreeRoot()
+		if root2, _ := expected.HashTreeRoot(); root != root2 {
+			t.Fatalf("%s: bad root", path)
+		}
+	}
+}
+
+func TestHashTreeRootReader(t *testing.T) {
+	for name, codec := range codecs {
+		for i := 0; i < 5; i++ {
+			obj := codec()
+			fuzz.New().Fuzz(obj)
+
+			buf, err := obj.MarshalSSZ()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			root, err := ssz.HashTreeRootReader(obj.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf)))
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			expected, err := obj.HashTreeRoot()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if root != expected {
+				t.Fatalf("%s: expected root %x but found %x", name, expected, root)
+			}
+			if len(buf) != 0 {
+				if _, err := ssz.HashTreeRootReader(obj.SchemaSSZ(), bytes.NewReader(buf[:len(buf)-1]), uint64(len(buf))); err == nil {
+					t.Fatalf("%s: short input expected to fail", name)
+				}
+			}
+		}
+	}
+}
+
+func TestReqResp(t *testing.T) {
+	rng := rand.New(rand.NewSource(10))
+	req := RandomCheckpoint(rng)
+	blocks := []*BeaconBlock{}
+	for i := 0; i < 5; i++ {
+		blocks = append(blocks, RandomBeaconBlock(rng))
+	}
+	// a state with long zero sections is sent as compressed chunks
+	state := RandomBeaconState(rng)
+
+	var stream bytes.Buffer
+	if err := reqresp.WriteRequest(&stream, req); err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range blocks {
+		if err := reqresp.WriteResponse(&stream, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := reqresp.WriteResponse(&stream, state); err != nil {
+		t.Fatal(err)
+	}
+	if err := reqresp.WriteError(&stream, reqresp.ResultResourceUnavailable, "not found"); err != nil {
+		t.Fatal(err)
+	}
+
+	r := reqresp.NewReader(&stream, 10*1024*1024)
+	var req2 Checkpoint
+	if err := r.ReadRequest(&req2); err != nil {
+		t.Fatal(err)
+	}
+	if req2.Epoch != req.Epoch || !bytes.Equal(req2.Root, req.Root) {
+		t.Fatal("bad request")
+	}
+	for _, b := range blocks {
+		b2 := new(BeaconBlock)
+		if err := r.ReadResponse(b2); err != nil {
+			t.Fatal(err)
+		}
+		if b.Slot != b2.Slot || !bytes.Equal(b.StateRoot, b2.StateRoot) {
+			t.Fatal("bad block")
+		}
+	}
+	state2 := new(BeaconState)
+	if err := r.ReadResponse(state2); err != nil {
+		t.Fatal(err)
+	}
+	root, _ := state.HashTreeRoot()
+	if root2, _ := state2.HashTreeRoot(); root != root2 {
+		t.Fatal("bad state")
+	}
+	err := r.ReadResponse(new(BeaconBlock))
+	if respErr, ok := err.(*reqresp.ResponseError); !ok || respErr.Result != reqresp.ResultResourceUnavailable || respErr.Message != "not found" {
+		t.Fatalf("response error expected but found %v", err)
+	}
+	if err := r.ReadResponse(new(BeaconBlock)); err != io.EOF {
+		t.Fatalf("eof expected but found %v", err)
+	}
+}
+
+func TestValue(t *testing.T) {
+	for name, codec := range codecs {
+		for i := 0; i < 5; i++ {
+			obj := codec()
+			fuzz.New().Fuzz(obj)
+
+			buf, err := obj.MarshalSSZ()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			value, err := ssz.UnmarshalValue(obj.SchemaSSZ(), buf)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			root, err := value.HashTreeRoot()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			expected, err := obj.HashTreeRoot()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if root != expected {
+				t.Fatalf("%s: expected root %x but found %x", name, expected, root)
+			}
+			buf2, err := value.MarshalSSZ()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !bytes.Equal(buf, buf2) {
+				t.Fatalf("%s: bad encoding of the value", name)
+			}
+			if value.SizeSSZ() != len(buf) {
+				t.Fatalf("%s: bad size of the value", name)
+			}
+		}
+	}
+
+	// the default values have the same root as the default views
+	for name, codec := range codecs {
+		schema := codec().SchemaSSZ()
+		root, err := ssz.NewValue(schema).HashTreeRoot()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if expected, _ := ssz.NewView(schema).HashTreeRoot(); root != expected {
+			t.Fatalf("%s: bad root of the default value", name)
+		}
+	}
+}
+
+func TestExtractColumns(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	bufs := [][]byte{}
+	slots, attSlots := []uint64{}, []uint64{}
+	for i := 0; i < 10; i++ {
+		block := RandomBeaconBlock(rng)
+		buf, err := block.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bufs = append(bufs, buf)
+		slots = append(slots, block.Slot)
+		for _, att := range block.Body.Attestations {
+			attSlots = append(attSlots, att.Data.Slot)
+		}
+	}
+
+	cols, err := ssz.ExtractColumns(new(BeaconBlock).SchemaSSZ(), bufs, "slot", "body.attestations[*].data.slot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, expected := range [][]uint64{slots, attSlots} {
+		values, err := cols[i].Uints()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(values) != len(expected) {
+			t.Fatalf("%s: expected %d values but found %d", cols[i].Path, len(expected), len(values))
+		}
+		for j := range values {
+			if values[j] != expected[j] {
+				t.Fatalf("%s: bad value %d", cols[i].Path, j)
+			}
+		}
+	}
+
+	if _, err := ssz.NewExtractor(new(BeaconBlock).SchemaSSZ(), "body.unknown"); err == nil {
+		t.Fatal("unknown field expected")
+	}
+}
+
+func TestUnion(t *testing.T) {
+	union := ssz.UnionSchema(nil, ssz.UintSchema(2), new(Checkpoint).SchemaSSZ())
+	schema := ssz.ContainerSchema("Container",
+		ssz.NewField("a", ssz.UintSchema(8)),
+		ssz.NewField("u", union),
+		ssz.NewField("l", ssz.ListSchema(union, 4)),
+	)
+
+	value := ssz.NewValue(schema)
+	value.Elems[0].Uint = 5
+	value.Elems[1].Selector = 1
+	value.Elems[1].Elems = []*ssz.Value{{Schema: ssz.UintSchema(2), Uint: 0x1234}}
+	for i := 0; i < 3; i++ {
+		elem, err := value.Elems[2].Append()
+		if err != nil {
+			t.Fatal(err)
+		}
+		elem.Selector = uint8(i)
+		if i != 0 {
+			elem.Elems = []*ssz.Value{ssz.NewValue(union.Options[i])}
+		}
+	}
+
+	buf, err := value.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	value2, err := ssz.UnmarshalValue(schema, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	selector, variant, err := value2.Elems[1].Variant()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if selector != 1 || variant.Uint != 0x1234 {
+		t.Fatal("bad variant")
+	}
+
+	// the root of the union is the root of the value mixed in with the selector
+	root, err := value.Elems[1].HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmp := make([]byte, 64)
+	tmp[0], tmp[1], tmp[32] = 0x34, 0x12, 1
+	if root != sha256.Sum256(tmp) {
+		t.Fatal("bad root of the union")
+	}
+
+	expected, err := value.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	view, err := ssz.NewViewFromSSZ(schema, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root, _ := view.HashTreeRoot(); root != expected {
+		t.Fatal("bad root of the view")
+	}
+	if buf2, _ := view.MarshalSSZ(); !bytes.Equal(buf, buf2) {
+		t.Fatal("bad encoding of the view")
+	}
+	if root, err := ssz.HashTreeRootReader(schema, bytes.NewReader(buf), uint64(len(buf))); err != nil || root != expected {
+		t.Fatal("bad streaming root")
+	}
+
+	// the view changes the option
+	u, err := view.Field("u")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkpoint, err := u.SetVariant(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	epoch, _ := checkpoint.Field("epoch")
+	if err := epoch.SetUint(3); err != nil {
+		t.Fatal(err)
+	}
+	value.Elems[1].Selector = 2
+	value.Elems[1].Elems = []*ssz.Value{ssz.NewValue(union.Options[2])}
+	value.Elems[1].Elems[0].Elems[0].Uint = 3
+	expected, _ = value.HashTreeRoot()
+	if root, _ := view.HashTreeRoot(); root != expected {
+		t.Fatal("bad root of the view with another option")
+	}
+
+	// the selector must be one of the options
+	buf[8+4+4] = 3
+	if _, err := ssz.UnmarshalValue(schema, buf); err != ssz.ErrInvalidSelector {
+		t.Fatalf("invalid selector expected but found %v", err)
+	}
+}
+
+func TestDump(t *testing.T) {
+	block := RandomBeaconBlock(rand.New(rand.NewSource(12)))
+	buf, err := block.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := ssz.Dump(&out, block.SchemaSSZ(), buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "slot: uint64 = "+strconv.FormatUint(block.Slot, 10)) {
+		t.Fatal("slot not found in the dump")
+	}
+	if !strings.Contains(out.String(), "body: offset = 76") {
+		t.Fatal("offset of the body not found in the dump")
+	}
+
+	// the offset of the body out of range
+	buf[72] = 0xff
+	out.Reset()
+	if err := ssz.Dump(&out, block.SchemaSSZ(), buf); err == nil {
+		t.Fatal("invalid offset expected")
+	}
+	if !strings.Contains(out.String(), "<root>: invalid") || !strings.Contains(out.String(), "parent_root: Bytes32") {
+		t.Fatal("invalid section not found in the dump")
+	}
+}
+
+func TestSchemaSize(t *testing.T) {
+	schema := (&BeaconBlock{}).SchemaSSZ()
+	if schema.MinSize() != 296 || schema.MaxSize() != 124284 {
+		t.Fatalf("bad size bounds %d to %d", schema.MinSize(), schema.MaxSize())
+	}
+	rnd := rand.New(rand.NewSource(13))
+	for i := 0; i < 10; i++ {
+		size := uint64(RandomBeaconBlock(rnd).SizeSSZ())
+		if size < schema.MinSize() || size > schema.MaxSize() {
+			t.Fatalf("size %d out of bounds", size)
+		}
+	}
+}
+
+func TestLegacy(t *testing.T) {
+	data := RandomDepositData(rand.New(rand.NewSource(14)))
+	buf, err := ssz.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data2 := new(DepositData)
+	if err := ssz.Unmarshal(buf, data2); err != nil {
+		t.Fatal(err)
+	}
+	if !deepEqual(data, data2) {
+		t.Fatal("bad unmarshal")
+	}
+
+	// the signing root of the deposit data is the root of the deposit message
+	msg := &DepositMessage{Pubkey: data.Pubkey, WithdrawalCredentials: data.WithdrawalCredentials, Amount: data.Amount}
+	root, err := ssz.SigningRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ssz.HashTreeRoot(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("bad signing root")
+	}
+	if _, err := ssz.Marshal(struct{}{}); err != ssz.ErrNotSupported {
+		t.Fatal("not supported expected")
+	}
+}
+
+func TestUint256(t *testing.T) {
+	num := &[4]uint64{1, 2, 3, 4}
+	buf := ssz.MarshalUint256(nil, num)
+
+	dst := new([4]uint64)
+	ssz.UnmarshalUint256(dst, buf)
+	if *dst != *num {
+		t.Fatal("bad unmarshal")
+	}
+
+	// a list of uint256 packs a value per chunk
+	schema := ssz.ListSchema(ssz.UintSchema(32), 4)
+	value, err := ssz.UnmarshalValue(schema, append(buf, buf...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := value.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hh := ssz.NewHasher()
+	hh.AppendUint256(num)
+	hh.AppendUint256(num)
+	hh.MerkleizeWithMixin(0, 2, 4)
+	expected, err := hh.HashRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("bad root")
+	}
+	view, err := ssz.NewViewFromSSZ(schema, append(buf, buf...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	elem, err := view.Index(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := elem.Uint(); err != ssz.ErrUintOverflow {
+		t.Fatal("overflow expected")
+	}
+}
+
+func TestComputeSigningRoot(t *testing.T) {
+	checkpoint := RandomCheckpoint(rand.New(rand.NewSource(15)))
+	objRoot, err := checkpoint.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot := [32]byte{1, 2, 3}
+	domain, err := ssz.ComputeDomain([4]byte{7}, [4]byte{1}, genesisRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the containers of two chunks are the hash of both chunks
+	version := make([]byte, 32)
+	version[0] = 1
+	forkRoot := sha256.Sum256(append(version, genesisRoot[:]...))
+	if domain[0] != 7 || !bytes.Equal(domain[4:], forkRoot[:28]) {
+		t.Fatal("bad domain")
+	}
+	root, err := ssz.ComputeSigningRoot(checkpoint, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != sha256.Sum256(append(objRoot[:], domain[:]...)) {
+		t.Fatal("bad signing root")
+	}
+}
+
+func TestBitlistLimit(t *testing.T) {
+	att := RandomPendingAttestation(rand.New(rand.NewSource(16)))
+
+	// 2049 bits with the length bit
+	att.AggregationBits = make([]byte, 257)
+	att.AggregationBits[256] = 0x02
+	if _, err := att.MarshalSSZ(); err != ssz.ErrBitlistTooBig {
+		t.Fatalf("bitlist too big expected but found %v", err)
+	}
+	if _, err := att.HashTreeRoot(); err != ssz.ErrBitlistTooBig {
+		t.Fatalf("bitlist too big expected but found %v", err)
+	}
+	att.AggregationBits[256] = 0x01
+	if _, err := att.HashTreeRoot(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProgressiveList(t *testing.T) {
+	// 10 uint64 are 3 chunks, the first one in the subtree
+	// of one chunk and the other two in the subtree of four
+	buf := []byte{}
+	for i := uint64(0); i < 10; i++ {
+		buf = ssz.MarshalUint64(buf, i+1)
+	}
+	hash := func(a, b []byte) []byte {
+		res := sha256.Sum256(append(append([]byte{}, a...), b...))
+		return res[:]
+	}
+	chunks := make([]byte, 96)
+	copy(chunks, buf)
+	zero := make([]byte, 32)
+	subtree := hash(hash(chunks[32:64], chunks[64:96]), hash(zero, zero))
+	length := make([]byte, 32)
+	length[0] = 10
+	expected := hash(hash(hash(zero, subtree), chunks[:32]), length)
+
+	hh := ssz.NewHasher()
+	for i := uint64(0); i < 10; i++ {
+		hh.AppendUint64(i + 1)
+	}
+	hh.FillUpTo32()
+	hh.MerkleizeProgressiveWithMixin(0, 10)
+	root, err := hh.HashRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(root[:], expected) {
+		t.Fatal("bad hasher root")
+	}
+
+	schema := ssz.ProgressiveListSchema(ssz.UintSchema(8))
+	value, err := ssz.UnmarshalValue(schema, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valueRoot, err := value.HashTreeRoot(); err != nil || valueRoot != root {
+		t.Fatal("bad value root")
+	}
+	view, err := ssz.NewViewFromSSZ(schema, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if viewRoot, err := view.HashTreeRoot(); err != nil || viewRoot != root {
+		t.Fatal("bad view root")
+	}
+	if readerRoot, err := ssz.HashTreeRootReader(schema, bytes.NewReader(buf), uint64(len(buf))); err != nil || readerRoot != root {
+		t.Fatal("bad reader root")
+	}
+
+	// the elements in the deeper subtrees
+	elem, err := view.Index(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num, err := elem.Uint(); err != nil || num != 10 {
+		t.Fatalf("bad element %d", num)
+	}
+	if _, err := view.Append(); err != nil {
+		t.Fatal(err)
+	}
+	out, err := view.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, ssz.MarshalUint64(buf, 0)) {
+		t.Fatal("bad marshal after append")
+	}
+
+	// the progressive bitlists have no limit
+	bits := ssz.ProgressiveBitlistSchema()
+	if _, err := ssz.UnmarshalValue(bits, append(make([]byte, 1000), 0x01)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHashBackend(t *testing.T) {
+	block := RandomBeaconBlock(rand.New(rand.NewSource(17)))
+	expected, err := ssz.HashWithNewHasher(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ssz.SetHashBackend("auto")
+
+	// all the backends compute the same roots
+	for _, name := range []string{ssz.BackendGeneric, "auto"} {
+		if err := ssz.SetHashBackend(name); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashWithNewHasher(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != expected {
+			t.Fatalf("bad root with the %s backend", ssz.HashBackend())
+		}
+	}
+	if err := ssz.SetHashBackend("unknown"); err != ssz.ErrBackendNotAvailable {
+		t.Fatalf("backend not available expected but found %v", err)
+	}
+}
+
+func TestByteOrder(t *testing.T) {
+	// the encodings are little endian no matter the byte order of the host
+	num := uint64(0x0102030405060708)
+	expected := []byte{8, 7, 6, 5, 4, 3, 2, 1}
+	if buf := ssz.MarshalUint64(nil, num); !bytes.Equal(buf, expected) {
+		t.Fatal("bad uint64")
+	}
+	if buf := ssz.MarshalUint32(nil, uint32(num)); !bytes.Equal(buf, expected[:4]) {
+		t.Fatal("bad uint32")
+	}
+	if buf := ssz.MarshalUint16(nil, uint16(num)); !bytes.Equal(buf, expected[:2]) {
+		t.Fatal("bad uint16")
+	}
+	if ssz.UnmarshallUint64(expected) != num || ssz.UnmarshallUint32(expected) != uint32(num) || ssz.UnmarshallUint16(expected) != uint16(num) {
+		t.Fatal("bad unmarshal")
+	}
+	if buf := ssz.MarshalUint256(nil, &[4]uint64{num}); !bytes.Equal(buf[:8], expected) || !bytes.Equal(buf[8:], make([]byte, 24)) {
+		t.Fatal("bad uint256")
+	}
+
+	schema := ssz.ListSchema(ssz.UintSchema(4), 4)
+	value := ssz.NewValue(schema)
+	elem, err := value.Append()
+	if err != nil {
+		t.Fatal(err)
+	}
+	elem.Uint = uint64(uint32(num))
+	buf, err := value.MarshalSSZ()
+	if err != nil || !bytes.Equal(buf, expected[:4]) {
+		t.Fatal("bad value")
+	}
+	view, err := ssz.NewViewFromSSZ(schema, expected[:4])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elem, err := view.Index(0); err != nil {
+		t.Fatal(err)
+	} else if i, err := elem.Uint(); err != nil || i != uint64(uint32(num)) {
+		t.Fatal("bad view")
+	}
+}
+
+func TestForks(t *testing.T) {
+	phase0, altair := [4]byte{0, 0, 0, 0}, [4]byte{1, 0, 0, 0}
+	genesisRoot := [32]byte{1, 2, 3}
+
+	forks := ssz.NewForks()
+	forks.Register("Block", phase0, func() ssz.Object { return new(BeaconBlockHeader) })
+	forks.Register("Block", altair, func() ssz.Object { return new(BeaconBlock) })
+
+	block := RandomBeaconBlock(rand.New(rand.NewSource(18)))
+	buf, err := block.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest, err := ssz.ComputeForkDigest(altair, genesisRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err := forks.DecodeDigest("Block", digest, genesisRoot, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !deepEqual(obj, block) {
+		t.Fatal("bad block")
+	}
+
+	// the phase0 variant does not decode the altair block
+	if _, err := forks.DecodeVersion("Block", phase0, buf); err == nil {
+		t.Fatal("phase0 decode expected to fail")
+	}
+	if _, err := forks.DecodeDigest("Block", digest, [32]byte{}, buf); err != ssz.ErrUnknownFork {
+		t.Fatalf("unknown fork expected but found %v", err)
+	}
+	if _, err := forks.New("State", altair); err != ssz.ErrUnknownFork {
+		t.Fatalf("unknown fork expected but found %v", err)
+	}
+}
+
+func TestUnmarshalAndHash(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(19)))
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj := new(BeaconState)
+	root, err := ssz.UnmarshalAndHash(obj, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected || !deepEqual(obj, state) {
+		t.Fatal("bad decode")
+	}
+
+	obj = new(BeaconState)
+	root, err = ssz.UnmarshalAndHashReader(obj, bytes.NewReader(buf), uint64(len(buf)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected || !deepEqual(obj, state) {
+		t.Fatal("bad decode from the reader")
+	}
+
+	// the input ends before the size
+	if _, err := ssz.UnmarshalAndHashReader(new(BeaconState), bytes.NewReader(buf[:len(buf)-1]), uint64(len(buf))); err == nil {
+		t.Fatal("truncated input expected to fail")
+	}
+	// a bad offset fails the hasher before the input is read
+	block, err := RandomBeaconBlock(rand.New(rand.NewSource(20))).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	block[72] = 0
+	if _, err := ssz.UnmarshalAndHashReader(new(BeaconBlock), bytes.NewReader(block), uint64(len(block))); err == nil {
+		t.Fatal("bad offset expected to fail")
+	}
+}
+
+func TestMarshalToWriter(t *testing.T) {
+	rng := rand.New(rand.NewSource(21))
+
+	var stream bytes.Buffer
+	blocks := []*BeaconBlock{RandomBeaconBlock(rng), RandomBeaconBlock(rng)}
+	for _, block := range blocks {
+		if err := ssz.MarshalToWriter(&stream, block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, block := range blocks {
+		obj := new(BeaconBlock)
+		if err := ssz.UnmarshalFromReader(&stream, obj, uint64(block.SizeSSZ())); err != nil {
+			t.Fatal(err)
+		}
+		if !deepEqual(obj, block) {
+			t.Fatal("bad decode")
+		}
+	}
+	if err := ssz.UnmarshalFromReader(&stream, new(BeaconBlock), uint64(blocks[0].SizeSSZ())); err != io.ErrUnexpectedEOF {
+		t.Fatalf("expected unexpected EOF but found %v", err)
+	}
+	// the size is checked with the schema before reading
+	if err := ssz.UnmarshalFromReader(&stream, new(Checkpoint), 41); err != ssz.ErrSize {
+		t.Fatalf("expected size error but found %v", err)
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	rng := rand.New(rand.NewSource(22))
+
+	var stream bytes.Buffer
+	blocks := []*BeaconBlock{RandomBeaconBlock(rng), RandomBeaconBlock(rng)}
+	for _, block := range blocks {
+		if err := ssz.MarshalToWriter(&stream, block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dec := ssz.NewDecoder(&stream)
+	for _, block := range blocks {
+		obj := new(BeaconBlock)
+		if err := dec.Decode(obj, uint64(block.SizeSSZ())); err != nil {
+			t.Fatal(err)
+		}
+		if !deepEqual(obj, block) {
+			t.Fatal("bad decode")
+		}
+	}
+
+	// the bad offset of the body is rejected before the rest of the block is read
+	buf, err := blocks[0].MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf[72] = 0
+	pr, pw := io.Pipe()
+	pw.CloseWithError(io.ErrNoProgress)
+
+	dec = ssz.NewDecoder(io.MultiReader(bytes.NewReader(buf[:100]), pr))
+	if err := dec.Decode(new(BeaconBlock), uint64(len(buf))); err != ssz.ErrOffset {
+		t.Fatalf("expected offset error but found %v", err)
+	}
+}
+
+func TestProfile(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(23)))
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := ssz.NewProfile(ssz.Profile{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root, err := p.HashTreeRoot(state.SchemaSSZ(), buf); err != nil || root != expected {
+		t.Fatal("bad root with the default profile")
+	}
+
+	// chunks of 64 bytes with a prefix in the leaves
+	leaf := func(chunk []byte) [32]byte {
+		return sha256.Sum256(append([]byte{0}, chunk...))
+	}
+	pair := func(a, b [32]byte) [32]byte {
+		return sha256.Sum256(append(a[:], b[:]...))
+	}
+	chunk := func(buf []byte) []byte {
+		return append(append([]byte{}, buf...), make([]byte, 64-len(buf))...)
+	}
+
+	fork := &Fork{PreviousVersion: []byte{1, 2, 3, 4}, CurrentVersion: []byte{5, 6, 7, 8}, Epoch: 9}
+	if buf, err = fork.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	l0, l1, l2 := leaf(chunk(buf[:4])), leaf(chunk(buf[4:8])), leaf(chunk(buf[8:]))
+
+	cases := []struct {
+		padding  ssz.Padding
+		expected [32]byte
+	}{
+		{ssz.PadZero, pair(pair(l0, l1), pair(l2, leaf(chunk(nil))))},
+		{ssz.PadNone, pair(pair(l0, l1), l2)},
+	}
+	for _, c := range cases {
+		p, err := ssz.NewProfile(ssz.Profile{ChunkSize: 64, Padding: c.padding, Leaf: leaf})
+		if err != nil {
+			t.Fatal(err)
+		}
+		root, err := p.HashTreeRoot(fork.SchemaSSZ(), buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != c.expected {
+			t.Fatalf("bad root with padding %d", c.padding)
+		}
+	}
+
+	// the chunks bigger than 32 bytes need a leaf encoding
+	if _, err := ssz.NewProfile(ssz.Profile{ChunkSize: 64}); err != ssz.ErrInvalidProfile {
+		t.Fatalf("expected invalid profile but found %v", err)
+	}
+}
+
+func TestSSZFields(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(24)))
+	fields := state.SSZFields()
+	expected := state.SchemaSSZ().FieldsInfo()
+	if len(fields) != len(expected) {
+		t.Fatal("bad number of fields")
+	}
+	for indx, f := range fields {
+		if f != expected[indx] {
+			t.Fatalf("field %s does not match the schema", f.Name)
+		}
+	}
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range fields {
+		if f.Name == "balances" && (f.Gindex != 43 || f.Limit != 1099511627776 || !f.Variable) {
+			t.Fatalf("bad balances field %v", f)
+		}
+		if f.Name == "validators" {
+			// the offset of the validators points after the fixed part
+			if ssz.ReadOffset(buf[f.Offset:f.Offset+f.Size]) < f.Offset+f.Size {
+				t.Fatal("bad offset of the validators")
+			}
+		}
+	}
+}
+
+func TestContainerBuilder(t *testing.T) {
+	s, err := ssz.Container().
+		Named("IndexedAttestation").
+		List("attesting_indices", ssz.UintSchema(8), 2048).
+		Field("data", new(AttestationData).SchemaSSZ()).
+		ByteVector("signature", 96).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj := RandomIndexedAttestation(rand.New(rand.NewSource(25)))
+	if !s.Equal(obj.SchemaSSZ()) {
+		t.Fatal("the schema does not match the generated one")
+	}
+
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := ssz.UnmarshalValue(s, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root, err := v.HashTreeRoot(); err != nil || root != expected {
+		t.Fatal("bad root of the value")
+	}
+
+	// two fields with the same name
+	if _, err := ssz.Container().Uint64("slot").ByteVector("slot", 32).Build(); err != ssz.ErrInvalidSchema {
+		t.Fatalf("expected invalid schema but found %v", err)
+	}
+	if _, err := ssz.Container().Build(); err != ssz.ErrInvalidSchema {
+		t.Fatalf("expected invalid schema but found %v", err)
+	}
+}
+
+func TestSizeProfile(t *testing.T) {
+	rng := rand.New(rand.NewSource(26))
+	p := ssz.NewSizeProfile(new(BeaconBlock).SchemaSSZ())
+
+	total := uint64(0)
+	signatures := uint64(0)
+	for i := 0; i < 3; i++ {
+		block := RandomBeaconBlock(rng)
+		buf, err := block.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := p.Add(buf); err != nil {
+			t.Fatal(err)
+		}
+		total += uint64(len(buf))
+		signatures += uint64(96 * len(block.Body.Attestations))
+	}
+	// an invalid encoding is not recorded
+	if err := p.Add(make([]byte, 10)); err == nil {
+		t.Fatal("invalid encoding expected to fail")
+	}
+	if p.Count() != 3 {
+		t.Fatalf("expected 3 objects but found %d", p.Count())
+	}
+	for _, f := range p.Fields() {
+		switch f.Path {
+		case "slot":
+			if f.Total != 24 || f.Min != 8 || f.Max != 8 {
+				t.Fatalf("bad slot sizes %v", f)
+			}
+		case "body":
+			// the fixed part has the offset of the body
+			if f.Total != total-3*76 {
+				t.Fatalf("bad body sizes %v", f)
+			}
+		case "body.attestations[*].signature":
+			if f.Total != signatures {
+				t.Fatalf("bad signature sizes %v", f)
+			}
+		}
+	}
+}
+
+func TestTraceDecode(t *testing.T) {
+	block := RandomBeaconBlock(rand.New(rand.NewSource(27)))
+	signature := bytes.Repeat([]byte{0xab}, 96)
+	block.Body.Attestations = []*Attestation{{
+		AggregationBits: []byte{0xff, 0x01},
+		Data:            RandomAttestationData(rand.New(rand.NewSource(28))),
+		Signature:       signature,
+	}}
+	buf, err := block.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := block.SchemaSSZ()
+	if _, err := ssz.TraceDecode(schema, buf); err != nil {
+		t.Fatal(err)
+	}
+
+	// the bitlist after the signature has no length bit
+	pos := bytes.Index(buf, signature) + 96
+	buf[pos+1] = 0
+
+	steps, err := ssz.TraceDecode(schema, buf)
+	if err != ssz.ErrBitlistNoLengthBit {
+		t.Fatalf("expected no length bit but found %v", err)
+	}
+	last := steps[len(steps)-1]
+	if last.Path != "body.attestations[0].aggregation_bits" || last.Err != err || last.Start != uint64(pos) || last.End != uint64(pos+2) {
+		t.Fatalf("bad failed step %v", last)
+	}
+	// the fields of the block and the offset of the body come first
+	if steps[0].Path != "" || steps[1].Path != "slot" || steps[4].Path != "body" || steps[4].Type != "offset" {
+		t.Fatal("bad steps before the failure")
+	}
+}
+
+func TestForkVariants(t *testing.T) {
+	obj := &ForkedHeader{Slot: 1, ParentRoot: make([]byte, 32), Extra: []byte{1, 2, 3}, Old: 2}
+
+	// phase0 does not have the field added in altair
+	s, err := ssz.Container().Uint64("slot").ByteVector("parent_root", 32).Uint64("old").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := obj.MarshalSSZPhase0()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != obj.SizeSSZPhase0() || len(buf) != 48 {
+		t.Fatalf("bad size %d", len(buf))
+	}
+	v, err := ssz.UnmarshalValue(s, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := obj.HashTreeRootPhase0()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root, err := v.HashTreeRoot(); err != nil || root != expected {
+		t.Fatal("bad root of the phase0 variant")
+	}
+	obj2 := new(ForkedHeader)
+	if err := obj2.UnmarshalSSZPhase0(buf); err != nil {
+		t.Fatal(err)
+	}
+	if obj2.Old != 2 || obj2.Extra != nil {
+		t.Fatal("bad phase0 unmarshal")
+	}
+
+	// capella has the fields of the latest fork
+	latest, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	capella, err := obj.MarshalSSZCapella()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(latest, capella) || len(latest) != 47 {
+		t.Fatal("bad capella variant")
+	}
+	if err := new(ForkedHeader).UnmarshalSSZAltair(latest); err == nil {
+		t.Fatal("the altair variant expects the removed field")
+	}
+}
+
+func TestMapGindex(t *testing.T) {
+	from := new(BeaconBlockHeader).SchemaSSZ()
+	// a new version of the header with a field in the middle, the tree of the fields is one level deeper
+	to, err := ssz.Container().
+		Uint64("slot").
+		Uint64("proposer_index").
+		ByteVector("parent_root", 32).
+		ByteVector("state_root", 32).
+		ByteVector("body_root", 32).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ssz.GindexMapping{
+		{Path: "slot", From: 4, To: 8, Same: true},
+		{Path: "parent_root", From: 5, To: 10, Same: true},
+		{Path: "state_root", From: 6, To: 11, Same: true},
+		{Path: "body_root", From: 7, To: 12, Same: true},
+		{Path: "proposer_index", To: 9},
+	}
+	found := ssz.MapGindices(from, to)
+	if len(found) != len(expected) {
+		t.Fatalf("expected %d mappings but found %d", len(expected), len(found))
+	}
+	for indx := range expected {
+		if found[indx] != expected[indx] {
+			t.Fatalf("bad mapping %v", found[indx])
+		}
+	}
+
+	// the elements of a list of headers with a bigger limit
+	fromList, _ := ssz.Container().List("headers", from, 16).Build()
+	toList, _ := ssz.Container().List("headers", to, 1024).Build()
+	for _, path := range []string{"headers", "headers[3]", "headers[15].body_root"} {
+		gindex, _, err := fromList.Gindex(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		toGindex, _, err := toList.Gindex(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res, err := ssz.MapGindex(fromList, toList, gindex); err != nil || res != toGindex {
+			t.Fatalf("%s: expected %d but found %d (%v)", path, toGindex, res, err)
+		}
+	}
+	// the length of the list
+	if res, err := ssz.MapGindex(fromList, toList, 3); err != nil || res != 3 {
+		t.Fatalf("bad gindex of the length %d (%v)", res, err)
+	}
+
+	// the removed field and the branches of the fields do not map
+	gindex, _, _ := to.Gindex("proposer_index")
+	if _, err := ssz.MapGindex(to, from, gindex); err != ssz.ErrGindexDiverges {
+		t.Fatalf("expected diverges but found %v", err)
+	}
+	if _, err := ssz.MapGindex(from, to, 2); err != ssz.ErrGindexDiverges {
+		t.Fatalf("expected diverges but found %v", err)
+	}
+	// an element beyond the limit of the old version
+	gindex, _, _ = toList.Gindex("headers[100]")
+	if _, err := ssz.MapGindex(toList, fromList, gindex); err != ssz.ErrGindexDiverges {
+		t.Fatalf("expected diverges but found %v", err)
+	}
+}
+
+func TestUnmarshalForward(t *testing.T) {
+	obj := RandomIndexedAttestation(rand.New(rand.NewSource(37)))
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a newer version with a fixed and a variable field at the end
+	newer, err := ssz.Container().
+		Field("attesting_indices", ssz.ListSchema(ssz.UintSchema(8), 2048)).
+		Field("data", new(AttestationData).SchemaSSZ()).
+		ByteVector("signature", 96).
+		Uint64("extra").
+		ByteList("blob", 64).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixed := uint64(228)
+	unknown := []byte{7, 0, 0, 0, 0, 0, 0, 0}
+	unknown = ssz.WriteOffset(unknown, int(uint64(len(buf))+12))
+	blob := []byte{1, 2, 3}
+
+	input := ssz.WriteOffset(nil, int(fixed+12))
+	input = append(input, buf[4:fixed]...)
+	input = append(input, unknown...)
+	input = append(input, buf[fixed:]...)
+	input = append(input, blob...)
+	if _, err := ssz.UnmarshalValue(newer, input); err != nil {
+		t.Fatal(err)
+	}
+	if err := new(IndexedAttestation).UnmarshalSSZ(input); err == nil {
+		t.Fatal("the unknown fields should fail without the forward mode")
+	}
+
+	obj2 := new(IndexedAttestation)
+	tail, err := ssz.UnmarshalForward(obj2, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tail, append(unknown, blob...)) {
+		t.Fatal("bad tail")
+	}
+	if !deepEqual(obj, obj2) {
+		t.Fatal("bad known fields")
+	}
+
+	// the encodings of the type have no tail
+	if tail, err := ssz.UnmarshalForward(new(IndexedAttestation), buf); err != nil || len(tail) != 0 {
+		t.Fatal("expected an empty tail")
+	}
+}
+
+func TestVerifyProofs(t *testing.T) {
+	r := rand.New(rand.NewSource(38))
+	state := RandomBeaconState(r)
+	for i := 0; i < 64; i++ {
+		state.Validators = append(state.Validators, RandomValidator(r))
+	}
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	view, err := ssz.NewViewFromSSZ(state.SchemaSSZ(), buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := []string{"slot", "fork.epoch"}
+	for indx := range state.Validators {
+		paths = append(paths, "validators["+strconv.Itoa(indx)+"].effective_balance")
+	}
+	proofs := []*ssz.Proof{}
+	for _, path := range paths {
+		v, err := view.Path(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := v.Prove()
+		if err != nil {
+			t.Fatal(err)
+		}
+		proofs = append(proofs, proof)
+	}
+	if err := ssz.VerifyProofs(root, proofs); err != nil {
+		t.Fatal(err)
+	}
+
+	// a wrong root and a wrong leaf
+	if err := ssz.VerifyProofs([32]byte{1}, proofs); err != ssz.ErrInvalidProof {
+		t.Fatalf("expected invalid proof but found %v", err)
+	}
+	last := proofs[len(proofs)-1]
+	last.Leaf = append([]byte{}, last.Leaf...)
+	last.Leaf[0] ^= 1
+	if err := ssz.VerifyProofs(root, proofs); err != ssz.ErrInvalidProof {
+		t.Fatalf("expected invalid proof but found %v", err)
+	}
+}
+
+func TestProofEncoding(t *testing.T) {
+	r := rand.New(rand.NewSource(39))
+	state := RandomBeaconState(r)
+	for i := 0; i < 16; i++ {
+		state.Validators = append(state.Validators, RandomValidator(r))
+	}
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	view, err := ssz.NewViewFromSSZ(state.SchemaSSZ(), buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the proofs are decoded as any other SSZ object
+	proof, err := ssz.QueryProof(state.SchemaSSZ(), buf, "validators[3].pubkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := proof.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof2 := new(ssz.Proof)
+	if err := proof2.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if err := ssz.VerifyProof(root, proof2); err != nil {
+		t.Fatal(err)
+	}
+	v, err := ssz.UnmarshalValue(proof.SchemaSSZ(), enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := proof.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found, err := v.HashTreeRoot(); err != nil || found != expected {
+		t.Fatal("bad root of the proof")
+	}
+
+	multi, err := view.ProveMulti("slot", "validators[3].pubkey", "validators[4].pubkey", "validators[15]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ssz.VerifyMultiproof(root, multi); err != nil {
+		t.Fatal(err)
+	}
+	enc, err = multi.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	multi2 := new(ssz.Multiproof)
+	if err := multi2.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if err := ssz.VerifyMultiproof(root, multi2); err != nil {
+		t.Fatal(err)
+	}
+	if multi2.Hashes = multi2.Hashes[1:]; ssz.VerifyMultiproof(root, multi2) != ssz.ErrInvalidProof {
+		t.Fatal("expected invalid proof without a helper")
+	}
+}
+
+func TestEVMProof(t *testing.T) {
+	obj := RandomBeaconBlock(rand.New(rand.NewSource(40)))
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := ssz.QueryProof(obj.SchemaSSZ(), buf, "body.randao_reveal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	evm, err := proof.EVM()
+	if err != nil {
+		t.Fatal(err)
+	}
+	abi := evm.ABIEncode()
+	if len(abi) != 32*(4+len(proof.Hashes)) {
+		t.Fatalf("bad size %d", len(abi))
+	}
+
+	// the root computed as a Solidity verifier does with the arguments
+	word := func(i int) []byte {
+		return abi[32*i : 32*(i+1)]
+	}
+	gindex := new(big.Int).SetBytes(word(0))
+	if gindex.Uint64() != proof.Index || new(big.Int).SetBytes(word(2)).Uint64() != 96 {
+		t.Fatal("bad head")
+	}
+	node := append([]byte{}, word(1)...)
+	for i := 0; i < int(new(big.Int).SetBytes(word(3)).Uint64()); i++ {
+		var h [32]byte
+		if gindex.Bit(0) == 1 {
+			h = sha256.Sum256(append(append([]byte{}, word(4+i)...), node...))
+		} else {
+			h = sha256.Sum256(append(append([]byte{}, node...), word(4+i)...))
+		}
+		node = h[:]
+		gindex.Rsh(gindex, 1)
+	}
+	if !bytes.Equal(node, root[:]) {
+		t.Fatal("bad root")
+	}
+
+	text, err := json.Marshal(evm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(text), `"gindex":"0x`+hex.EncodeToString(word(0))+`"`) {
+		t.Fatalf("bad json %s", text)
+	}
+}
+
+func TestHasherMaxRetained(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(41)))
+	for i := 0; i < 1024; i++ {
+		state.Validators = append(state.Validators, RandomValidator(rand.New(rand.NewSource(int64(i)))))
+	}
+	root, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hh := ssz.NewHasher()
+	hh.SetMaxRetained(4096)
+	if err := state.HashTreeRootWith(hh); err != nil {
+		t.Fatal(err)
+	}
+	if hh.Retained() <= 4096 {
+		t.Fatal("expected the buffers to grow")
+	}
+	hh.Reset()
+	if hh.Retained() > 4096 {
+		t.Fatalf("buffers of %d bytes retained", hh.Retained())
+	}
+
+	// the hasher is the same after the reset
+	if err := state.HashTreeRootWith(hh); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := hh.HashRoot(); err != nil || res != root {
+		t.Fatal("bad root")
+	}
+}
+
+// validatorPool is an ssz.ObjectPool of the validators of a state
+type validatorPool []*Validator
+
+func (p *validatorPool) Get(typ interface{}) interface{} {
+	if _, ok := typ.(*Validator); !ok || len(*p) == 0 {
+		return nil
+	}
+	v := (*p)[len(*p)-1]
+	*p = (*p)[:len(*p)-1]
+	return v
+}
+
+func TestUnmarshalWithPool(t *testing.T) {
+	rng := rand.New(rand.NewSource(43))
+	state := RandomBeaconState(rng)
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the validators of another state are recycled
+	pool := validatorPool{}
+	for range state.Validators {
+		pool = append(pool, RandomValidator(rng))
+	}
+	recycled := map[*Validator]bool{}
+	for _, v := range pool {
+		recycled[v] = true
+	}
+
+	obj := new(BeaconState)
+	if err := ssz.UnmarshalWithPool(obj, buf, &pool); err != nil {
+		t.Fatal(err)
+	}
+	if len(pool) != 0 {
+		t.Fatal("expected the validators of the pool")
+	}
+	for _, v := range obj.Validators {
+		if !recycled[v] {
+			t.Fatal("validator not recycled")
+		}
+	}
+	if !deepEqual(state, obj) {
+		t.Fatal("bad decode")
+	}
+
+	// the objects are released to the pool of their type
+	types := new(ssz.TypePool)
+	for _, v := range obj.Validators {
+		types.Put(v)
+	}
+	if types.Get((*Checkpoint)(nil)) != nil {
+		t.Fatal("expected no checkpoints")
+	}
+	types.Put(&Checkpoint{})
+	if c := types.Get((*Checkpoint)(nil)); c != nil {
+		if _, ok := c.(*Checkpoint); !ok {
+			t.Fatal("expected a checkpoint")
+		}
+	}
+	obj2 := new(BeaconState)
+	if err := ssz.UnmarshalWithPool(obj2, buf, types); err != nil || !deepEqual(state, obj2) {
+		t.Fatal("bad decode")
+	}
+}
+
+func TestMarshalBuffers(t *testing.T) {
+	rng := rand.New(rand.NewSource(44))
+	blob := RandomBlob(rng)
+	blob.Data = make([]byte, 4096)
+	rng.Read(blob.Data)
+
+	for _, obj := range []ssz.Marshaler{blob, RandomBeaconState(rng), RandomBeaconBlock(rng)} {
+		bufs, err := ssz.MarshalBuffers(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(bytes.Join(bufs, nil), expected) {
+			t.Fatal("bad encoding")
+		}
+	}
+
+	// the large fields are not copied
+	bufs, err := ssz.MarshalBuffers(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bufs) != 4 || &bufs[1][0] != &blob.Blob[0] || &bufs[3][0] != &blob.Data[0] {
+		t.Fatal("expected the fields to be referenced")
+	}
+}
+
+func TestMaxSizeSSZ(t *testing.T) {
+	// aggregation bits (2048 bits and the length bit), offset, data and signature
+	if size := new(Attestation).MaxSizeSSZ(); size != 257+4+128+96 {
+		t.Fatalf("bad max size %d", size)
+	}
+
+	rng := rand.New(rand.NewSource(46))
+	for i := 0; i < 20; i++ {
+		block := RandomBeaconBlock(rng)
+		if uint64(block.SizeSSZ()) > block.MaxSizeSSZ() {
+			t.Fatal("size above the max size")
+		}
+	}
+	if new(Checkpoint).MaxSizeSSZ() != uint64(new(Checkpoint).SizeSSZ()) {
+		t.Fatal("bad max size of a fixed container")
+	}
+}
+
+func TestHashTreeRootGindex(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(43)))
+	for len(state.PreviousEpochAttestations) == 0 {
+		state.PreviousEpochAttestations = append(state.PreviousEpochAttestations, RandomPendingAttestation(rand.New(rand.NewSource(1))))
+	}
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	view, err := ssz.NewViewFromSSZ(state.SchemaSSZ(), buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree, err := view.Node()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := ssz.HashTreeRootPath(state, "validators")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, _ := ssz.HashWithDefaultHasher(ssz.HashFunc(func(hh *ssz.Hasher) error {
+		indx := hh.Index()
+		for _, v := range state.Validators {
+			if err := v.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(indx, uint64(len(state.Validators)), 1099511627776)
+		return nil
+	})); root != expected {
+		t.Fatal("bad root of the validators")
+	}
+
+	// the roots of the values, of their inner nodes and of the top of the tree
+	gindices := []uint64{}
+	for g := uint64(1); g < 64; g++ {
+		gindices = append(gindices, g)
+	}
+	for _, path := range []string{"validators", "validators[3]", "validators[3].pubkey", "balances", "justification_bits", "previous_epoch_attestations[0].aggregation_bits", "fork.epoch"} {
+		g, _, err := state.SchemaSSZ().Gindex(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gindices = append(gindices, g, g>>1, g>>2, g<<1, g<<1|1, g<<2, g<<3|1)
+	}
+	for _, gindex := range gindices {
+		res, err := ssz.HashTreeRootGindex(state, gindex)
+		if err != nil {
+			// the children of the leaves
+			continue
+		}
+		node, err := tree.Get(gindex)
+		if err != nil {
+			t.Fatalf("gindex %d: %v", gindex, err)
+		}
+		if res != node.Root() {
+			t.Fatalf("bad root of the gindex %d", gindex)
+		}
+	}
+}
+
+type countingCache struct {
+	ssz.RootCache
+	hits, misses int
+}
+
+func (c *countingCache) Get(key [32]byte) ([32]byte, bool) {
+	root, ok := c.RootCache.Get(key)
+	if ok {
+		c.hits++
+	} else {
+		c.misses++
+	}
+	return root, ok
+}
+
+func TestFileRootCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fastssz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "roots")
+
+	state := RandomBeaconState(rand.New(rand.NewSource(44)))
+	expected, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache, err := ssz.OpenFileRootCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRootWithCache(state, cache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("wrong root")
+	}
+	if err := cache.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the roots are found after the cache is opened again
+	cache, err = ssz.OpenFileRootCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cache.Close()
+	counting := &countingCache{RootCache: cache}
+	if root, err = ssz.HashTreeRootWithCache(state, counting); err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("wrong root")
+	}
+	if counting.misses != 0 || counting.hits == 0 {
+		t.Fatalf("expected only hits: %d hits %d misses", counting.hits, counting.misses)
+	}
+
+	// a changed validator is hashed again
+	state.Validators[0].EffectiveBalance++
+	if expected, err = state.HashTreeRoot(); err != nil {
+		t.Fatal(err)
+	}
+	counting.hits, counting.misses = 0, 0
+	if root, err = ssz.HashTreeRootWithCache(state, counting); err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("wrong root")
+	}
+	if counting.misses != 1 {
+		t.Fatalf("expected one miss: %d", counting.misses)
+	}
+
+	// the root of the previous validator is removed by the compaction after the next root
+	size := cache.Len()
+	if err := cache.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ssz.HashTreeRootWithCache(state, cache); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if cache.Len() != size-1 {
+		t.Fatalf("expected %d roots after the compaction: %d", size-1, cache.Len())
+	}
+}
+
+// cancelReader cancels the context once n bytes have been read
+type cancelReader struct {
+	r      io.Reader
+	n      int
+	cancel func()
+}
+
+func (c *cancelReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if c.n -= n; c.n <= 0 {
+		c.cancel()
+	}
+	return n, err
+}
+
+func TestHashTreeRootContext(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(45)))
+	expected, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRootContext(context.Background(), state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("wrong root")
+	}
+
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &cancelReader{r: bytes.NewReader(buf), n: len(buf) / 2, cancel: cancel}
+	if _, err := ssz.HashTreeRootReaderContext(ctx, state.SchemaSSZ(), r, uint64(len(buf))); err != context.Canceled {
+		t.Fatalf("expected the context to be canceled: %v", err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	r = &cancelReader{r: bytes.NewReader(buf), n: len(buf) / 2, cancel: cancel}
+	if err := ssz.NewDecoder(r).DecodeContext(ctx, new(BeaconState), uint64(len(buf))); err != context.Canceled {
+		t.Fatalf("expected the context to be canceled: %v", err)
+	}
+	if err := ssz.NewDecoder(bytes.NewReader(buf)).DecodeContext(context.Background(), new(BeaconState), uint64(len(buf))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnmarshalNoCopy(t *testing.T) {
+	state := RandomBeaconState(rand.New(rand.NewSource(46)))
+	for len(state.Validators) == 0 {
+		state.Validators = append(state.Validators, RandomValidator(rand.New(rand.NewSource(1))))
+	}
+	buf, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj := new(BeaconState)
+	if err := ssz.UnmarshalNoCopy(obj, buf); err != nil {
+		t.Fatal(err)
+	}
+	res, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res, buf) {
+		t.Fatal("bad unmarshal")
+	}
+
+	// the byte fields alias the input until the object is copied
+	cpy, err := obj.CopySSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey := append([]byte{}, obj.Validators[0].Pubkey...)
+	for i := range buf {
+		buf[i] ^= 0xff
+	}
+	if bytes.Equal(obj.Validators[0].Pubkey, pubkey) {
+		t.Fatal("expected the pubkey to alias the input")
+	}
+	if !bytes.Equal(cpy.Validators[0].Pubkey, pubkey) {
+		t.Fatal("expected the copy to be detached from the input")
+	}
+	if res, err = cpy.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	if expected, _ := state.MarshalSSZ(); !bytes.Equal(res, expected) {
+		t.Fatal("bad copy")
+	}
+
+	// an append to an aliased field does not overwrite the rest of the input
+	next := obj.Validators[0].WithdrawalCredentials[0]
+	obj.Validators[0].Pubkey = append(obj.Validators[0].Pubkey, 1)
+	if obj.Validators[0].WithdrawalCredentials[0] != next {
+		t.Fatal("the append modified the input")
+	}
+}
+
+func TestSequencesOfBitfields(t *testing.T) {
+	for i := int64(0); i < 20; i++ {
+		obj := RandomCommitteeBits(rand.New(rand.NewSource(i)))
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := new(CommitteeBits)
+		if err := res.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		if res, err := res.MarshalSSZ(); err != nil || !bytes.Equal(res, buf) {
+			t.Fatal("bad unmarshal")
+		}
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := ssz.HashTreeRootReader(obj.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != expected {
+			t.Fatal("wrong root")
+		}
+	}
+
+	// the bitlists are validated against the limit of the elements
+	obj := RandomCommitteeBits(rand.New(rand.NewSource(1)))
+	obj.Previous[0] = make([]byte, 300)
+	obj.Previous[0][299] = 1
+	if _, err := obj.MarshalSSZ(); err == nil {
+		t.Fatal("expected an error for a bitlist over the limit")
+	}
+
+	// the bits of a bitvector above its length are not set
+	obj = RandomCommitteeBits(rand.New(rand.NewSource(1)))
+	obj.Justifications = append(obj.Justifications, []byte{0x10})
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := new(CommitteeBits).UnmarshalSSZ(buf); err == nil {
+		t.Fatal("expected an error for a bitvector with bits above its length")
+	}
+}
+
+func TestPointerToBytes(t *testing.T) {
+	// the nil pointers are encoded as the zero value
+	root, extra := make([]byte, 32), []byte{}
+	expected, err := (&OptionalBytes{Root: &root, Extra: &extra}).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj := new(OptionalBytes)
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, expected) || obj.SizeSSZ() != len(buf) {
+		t.Fatal("expected the nil pointers to be encoded as the zero value")
+	}
+
+	obj = RandomOptionalBytes(rand.New(rand.NewSource(1)))
+	if buf, err = obj.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	res := new(OptionalBytes)
+	if err := res.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(*res.Root, *obj.Root) || !bytes.Equal(*res.Extra, *obj.Extra) {
+		t.Fatal("bad unmarshal")
+	}
+	if a, err := obj.HashTreeRoot(); err != nil {
+		t.Fatal(err)
+	} else if b, _ := ssz.HashTreeRootReader(obj.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf))); a != b {
+		t.Fatal("wrong root")
+	}
+}
+
+func TestTripleNestedBytes(t *testing.T) {
+	for i := int64(0); i < 20; i++ {
+		obj := RandomSlotRoots(rand.New(rand.NewSource(i)))
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := new(SlotRoots)
+		if err := res.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		if res, err := res.MarshalSSZ(); err != nil || !bytes.Equal(res, buf) {
+			t.Fatal("bad unmarshal")
+		}
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := ssz.HashTreeRootReader(obj.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != expected {
+			t.Fatal("wrong root")
+		}
+	}
+
+	// the limits of the inner dimensions are checked
+	obj := RandomSlotRoots(rand.New(rand.NewSource(1)))
+	obj.PerSlot = [][][]byte{make([][]byte, 17)}
+	for indx := range obj.PerSlot[0] {
+		obj.PerSlot[0][indx] = make([]byte, 32)
+	}
+	if _, err := obj.MarshalSSZ(); err == nil {
+		t.Fatal("expected an error for an inner list over its limit")
+	}
+	obj.PerSlot = nil
+	obj.Blobs[1] = [][]byte{make([]byte, 65)}
+	if _, err := obj.MarshalSSZ(); err == nil {
+		t.Fatal("expected an error for bytes over their limit")
+	}
+
+	// the limit of the tuple form of the ssz-max tag
+	if schema := obj.SchemaSSZ().Fields[3].Schema.String(); schema != "List[Bytes32, 1024]" {
+		t.Fatalf("unexpected schema %s", schema)
+	}
+}
+
+func TestListOfByteLists(t *testing.T) {
+	obj := &Transactions{BlockNumber: 1, Transactions: [][]byte{{0x1, 0x2}, {}, make([]byte, 300)}}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the fixed part, the offsets of the transactions and their bytes
+	if len(buf) != 8+4+3*4+2+300 {
+		t.Fatalf("unexpected size %d", len(buf))
+	}
+	res := new(Transactions)
+	if err := res.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Transactions) != 3 || !bytes.Equal(res.Transactions[0], []byte{0x1, 0x2}) || len(res.Transactions[2]) != 300 {
+		t.Fatal("bad unmarshal")
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ssz.HashTreeRootReader(obj.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("wrong root")
+	}
+	if schema := obj.SchemaSSZ().Fields[1].Schema.String(); schema != "List[ByteList[1073741824], 1048576]" {
+		t.Fatalf("unexpected schema %s", schema)
+	}
+}
+
+func TestListsOfBools(t *testing.T) {
+	obj := &Votes{
+		Voted:  []bool{true, false, true},
+		Quorum: []bool{false, true, true, false},
+		Rounds: [][]bool{{true}, {}, {false, true}},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := new(Votes)
+	if err := res.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Voted) != 3 || !res.Voted[2] || !res.Quorum[1] || res.Quorum[3] || len(res.Rounds) != 3 || !res.Rounds[2][1] {
+		t.Fatal("bad unmarshal")
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ssz.HashTreeRootReader(obj.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("wrong root")
+	}
+
+	// the booleans are either 0 or 1
+	invalid := append([]byte{}, buf...)
+	invalid[4] = 2
+	if err := new(Votes).UnmarshalSSZ(invalid); err != ssz.ErrInvalidBool {
+		t.Fatalf("expected invalid bool but found %v", err)
+	}
+	invalid = append([]byte{}, buf...)
+	invalid[len(invalid)-1] = 2
+	if err := new(Votes).UnmarshalSSZ(invalid); err != ssz.ErrInvalidBool {
+		t.Fatalf("expected invalid bool but found %v", err)
+	}
+}
+
+func TestTimeFields(t *testing.T) {
+	obj := &Event{ID: 1, Created: time.Unix(1700000000, 0).UTC(), Updated: time.Unix(1700000000, 123456789).UTC()}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ssz.UnmarshallUint64(buf[8:16]) != 1700000000 || ssz.UnmarshallUint64(buf[16:24]) != 1700000000123 {
+		t.Fatal("bad timestamps")
+	}
+	res := new(Event)
+	if err := res.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !res.Created.Equal(obj.Created) || !res.Updated.Equal(time.Unix(1700000000, 123000000)) {
+		t.Fatal("bad unmarshal")
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ssz.HashTreeRootReader(obj.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("wrong root")
+	}
+
+	// the zero time is encoded as zero
+	buf, err = new(Event).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := res.UnmarshalSSZ(buf); err != nil || !res.Created.IsZero() || !res.Updated.IsZero() {
+		t.Fatal("bad zero time")
+	}
+
+	// the times before the unix epoch and the timestamps over the int64 are not valid
+	obj.Created = time.Unix(-1, 0)
+	if _, err := obj.MarshalSSZ(); err != ssz.ErrTimeRange {
+		t.Fatalf("expected time range error but found %v", err)
+	}
+	buf[15] = 0x80
+	if err := res.UnmarshalSSZ(buf); err != ssz.ErrTimeRange {
+		t.Fatalf("expected time range error but found %v", err)
+	}
+}
+
+func TestUnmarshalReuse(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	obj := new(BeaconState)
+	var buf []byte
+	for i := 0; i < 10; i++ {
+		// the lists of the previous state are reused for the next one
+		expected := RandomBeaconState(rng)
+		var err error
+		if buf, err = expected.MarshalSSZ(); err != nil {
+			t.Fatal(err)
+		}
+		if err := obj.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		res, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(res, buf) {
+			t.Fatal("bad unmarshal into a reused object")
+		}
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		if err := obj.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Fatalf("expected no allocations but found %f", allocs)
+	}
+}
+
+func TestConsensusContainers(t *testing.T) {
+	version := [4]byte{1, 2, 3, 4}
+	var genesisValidatorsRoot [32]byte
+	genesisValidatorsRoot[0] = 5
+
+	forkData := &consensus.ForkData{CurrentVersion: version[:], GenesisValidatorsRoot: genesisValidatorsRoot[:]}
+	root, err := forkData.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ssz.ComputeForkDataRoot(version, genesisValidatorsRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("bad fork data root")
+	}
+
+	// the containers have the same encoding as the ones of the spectests
+	checkpoint := &consensus.Checkpoint{Epoch: 10, Root: genesisValidatorsRoot[:]}
+	buf, err := checkpoint.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj := new(Checkpoint)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Epoch != 10 || !bytes.Equal(obj.Root, checkpoint.Root) {
+		t.Fatal("bad checkpoint")
+	}
+
+	domain, err := ssz.ComputeDomain([4]byte{7}, version, genesisValidatorsRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	objRoot, err := checkpoint.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingData := &consensus.SigningData{ObjectRoot: objRoot[:], Domain: domain[:]}
+	if root, err = signingData.HashTreeRoot(); err != nil {
+		t.Fatal(err)
+	}
+	if expected, err = ssz.ComputeSigningRoot(obj, domain); err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("bad signing root")
+	}
+}
+
+func TestSpecTypes(t *testing.T) {
+	// the containers of the corpus that did not change since its spec release
+	types := map[string]testCallback{
+		"Attestation":         func() codec { return new(spectypes.Attestation) },
+		"AttestationData":     func() codec { return new(spectypes.AttestationData) },
+		"AttesterSlashing":    func() codec { return new(spectypes.AttesterSlashing) },
+		"Checkpoint":          func() codec { return new(spectypes.Checkpoint) },
+		"Deposit":             func() codec { return new(spectypes.Deposit) },
+		"DepositData":         func() codec { return new(spectypes.DepositData) },
+		"DepositMessage":      func() codec { return new(spectypes.DepositMessage) },
+		"Eth1Data":            func() codec { return new(spectypes.Eth1Data) },
+		"Fork":                func() codec { return new(spectypes.Fork) },
+		"IndexedAttestation":  func() codec { return new(spectypes.IndexedAttestation) },
+		"PendingAttestation":  func() codec { return new(spectypes.PendingAttestation) },
+		"SignedVoluntaryExit": func() codec { return new(spectypes.SignedVoluntaryExit) },
+		"Validator":           func() codec { return new(spectypes.Validator) },
+		"VoluntaryExit":       func() codec { return new(spectypes.VoluntaryExit) },
+	}
+	for name, base := range types {
+		for _, f := range walkPath(t, filepath.Join(embeddedTestsPath, name)) {
+			checkSSZEncoding(t, f, base)
+		}
+	}
+
+	// the fork functions encode the fields of each fork
+	state := &spectypes.BeaconState{
+		PreviousEpochParticipation:   []byte{1, 2},
+		LatestExecutionPayloadHeader: new(spectypes.ExecutionPayloadHeader),
+	}
+	sizes := map[string]int{
+		"phase0": state.SizeSSZPhase0(),
+		"altair": state.SizeSSZAltair(),
+		"deneb":  state.SizeSSZ(),
+	}
+	// the fixed sizes of the mainnet states, with the participation of the altair states
+	expected := map[string]int{
+		"phase0": 2687377,
+		"altair": 2736629 + 2,
+		"deneb":  2737237 + 2,
+	}
+	for fork, size := range sizes {
+		if size != expected[fork] {
+			t.Fatalf("expected %d bytes in %s but found %d", expected[fork], fork, size)
+		}
+	}
+}
+
+func TestByteArrays(t *testing.T) {
+	obj := &Arrays{Pubkeys: [][48]byte{{1}, {2}}, Hashes: []Hash{{3}, {4}}}
+	obj.Root[0], obj.Hash[31] = 5, 6
+
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != 32+32+4+32*2+48*2 {
+		t.Fatalf("bad size %d", len(buf))
+	}
+	obj2 := new(Arrays)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !deepEqual(obj, obj2) {
+		t.Fatal("bad")
+	}
+
+	// the arrays are hashed as the fixed bytes of the schema
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ssz.HashTreeRootReader(obj.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("bad root")
+	}
+}
+
+func TestDecodeDynamicLength(t *testing.T) {
+	cases := []struct {
+		buf    []byte
+		length int
+		err    error
+	}{
+		{nil, 0, nil},
+		{[]byte{8, 0, 0, 0, 0, 0, 0, 0}, 2, nil},
+		{[]byte{1}, 0, fmt.Errorf("not enough data")},
+		{[]byte{0, 0, 0, 0}, 0, ssz.ErrOffset},
+		{[]byte{5, 0, 0, 0, 0}, 0, ssz.ErrOffset},
+		{[]byte{8, 0, 0, 0}, 0, ssz.ErrOffset},
+		// an offset that is negative as a 32 bits int
+		{[]byte{0xfc, 0xff, 0xff, 0xff}, 0, ssz.ErrOffset},
+		{[]byte{12, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, ssz.ErrListTooBig},
+	}
+	for _, c := range cases {
+		length, err := ssz.DecodeDynamicLength(c.buf, 2)
+		if fmt.Sprint(err) != fmt.Sprint(c.err) || length != c.length {
+			t.Fatalf("%x: expected %d and %v but found %d and %v", c.buf, c.length, c.err, length, err)
+		}
+	}
+}
+
+func TestUnmarshalDynamicOffsets(t *testing.T) {
+	cases := []struct {
+		buf    []byte
+		length int
+		items  []string
+		err    error
+	}{
+		{[]byte{8, 0, 0, 0, 9, 0, 0, 0, 'a', 'b'}, 2, []string{"a", "b"}, nil},
+		{[]byte{8, 0, 0, 0, 8, 0, 0, 0}, 2, []string{"", ""}, nil},
+		// the first offset is zero
+		{[]byte{0, 0, 0, 0, 8, 0, 0, 0}, 2, nil, ssz.ErrOffset},
+		// the first offset is not divisible by 4
+		{[]byte{9, 0, 0, 0, 9, 0, 0, 0, 'a'}, 2, nil, ssz.ErrOffset},
+		// the first offset does not match the length
+		{[]byte{12, 0, 0, 0, 12, 0, 0, 0, 12, 0, 0, 0}, 2, nil, ssz.ErrOffset},
+		// an offset is past the end
+		{[]byte{8, 0, 0, 0, 11, 0, 0, 0, 'a', 'b'}, 2, nil, ssz.ErrOffset},
+		{[]byte{12, 0, 0, 0}, 1, nil, ssz.ErrOffset},
+		// the offsets decrease
+		{[]byte{12, 0, 0, 0, 13, 0, 0, 0, 12, 0, 0, 0, 'a'}, 3, nil, ssz.ErrOffset},
+		// the input does not have all the offsets
+		{[]byte{8, 0, 0, 0}, 2, nil, ssz.ErrOffset},
+	}
+	for _, c := range cases {
+		items := []string{}
+		err := ssz.UnmarshalDynamic(c.buf, c.length, func(indx int, b []byte) error {
+			items = append(items, string(b))
+			return nil
+		})
+		if err != c.err {
+			t.Fatalf("%x: expected %v but found %v", c.buf, c.err, err)
+		}
+		if err == nil && strings.Join(items, ",") != strings.Join(c.items, ",") {
+			t.Fatalf("%x: expected %q but found %q", c.buf, c.items, items)
+		}
+	}
+}
+
+func TestNilPointerFields(t *testing.T) {
+	// a nil pointer is encoded and hashed as the zero value of the struct
+	obj := ByValue{A: 1}
+	zero := ByValue{A: 1, O: &Outer{}}
+
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := zero.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, expected) || obj.SizeSSZ() != len(expected) {
+		t.Fatal("bad encoding of the nil pointer")
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRoot, err := zero.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expectedRoot {
+		t.Fatal("bad root of the nil pointer")
+	}
+
+	// the zero values without the fixed bytes fail instead of panicking
+	slashing := new(AttesterSlashing)
+	if _, err := slashing.MarshalSSZ(); err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, err := slashing.HashTreeRoot(); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestStructValues(t *testing.T) {
+	obj := RandomValues(rand.New(rand.NewSource(1)))
+
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj2 := new(Values)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !deepEqual(obj, obj2) {
+		t.Fatal("bad decode")
+	}
+
+	// the structs are hashed as the containers of the schema
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ssz.HashTreeRootReader(obj.SchemaSSZ(), bytes.NewReader(buf), uint64(len(buf)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("bad root")
+	}
+
+	// the values in the slices have value receivers
+	for _, v := range obj.Inners {
+		if _, err := v.MarshalSSZ(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
 func TestFuzzUnmarshalAppend(t *testing.T) {
 	checkIsFuzzEnabled(t)
 
@@ -217,7 +2971,26 @@ func min(i, j int) int {
 	return j
 }
 
+func TestSpecEmbedded(t *testing.T) {
+	// synthetic corpus with the minimal preset that does not require the spec tests
+	files := readDir(t, embeddedTestsPath)
+	for _, f := range files {
+		name := filepath.Base(f)
+
+		base, ok := codecs[name]
+		if !ok {
+			t.Fatalf("name %s not found", name)
+		}
+
+		for _, f := range walkPath(t, f) {
+			checkSSZEncoding(t, f, base)
+		}
+	}
+}
+
 func TestSpecMinimal(t *testing.T) {
+	skipIfNoSpecTests(t)
+
 	files := readDir(t, filepath.Join(testsPath, "/minimal/phase0/ssz_static"))
 	for _, f := range files {
 		spl := strings.Split(f, "/")
@@ -235,6 +3008,8 @@ func TestSpecMinimal(t *testing.T) {
 }
 
 func TestSpecMainnet(t *testing.T) {
+	skipIfNoSpecTests(t)
+
 	files := readDir(t, filepath.Join(testsPath, "/mainnet/phase0/ssz_static"))
 	for _, f := range files {
 		spl := strings.Split(f, "/")
@@ -276,6 +3051,37 @@ func checkSSZEncoding(t *testing.T, f string, base testCallback) {
 	if !deepEqual(obj, obj2) {
 		t.Fatal("bad")
 	}
+
+	// Root
+	expectedRoot := readRoot(t, f)
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expectedRoot {
+		t.Fatalf("expected root %x but found %x", expectedRoot, root)
+	}
+}
+
+// readRoot reads the root of the roots file of the test case
+func readRoot(t *testing.T, path string) [32]byte {
+	var root [32]byte
+	raw, err := ioutil.ReadFile(filepath.Join(path, rootsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roots struct {
+		Root string `json:"root"`
+	}
+	if err := yaml.Unmarshal(raw, &roots); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := hex.DecodeString(strings.TrimPrefix(roots.Root, "0x"))
+	if err != nil || len(buf) != 32 {
+		t.Fatalf("bad root '%s'", roots.Root)
+	}
+	copy(root[:], buf)
+	return root
 }
 
 const benchmarkTestCase = "../eth2.0-spec-tests/tests/mainnet/phase0/ssz_static/BeaconBlock/ssz_random/case_4"
@@ -359,13 +3165,21 @@ func BenchmarkUnMarshalFast(b *testing.B) {
 }
 
 const (
-	testsPath      = "../eth2.0-spec-tests/tests"
-	serializedFile = "serialized.ssz"
-	valueFile      = "value.yaml"
-	rootsFile      = "roots.yaml"
+	testsPath         = "../eth2.0-spec-tests/tests"
+	embeddedTestsPath = "./testdata/synthetic"
+	serializedFile    = "serialized.ssz"
+	valueFile         = "value.yaml"
+	rootsFile         = "roots.yaml"
 )
 
-func walkPath(t *testing.T, path string) (res []string) {
+// skipIfNoSpecTests skips the test if the eth2.0-spec-tests submodule has not been downloaded
+func skipIfNoSpecTests(t *testing.T) {
+	if _, err := os.Stat(testsPath); err != nil {
+		t.Skip("spec tests not found, run 'git submodule update --init'")
+	}
+}
+
+func walkPath(t testing.TB, path string) (res []string) {
 	err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -0,0 +1,37 @@
+//go:build go1.21
+// +build go1.21
+
+package spectests
+
+import (
+	"bytes"
+	"log/slog"
+	"math/rand"
+	"strings"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+func TestSetTraceLogger(t *testing.T) {
+	var logs bytes.Buffer
+	ssz.SetTraceLogger(slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	defer ssz.SetTraceLogger(nil)
+
+	buf, err := RandomBeaconBlock(rand.New(rand.NewSource(29))).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the offset of the body is not the end of the fixed part
+	buf[72] = 0
+	if err := ssz.UnmarshalFromReader(bytes.NewReader(buf), new(BeaconBlock), uint64(len(buf))); err == nil {
+		t.Fatal("bad offset expected to fail")
+	}
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected 5 steps but found %d", len(lines))
+	}
+	if last := lines[4]; !strings.Contains(last, "field=body type=offset start=72 end=76 offset=") || !strings.Contains(last, "err=") {
+		t.Fatalf("bad failed step: %s", last)
+	}
+}
@@ -0,0 +1,333 @@
+// Package spectypes has the generated encodings of the containers of the consensus specs with
+// the mainnet preset, from phase0 to deneb, for the tools that do not have their own types.
+// The containers that change between the forks have the 'ssz-fork' tags, then the functions
+// without suffix encode the deneb containers and the functions of each fork (i.e. UnmarshalSSZAltair
+// or HashTreeRootCapella) the containers of the fork.
+package spectypes
+
+// phase0
+
+type Fork struct {
+	PreviousVersion []byte `json:"previous_version" ssz-size:"4"`
+	CurrentVersion  []byte `json:"current_version" ssz-size:"4"`
+	Epoch           uint64 `json:"epoch"`
+}
+
+type ForkData struct {
+	CurrentVersion        []byte `json:"current_version" ssz-size:"4"`
+	GenesisValidatorsRoot []byte `json:"genesis_validators_root" ssz-size:"32"`
+}
+
+type SigningData struct {
+	ObjectRoot []byte `json:"object_root" ssz-size:"32"`
+	Domain     []byte `json:"domain" ssz-size:"32"`
+}
+
+type Checkpoint struct {
+	Epoch uint64 `json:"epoch"`
+	Root  []byte `json:"root" ssz-size:"32"`
+}
+
+type Validator struct {
+	Pubkey                     []byte `json:"pubkey" ssz-size:"48"`
+	WithdrawalCredentials      []byte `json:"withdrawal_credentials" ssz-size:"32"`
+	EffectiveBalance           uint64 `json:"effective_balance"`
+	Slashed                    bool   `json:"slashed"`
+	ActivationEligibilityEpoch uint64 `json:"activation_eligibility_epoch"`
+	ActivationEpoch            uint64 `json:"activation_epoch"`
+	ExitEpoch                  uint64 `json:"exit_epoch"`
+	WithdrawableEpoch          uint64 `json:"withdrawable_epoch"`
+}
+
+type AttestationData struct {
+	Slot            uint64      `json:"slot"`
+	Index           uint64      `json:"index"`
+	BeaconBlockRoot []byte      `json:"beacon_block_root" ssz-size:"32"`
+	Source          *Checkpoint `json:"source"`
+	Target          *Checkpoint `json:"target"`
+}
+
+type IndexedAttestation struct {
+	AttestingIndices []uint64         `json:"attesting_indices" ssz-max:"2048"`
+	Data             *AttestationData `json:"data"`
+	Signature        []byte           `json:"signature" ssz-size:"96"`
+}
+
+type PendingAttestation struct {
+	AggregationBits []byte           `json:"aggregation_bits" ssz:"bitlist" ssz-max:"2048"`
+	Data            *AttestationData `json:"data"`
+	InclusionDelay  uint64           `json:"inclusion_delay"`
+	ProposerIndex   uint64           `json:"proposer_index"`
+}
+
+type Eth1Data struct {
+	DepositRoot  []byte `json:"deposit_root" ssz-size:"32"`
+	DepositCount uint64 `json:"deposit_count"`
+	BlockHash    []byte `json:"block_hash" ssz-size:"32"`
+}
+
+type Eth1Block struct {
+	Timestamp    uint64 `json:"timestamp"`
+	DepositRoot  []byte `json:"deposit_root" ssz-size:"32"`
+	DepositCount uint64 `json:"deposit_count"`
+}
+
+type HistoricalBatch struct {
+	BlockRoots [][]byte `json:"block_roots" ssz-size:"8192,32"`
+	StateRoots [][]byte `json:"state_roots" ssz-size:"8192,32"`
+}
+
+type DepositMessage struct {
+	Pubkey                []byte `json:"pubkey" ssz-size:"48"`
+	WithdrawalCredentials []byte `json:"withdrawal_credentials" ssz-size:"32"`
+	Amount                uint64 `json:"amount"`
+}
+
+type DepositData struct {
+	Pubkey                []byte `json:"pubkey" ssz-size:"48"`
+	WithdrawalCredentials []byte `json:"withdrawal_credentials" ssz-size:"32"`
+	Amount                uint64 `json:"amount"`
+	Signature             []byte `json:"signature" ssz-size:"96"`
+}
+
+type BeaconBlockHeader struct {
+	Slot          uint64 `json:"slot"`
+	ProposerIndex uint64 `json:"proposer_index"`
+	ParentRoot    []byte `json:"parent_root" ssz-size:"32"`
+	StateRoot     []byte `json:"state_root" ssz-size:"32"`
+	BodyRoot      []byte `json:"body_root" ssz-size:"32"`
+}
+
+type SignedBeaconBlockHeader struct {
+	Message   *BeaconBlockHeader `json:"message"`
+	Signature []byte             `json:"signature" ssz-size:"96"`
+}
+
+type ProposerSlashing struct {
+	SignedHeader1 *SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 *SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+type AttesterSlashing struct {
+	Attestation1 *IndexedAttestation `json:"attestation_1"`
+	Attestation2 *IndexedAttestation `json:"attestation_2"`
+}
+
+type Attestation struct {
+	AggregationBits []byte           `json:"aggregation_bits" ssz:"bitlist" ssz-max:"2048"`
+	Data            *AttestationData `json:"data"`
+	Signature       []byte           `json:"signature" ssz-size:"96"`
+}
+
+type Deposit struct {
+	Proof [][]byte     `json:"proof" ssz-size:"33,32"`
+	Data  *DepositData `json:"data"`
+}
+
+type VoluntaryExit struct {
+	Epoch          uint64 `json:"epoch"`
+	ValidatorIndex uint64 `json:"validator_index"`
+}
+
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit `json:"message"`
+	Signature []byte         `json:"signature" ssz-size:"96"`
+}
+
+type AggregateAndProof struct {
+	AggregatorIndex uint64       `json:"aggregator_index"`
+	Aggregate       *Attestation `json:"aggregate"`
+	SelectionProof  []byte       `json:"selection_proof" ssz-size:"96"`
+}
+
+type SignedAggregateAndProof struct {
+	Message   *AggregateAndProof `json:"message"`
+	Signature []byte             `json:"signature" ssz-size:"96"`
+}
+
+type BeaconBlockBody struct {
+	RandaoReveal          []byte                        `json:"randao_reveal" ssz-size:"96"`
+	Eth1Data              *Eth1Data                     `json:"eth1_data"`
+	Graffiti              []byte                        `json:"graffiti" ssz-size:"32"`
+	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings" ssz-max:"16"`
+	AttesterSlashings     []*AttesterSlashing           `json:"attester_slashings" ssz-max:"2"`
+	Attestations          []*Attestation                `json:"attestations" ssz-max:"128"`
+	Deposits              []*Deposit                    `json:"deposits" ssz-max:"16"`
+	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits" ssz-max:"16"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate" ssz-fork:"altair+"`
+	ExecutionPayload      *ExecutionPayload             `json:"execution_payload" ssz-fork:"bellatrix+"`
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes" ssz-max:"16" ssz-fork:"capella+"`
+	BlobKZGCommitments    [][]byte                      `json:"blob_kzg_commitments" ssz-size:"?,48" ssz-max:"4096" ssz-fork:"deneb+"`
+}
+
+type BeaconBlock struct {
+	Slot          uint64           `json:"slot"`
+	ProposerIndex uint64           `json:"proposer_index"`
+	ParentRoot    []byte           `json:"parent_root" ssz-size:"32"`
+	StateRoot     []byte           `json:"state_root" ssz-size:"32"`
+	Body          *BeaconBlockBody `json:"body"`
+}
+
+type SignedBeaconBlock struct {
+	Message   *BeaconBlock `json:"message"`
+	Signature []byte       `json:"signature" ssz-size:"96"`
+}
+
+type BeaconState struct {
+	GenesisTime                  uint64                  `json:"genesis_time"`
+	GenesisValidatorsRoot        []byte                  `json:"genesis_validators_root" ssz-size:"32"`
+	Slot                         uint64                  `json:"slot"`
+	Fork                         *Fork                   `json:"fork"`
+	LatestBlockHeader            *BeaconBlockHeader      `json:"latest_block_header"`
+	BlockRoots                   [][]byte                `json:"block_roots" ssz-size:"8192,32"`
+	StateRoots                   [][]byte                `json:"state_roots" ssz-size:"8192,32"`
+	HistoricalRoots              [][]byte                `json:"historical_roots" ssz-size:"?,32" ssz-max:"16777216"`
+	Eth1Data                     *Eth1Data               `json:"eth1_data"`
+	Eth1DataVotes                []*Eth1Data             `json:"eth1_data_votes" ssz-max:"2048"`
+	Eth1DepositIndex             uint64                  `json:"eth1_deposit_index"`
+	Validators                   []*Validator            `json:"validators" ssz-max:"1099511627776"`
+	Balances                     []uint64                `json:"balances" ssz-max:"1099511627776"`
+	RandaoMixes                  [][]byte                `json:"randao_mixes" ssz-size:"65536,32"`
+	Slashings                    []uint64                `json:"slashings" ssz-size:"8192"`
+	PreviousEpochAttestations    []*PendingAttestation   `json:"previous_epoch_attestations" ssz-max:"4096" ssz-fork:"phase0"`
+	CurrentEpochAttestations     []*PendingAttestation   `json:"current_epoch_attestations" ssz-max:"4096" ssz-fork:"phase0"`
+	PreviousEpochParticipation   []byte                  `json:"previous_epoch_participation" ssz-max:"1099511627776" ssz-fork:"altair+"`
+	CurrentEpochParticipation    []byte                  `json:"current_epoch_participation" ssz-max:"1099511627776" ssz-fork:"altair+"`
+	JustificationBits            []byte                  `json:"justification_bits" ssz-size:"1"`
+	PreviousJustifiedCheckpoint  *Checkpoint             `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *Checkpoint             `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *Checkpoint             `json:"finalized_checkpoint"`
+	InactivityScores             []uint64                `json:"inactivity_scores" ssz-max:"1099511627776" ssz-fork:"altair+"`
+	CurrentSyncCommittee         *SyncCommittee          `json:"current_sync_committee" ssz-fork:"altair+"`
+	NextSyncCommittee            *SyncCommittee          `json:"next_sync_committee" ssz-fork:"altair+"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeader `json:"latest_execution_payload_header" ssz-fork:"bellatrix+"`
+	NextWithdrawalIndex          uint64                  `json:"next_withdrawal_index" ssz-fork:"capella+"`
+	NextWithdrawalValidatorIndex uint64                  `json:"next_withdrawal_validator_index" ssz-fork:"capella+"`
+	HistoricalSummaries          []*HistoricalSummary    `json:"historical_summaries" ssz-max:"16777216" ssz-fork:"capella+"`
+}
+
+// altair
+
+type SyncAggregate struct {
+	SyncCommitteeBits      []byte `json:"sync_committee_bits" ssz-size:"64"`
+	SyncCommitteeSignature []byte `json:"sync_committee_signature" ssz-size:"96"`
+}
+
+type SyncCommittee struct {
+	Pubkeys         [][]byte `json:"pubkeys" ssz-size:"512,48"`
+	AggregatePubkey []byte   `json:"aggregate_pubkey" ssz-size:"48"`
+}
+
+type SyncCommitteeMessage struct {
+	Slot            uint64 `json:"slot"`
+	BeaconBlockRoot []byte `json:"beacon_block_root" ssz-size:"32"`
+	ValidatorIndex  uint64 `json:"validator_index"`
+	Signature       []byte `json:"signature" ssz-size:"96"`
+}
+
+type SyncCommitteeContribution struct {
+	Slot              uint64 `json:"slot"`
+	BeaconBlockRoot   []byte `json:"beacon_block_root" ssz-size:"32"`
+	SubcommitteeIndex uint64 `json:"subcommittee_index"`
+	AggregationBits   []byte `json:"aggregation_bits" ssz-size:"16"`
+	Signature         []byte `json:"signature" ssz-size:"96"`
+}
+
+type ContributionAndProof struct {
+	AggregatorIndex uint64                     `json:"aggregator_index"`
+	Contribution    *SyncCommitteeContribution `json:"contribution"`
+	SelectionProof  []byte                     `json:"selection_proof" ssz-size:"96"`
+}
+
+type SignedContributionAndProof struct {
+	Message   *ContributionAndProof `json:"message"`
+	Signature []byte                `json:"signature" ssz-size:"96"`
+}
+
+type SyncAggregatorSelectionData struct {
+	Slot              uint64 `json:"slot"`
+	SubcommitteeIndex uint64 `json:"subcommittee_index"`
+}
+
+// bellatrix
+
+type ExecutionPayload struct {
+	ParentHash    []byte        `json:"parent_hash" ssz-size:"32"`
+	FeeRecipient  []byte        `json:"fee_recipient" ssz-size:"20"`
+	StateRoot     []byte        `json:"state_root" ssz-size:"32"`
+	ReceiptsRoot  []byte        `json:"receipts_root" ssz-size:"32"`
+	LogsBloom     []byte        `json:"logs_bloom" ssz-size:"256"`
+	PrevRandao    []byte        `json:"prev_randao" ssz-size:"32"`
+	BlockNumber   uint64        `json:"block_number"`
+	GasLimit      uint64        `json:"gas_limit"`
+	GasUsed       uint64        `json:"gas_used"`
+	Timestamp     uint64        `json:"timestamp"`
+	ExtraData     []byte        `json:"extra_data" ssz-max:"32"`
+	BaseFeePerGas []byte        `json:"base_fee_per_gas" ssz-size:"32"`
+	BlockHash     []byte        `json:"block_hash" ssz-size:"32"`
+	Transactions  [][]byte      `json:"transactions" ssz-size:"?,?" ssz-max:"1048576,1073741824"`
+	Withdrawals   []*Withdrawal `json:"withdrawals" ssz-max:"16" ssz-fork:"capella+"`
+	BlobGasUsed   uint64        `json:"blob_gas_used" ssz-fork:"deneb+"`
+	ExcessBlobGas uint64        `json:"excess_blob_gas" ssz-fork:"deneb+"`
+}
+
+type ExecutionPayloadHeader struct {
+	ParentHash       []byte `json:"parent_hash" ssz-size:"32"`
+	FeeRecipient     []byte `json:"fee_recipient" ssz-size:"20"`
+	StateRoot        []byte `json:"state_root" ssz-size:"32"`
+	ReceiptsRoot     []byte `json:"receipts_root" ssz-size:"32"`
+	LogsBloom        []byte `json:"logs_bloom" ssz-size:"256"`
+	PrevRandao       []byte `json:"prev_randao" ssz-size:"32"`
+	BlockNumber      uint64 `json:"block_number"`
+	GasLimit         uint64 `json:"gas_limit"`
+	GasUsed          uint64 `json:"gas_used"`
+	Timestamp        uint64 `json:"timestamp"`
+	ExtraData        []byte `json:"extra_data" ssz-max:"32"`
+	BaseFeePerGas    []byte `json:"base_fee_per_gas" ssz-size:"32"`
+	BlockHash        []byte `json:"block_hash" ssz-size:"32"`
+	TransactionsRoot []byte `json:"transactions_root" ssz-size:"32"`
+	WithdrawalsRoot  []byte `json:"withdrawals_root" ssz-size:"32" ssz-fork:"capella+"`
+	BlobGasUsed      uint64 `json:"blob_gas_used" ssz-fork:"deneb+"`
+	ExcessBlobGas    uint64 `json:"excess_blob_gas" ssz-fork:"deneb+"`
+}
+
+// capella
+
+type Withdrawal struct {
+	Index          uint64 `json:"index"`
+	ValidatorIndex uint64 `json:"validator_index"`
+	Address        []byte `json:"address" ssz-size:"20"`
+	Amount         uint64 `json:"amount"`
+}
+
+type BLSToExecutionChange struct {
+	ValidatorIndex     uint64 `json:"validator_index"`
+	FromBLSPubkey      []byte `json:"from_bls_pubkey" ssz-size:"48"`
+	ToExecutionAddress []byte `json:"to_execution_address" ssz-size:"20"`
+}
+
+type SignedBLSToExecutionChange struct {
+	Message   *BLSToExecutionChange `json:"message"`
+	Signature []byte                `json:"signature" ssz-size:"96"`
+}
+
+type HistoricalSummary struct {
+	BlockSummaryRoot []byte `json:"block_summary_root" ssz-size:"32"`
+	StateSummaryRoot []byte `json:"state_summary_root" ssz-size:"32"`
+}
+
+// deneb
+
+type BlobIdentifier struct {
+	BlockRoot []byte `json:"block_root" ssz-size:"32"`
+	Index     uint64 `json:"index"`
+}
+
+type BlobSidecar struct {
+	Index                       uint64                   `json:"index"`
+	Blob                        []byte                   `json:"blob" ssz-size:"131072"`
+	KZGCommitment               []byte                   `json:"kzg_commitment" ssz-size:"48"`
+	KZGProof                    []byte                   `json:"kzg_proof" ssz-size:"48"`
+	SignedBlockHeader           *SignedBeaconBlockHeader `json:"signed_block_header"`
+	KZGCommitmentInclusionProof [][]byte                 `json:"kzg_commitment_inclusion_proof" ssz-size:"17,32"`
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const arraysSource = `package arrays
+
+type Hash [32]byte
+
+type Arrays struct {
+	Root    [32]byte
+	Hash    Hash
+	Pubkeys [][48]byte ` + "`ssz-max:\"4\"`" + `
+	Hashes  []Hash     ` + "`ssz-size:\"2\"`" + `
+}
+`
+
+func TestByteArrays(t *testing.T) {
+	// the package is in the module to import the ssz package
+	dir, err := ioutil.TempDir(".", "_arrays")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	source := filepath.Join(dir, "arrays.go")
+	if err := ioutil.WriteFile(source, []byte(arraysSource), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := defaultOptions()
+	opts.random = true
+	if err := encode(source, nil, "", opts); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("go", "vet", "./"+dir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("the generated code does not build: %v\n%s", err, out)
+	}
+
+	// the getters of the arrays cannot be sliced
+	opts = defaultOptions()
+	opts.useGetters = true
+	err = encode(source, nil, "", opts)
+	if err == nil || !strings.Contains(err.Error(), "use-getters") {
+		t.Fatalf("expected the use-getters error but found %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// bitfieldType returns the bit length of the type of a package if it is one of the bitfield types
+// of the options, zero for a bitlist. The types are set as their fully qualified name with their
+// kind, either 'bitlist' or 'bitvectorN' (i.e. 'github.com/me/bitfield.Bits=bitlist').
+func (e *env) bitfieldType(pkg, sel string) (uint64, bool, error) {
+	if e.opts.bitfields == "" {
+		return 0, false, nil
+	}
+	path, err := e.findImport(pkg)
+	if err != nil {
+		return 0, false, nil
+	}
+	for _, item := range strings.Split(e.opts.bitfields, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.Split(item, "=")
+		if len(parts) != 2 {
+			return 0, false, fmt.Errorf("bitfield type '%s' expects the format 'path.Type=kind'", item)
+		}
+		var bitLen uint64
+		if kind := parts[1]; kind != "bitlist" {
+			num, err := strconv.Atoi(strings.TrimPrefix(kind, "bitvector"))
+			if !strings.HasPrefix(kind, "bitvector") || err != nil || num <= 0 {
+				return 0, false, fmt.Errorf("bitfield type '%s' expects either a bitlist or a bitvectorN kind", item)
+			}
+			bitLen = uint64(num)
+		}
+		if parts[0] == path+"."+sel {
+			return bitLen, true, nil
+		}
+	}
+	return 0, false, nil
+}
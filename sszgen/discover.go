@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"sort"
+	"strings"
+)
+
+const (
+	// generateMarker is the comment that marks a type as a target
+	generateMarker = "//sszgen:generate"
+	// valueReceiverMarker is the comment that marks a type to use value receivers
+	valueReceiverMarker = "//sszgen:value-receiver"
+)
+
+// discoverTargets returns the targets declared in the files either with the 'objs' flag of
+// a '//go:generate sszgen' directive or with a '//sszgen:generate' comment on the type.
+func discoverTargets(files map[string]*ast.File) ([]string, error) {
+	found := map[string]bool{}
+	for _, file := range files {
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				for _, target := range generateDirectiveTargets(comment.Text) {
+					found[target] = true
+				}
+			}
+		}
+		for _, dec := range file.Decls {
+			genDecl, ok := dec.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				if hasTypeMarker(genDecl, typeSpec, generateMarker) {
+					found[typeSpec.Name.Name] = true
+				}
+			}
+		}
+	}
+	if len(found) == 0 {
+		return nil, fmt.Errorf("no targets found with go:generate directives or %s comments", generateMarker)
+	}
+
+	targets := []string{}
+	for name := range found {
+		targets = append(targets, name)
+	}
+	sort.Strings(targets)
+	return targets, nil
+}
+
+// hasTypeMarker returns true if the comment of the type has the marker (i.e. '//sszgen:generate')
+func hasTypeMarker(genDecl *ast.GenDecl, typeSpec *ast.TypeSpec, marker string) bool {
+	// the comment is on the declaration unless the types are grouped
+	return hasMarker(typeSpec.Doc, marker) || (len(genDecl.Specs) == 1 && hasMarker(genDecl.Doc, marker))
+}
+
+func hasMarker(doc *ast.CommentGroup, marker string) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if strings.TrimSpace(comment.Text) == marker {
+			return true
+		}
+	}
+	return false
+}
+
+// generateDirectiveTargets returns the value of the 'objs' flag in
+// a go:generate directive that runs sszgen (i.e. '//go:generate sszgen --objs A,B').
+func generateDirectiveTargets(text string) []string {
+	if !strings.HasPrefix(text, "//go:generate ") {
+		return nil
+	}
+	args := strings.Fields(strings.TrimPrefix(text, "//go:generate "))
+
+	isSszgen := false
+	for _, arg := range args {
+		if strings.Contains(arg, "sszgen") {
+			isSszgen = true
+			break
+		}
+	}
+	if !isSszgen {
+		return nil
+	}
+
+	for indx, arg := range args {
+		name := strings.TrimLeft(arg, "-")
+		if name == arg {
+			// not a flag
+			continue
+		}
+		if strings.HasPrefix(name, "objs=") {
+			return splitTargets(strings.TrimPrefix(name, "objs="))
+		}
+		if name == "objs" && indx+1 < len(args) {
+			return splitTargets(args[indx+1])
+		}
+	}
+	return nil
+}
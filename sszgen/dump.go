@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// dumpCmd prints the encoding of a struct as an hexdump annotated with its fields (ssz.Dump).
+// It is intended to find the bytes in which two implementations disagree.
+func dumpCmd(args []string) error {
+	var source string
+	var typ string
+	var input string
+
+	flagSet := flag.NewFlagSet("dump", flag.ExitOnError)
+	flagSet.StringVar(&source, "path", "", "")
+	flagSet.StringVar(&typ, "type", "", "")
+	flagSet.StringVar(&input, "input", "", "")
+
+	opts := defaultOptions()
+	opts.register(flagSet)
+
+	flagSet.Parse(args)
+	opts.setup()
+
+	if typ == "" {
+		return fmt.Errorf("the type to decode is not set")
+	}
+	schema, err := targetSchema(source, typ, opts)
+	if err != nil {
+		return err
+	}
+
+	var buf []byte
+	if input == "" {
+		buf, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		buf, err = ioutil.ReadFile(input)
+	}
+	if err != nil {
+		return err
+	}
+	return ssz.Dump(os.Stdout, schema, buf)
+}
+
+// targetSchema returns the runtime schema of the struct
+func targetSchema(source, typ string, opts *options) (*ssz.Schema, error) {
+	e, err := newEnv(source, []string{typ}, opts)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := e.objs[typ]
+	if !ok {
+		return nil, fmt.Errorf("type %s not found", typ)
+	}
+	return v.runtimeSchema(), nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"go/ast"
+	"path/filepath"
+	"strings"
+)
+
+// exampleName returns the name of the file with the examples of an output
+// (i.e. a_encoding_example_test.go for a_encoding.go)
+func exampleName(name string) string {
+	dir, base := filepath.Split(name)
+	if i := strings.Index(base, "."); i != -1 {
+		base = base[:i]
+	}
+	return filepath.Join(dir, base+"_example_test.go")
+}
+
+// generateExamples returns the files with the examples of the generated structs, with the
+// same layout as the encodings. The output is empty for the outputs without exported structs.
+func (e *env) generateExamples(output string) map[string]string {
+	out := map[string]string{}
+	if output != "" {
+		orders := []string{}
+		for _, name := range e.orderedFiles() {
+			orders = append(orders, e.order[name]...)
+		}
+		if str, ok := e.examples(orders); ok {
+			out[exampleName(output)] = str
+		}
+		return out
+	}
+	for _, name := range e.orderedFiles() {
+		if str, ok := e.examples(e.order[name]); ok {
+			out[exampleName(e.encodingName(name))] = str
+		}
+	}
+	return out
+}
+
+// examples prints the examples of the exported structs in order. They start from the random
+// objects and run with the tests of the package, which check that the decoded object has the
+// same encoding and root.
+func (e *env) examples(order []string) (string, bool) {
+	tmpl := generatedHeader + `
+	package {{.package}}
+
+	import (
+		"bytes"
+		"fmt"
+		"math/rand"
+	)
+
+	{{ range .names }}
+	func Example{{.}}() {
+		obj := Random{{.}}(rand.New(rand.NewSource(1)))
+
+		// encode the object
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			panic(err)
+		}
+
+		// decode it into a new object
+		obj2 := new({{.}})
+		if err := obj2.UnmarshalSSZ(buf); err != nil {
+			panic(err)
+		}
+
+		// encode the decoded object again
+		buf2, err := obj2.MarshalSSZ()
+		if err != nil {
+			panic(err)
+		}
+
+		// compute the roots of both objects
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			panic(err)
+		}
+		root2, err := obj2.HashTreeRoot()
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(bytes.Equal(buf, buf2) && root == root2)
+		// Output: true
+	}
+	{{ end }}
+	`
+
+	names := []string{}
+	for _, name := range order {
+		if _, ok := e.objs[name]; !ok || !ast.IsExported(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return "", false
+	}
+	return execTmpl(tmpl, map[string]interface{}{
+		"package": e.packName,
+		"names":   names,
+	}), true
+}
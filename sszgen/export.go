@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// exportCmd writes the schema document of the targets, the inverse of the import command.
+// It describes every container with the types of its fields in the notation of the specs,
+// with the limits resolved, so that other implementations read the same definitions.
+func exportCmd(args []string) error {
+	var source string
+	var objsStr string
+	var output string
+	var formatStr string
+
+	flagSet := flag.NewFlagSet("export", flag.ExitOnError)
+	flagSet.StringVar(&source, "path", "", "")
+	flagSet.StringVar(&objsStr, "objs", "", "")
+	flagSet.StringVar(&output, "output", "", "")
+	flagSet.StringVar(&formatStr, "format", "json", "")
+
+	opts := defaultOptions()
+	opts.register(flagSet)
+
+	flagSet.Parse(args)
+	opts.setup()
+
+	e, err := newEnv(source, splitTargets(objsStr), opts)
+	if err != nil {
+		return err
+	}
+	doc := e.schemaDocument()
+
+	var res []byte
+	switch formatStr {
+	case "json":
+		if res, err = json.MarshalIndent(doc, "", "  "); err != nil {
+			return err
+		}
+		res = append(res, '\n')
+	case "yaml":
+		res = []byte(doc.yaml())
+	default:
+		return fmt.Errorf("unknown format '%s', expected json or yaml", formatStr)
+	}
+	if output == "" {
+		fmt.Print(string(res))
+		return nil
+	}
+	return ioutil.WriteFile(output, res, 0644)
+}
+
+// schemaDocument returns the document of the containers in the order in which they appear
+// on the files, with the containers of the other targets that they reference
+func (e *env) schemaDocument() *schemaDocument {
+	doc := &schemaDocument{Package: e.packName, Containers: []*schemaContainer{}}
+	for _, name := range e.orderedObjs() {
+		s := e.objs[name].runtimeSchema()
+		c := &schemaContainer{Name: name, Fields: []*schemaField{}}
+		for _, f := range s.Fields {
+			c.Fields = append(c.Fields, &schemaField{Name: f.Name, Type: f.Schema.String()})
+		}
+		doc.Containers = append(doc.Containers, c)
+	}
+	return doc
+}
+
+// yaml prints the document with the keys in the same order as the json output
+func (d *schemaDocument) yaml() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "package: %s\ncontainers:\n", d.Package)
+	for _, c := range d.Containers {
+		fmt.Fprintf(&b, "  - name: %s\n    fields:\n", c.Name)
+		for _, f := range c.Fields {
+			fmt.Fprintf(&b, "      - name: %s\n        type: %s\n", f.Name, f.Type)
+		}
+	}
+	return b.String()
+}
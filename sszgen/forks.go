@@ -0,0 +1,225 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// defaultForks are the forks of the beacon chain in order
+const defaultForks = "phase0,altair,bellatrix,capella,deneb,electra"
+
+// forkNames returns the forks of the options in order
+func (e *env) forkNames() []string {
+	res := []string{}
+	for _, fork := range strings.Split(e.opts.forks, ",") {
+		if fork = strings.TrimSpace(fork); fork != "" {
+			res = append(res, fork)
+		}
+	}
+	return res
+}
+
+// forkSuffix returns the suffix of the methods of a fork (i.e. Altair for altair)
+func forkSuffix(fork string) string {
+	return strings.ToUpper(fork[:1]) + fork[1:]
+}
+
+// forkRange returns the first and the last fork with the field of the 'ssz-fork' tag. The tag
+// is either 'ssz-fork:"altair+"' for a field added in altair, 'ssz-fork:"phase0-bellatrix"' for
+// a field removed in capella or 'ssz-fork:"altair"' for a field only in altair.
+func (e *env) forkRange(tags string) (int, int, bool, error) {
+	tag, ok := getTags(tags, "ssz-fork")
+	if !ok {
+		return 0, 0, false, nil
+	}
+	forks := e.forkNames()
+	if len(forks) == 0 {
+		return 0, 0, false, fmt.Errorf("the ssz-fork tag '%s' is set without forks", tag)
+	}
+	index := func(name string) (int, error) {
+		for indx, fork := range forks {
+			if fork == name {
+				return indx, nil
+			}
+		}
+		return 0, fmt.Errorf("unknown fork '%s' in the ssz-fork tag, the forks are %s", name, strings.Join(forks, ","))
+	}
+
+	var from, to string
+	if strings.HasSuffix(tag, "+") {
+		from, to = strings.TrimSuffix(tag, "+"), forks[len(forks)-1]
+	} else if parts := strings.Split(tag, "-"); len(parts) == 2 {
+		from, to = parts[0], parts[1]
+	} else {
+		from, to = tag, tag
+	}
+	first, err := index(from)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	last, err := index(to)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if first > last {
+		return 0, 0, false, fmt.Errorf("the ssz-fork tag '%s' ends before it starts", tag)
+	}
+	return first, last, true, nil
+}
+
+// inFork returns true if the field is encoded in the fork
+func (e *env) inFork(v *Value, fork int) bool {
+	first, last, ok, _ := e.forkRange(v.tags)
+	return !ok || (first <= fork && fork <= last)
+}
+
+// isForked returns true if the fields of the container or of any of its nested containers
+// depend on the fork
+func isForked(v *Value) bool {
+	if v.e != nil {
+		return isForked(v.e)
+	}
+	for _, f := range v.o {
+		if _, ok := getTags(f.tags, "ssz-fork"); ok || isForked(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// forkValue returns the value with the fields of the fork. The containers that depend
+// on the fork have the suffix, their sizes are computed from the fields of the fork.
+func (e *env) forkValue(v *Value, fork int, suffix string) *Value {
+	vv := v.copy()
+	e.applyFork(vv, fork, suffix)
+	return vv
+}
+
+func (e *env) applyFork(v *Value, fork int, suffix string) {
+	switch v.t {
+	case TypeContainer:
+		if !isForked(v) {
+			return
+		}
+		v.fork = suffix
+		fields := []*Value{}
+		for _, f := range v.o {
+			if e.inFork(f, fork) {
+				e.applyFork(f, fork, suffix)
+				fields = append(fields, f)
+			}
+		}
+		v.o = fields
+		v.n, v.c = 0, false
+		for _, f := range v.o {
+			if f.isFixed() {
+				v.n += f.n
+			} else {
+				v.n += bytesPerLengthOffset
+				v.c = true
+			}
+		}
+
+	case TypeVector, TypeList:
+		e.applyFork(v.e, fork, suffix)
+		if v.t == TypeVector {
+			v.n = 0
+			if v.e.isFixed() {
+				v.n = v.s * v.e.n
+			}
+		}
+	}
+}
+
+// layout returns the names of the fields of the container and its nested containers
+func (v *Value) layout() string {
+	if v.e != nil {
+		return v.e.layout()
+	}
+	names := []string{}
+	for _, f := range v.o {
+		names = append(names, f.name+"("+f.layout()+")")
+	}
+	return strings.Join(names, ",")
+}
+
+// forkVariants creates the marshal, unmarshal, size and hash functions of the container for
+// each fork with the suffix of the fork (i.e. MarshalSSZAltair). The functions without suffix
+// encode the latest fork. The forks with the same fields as the latest fork or as a previous
+// fork call the functions of that fork.
+func (e *env) forkVariants(name string, v *Value) string {
+	forks := e.forkNames()
+	layouts := make([]string, len(forks))
+	for indx := range forks {
+		layouts[indx] = e.forkValue(v, indx, "").layout()
+	}
+
+	out := []string{}
+	for indx, fork := range forks {
+		suffix := forkSuffix(fork)
+		if layouts[indx] == layouts[len(forks)-1] {
+			out = append(out, e.forkAlias(name, v, suffix, ""))
+			continue
+		}
+		first := indx
+		for j := 0; j < indx; j++ {
+			if layouts[j] == layouts[indx] {
+				first = j
+				break
+			}
+		}
+		if first != indx {
+			out = append(out, e.forkAlias(name, v, suffix, forkSuffix(forks[first])))
+			continue
+		}
+		variant := e.forkValue(v, indx, suffix)
+		out = append(out, e.marshal(name, variant), e.unmarshal(name, variant), e.size(name, variant), e.hashTreeRoot(name, variant))
+	}
+	return strings.Join(out, "\n\n")
+}
+
+// forkAlias creates the functions of a fork that call the functions of the fork with the same fields
+func (e *env) forkAlias(name string, v *Value, fork, target string) string {
+	tmpl := `// MarshalSSZ{{.fork}} ssz marshals the {{.name}} object
+	func (:: {{.receiver}}) MarshalSSZ{{.fork}}() ([]byte, error) {
+		return ::.MarshalSSZ{{.target}}()
+	}
+
+	// MarshalSSZTo{{.fork}} ssz marshals the {{.name}} object to a target array
+	func (:: {{.receiver}}) MarshalSSZTo{{.fork}}(dst []byte) ([]byte, error) {
+		return ::.MarshalSSZTo{{.target}}(dst)
+	}
+
+	// UnmarshalSSZ{{.fork}} ssz unmarshals the {{.name}} object
+	func (:: *{{.name}}) UnmarshalSSZ{{.fork}}(buf []byte) error {
+		return ::.UnmarshalSSZ{{.target}}(buf)
+	}
+
+	// SizeSSZ{{.fork}} returns the ssz encoded size in bytes for the {{.name}} object
+	func (:: {{.receiver}}) SizeSSZ{{.fork}}() int {
+		return ::.SizeSSZ{{.target}}()
+	}
+
+	// MaxSizeSSZ{{.fork}} returns the size in bytes of the largest ssz encoding of the {{.name}} object
+	func (:: {{.receiver}}) MaxSizeSSZ{{.fork}}() uint64 {
+		return ::.MaxSizeSSZ{{.target}}()
+	}
+
+	// HashTreeRoot{{.fork}} ssz hashes the {{.name}} object
+	func (:: {{.receiver}}) HashTreeRoot{{.fork}}() ([32]byte, error) {
+		return ::.HashTreeRoot{{.target}}()
+	}
+
+	// HashTreeRootWith{{.fork}} ssz hashes the {{.name}} object with a hasher
+	func (:: {{.receiver}}) HashTreeRootWith{{.fork}}(hh *ssz.Hasher) error {
+		return ::.HashTreeRootWith{{.target}}(hh)
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":     name,
+		"receiver": v.receiver(),
+		"fork":     fork,
+		"target":   target,
+	})
+	return appendObjSignature(str, v)
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// hashTreeRoot creates the functions that compute the hash tree root of the struct:
+// 1. HashTreeRoot() computes the root with a Hasher from the default pool (or a new one if the pool is disabled).
+// 2. HashTreeRootWith(hh *ssz.Hasher) appends the root of the struct to an existing Hasher.
+func (e *env) hashTreeRoot(name string, v *Value) string {
+	tmpl := `// HashTreeRoot{{.fork}} ssz hashes the {{.name}} object
+	func (:: {{.receiver}}) HashTreeRoot{{.fork}}() ([32]byte, error) {
+		return ssz.{{.hashWith}}({{if .fork}}ssz.HashFunc(::.HashTreeRootWith{{.fork}}){{else}}::{{end}})
+	}
+
+	// HashTreeRootWith{{.fork}} ssz hashes the {{.name}} object with a hasher
+	func (:: {{.receiver}}) HashTreeRootWith{{.fork}}(hh *ssz.Hasher) (err error) {
+		{{.hashTreeRoot}}
+		return
+	}`
+
+	hashWith := "HashWithDefaultHasher"
+	if !e.opts.hasherPool {
+		hashWith = "HashWithNewHasher"
+	}
+	body, helpers := v.hashTreeRootContainer(true), ""
+	if e.fieldHelpers(v) {
+		body = v.hashTreeRootFields(func(i *Value) string {
+			return fmt.Sprintf("if err = ::.%s(hh); err != nil {\n return\n}", helperName("hashTreeRoot"+v.fork, i))
+		})
+		helpers = v.hashTreeRootHelpers(name)
+	}
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":         name,
+		"fork":         v.fork,
+		"receiver":     v.receiver(),
+		"hashWith":     hashWith,
+		"hashTreeRoot": body,
+	})
+	return appendObjSignature(str+helpers, v)
+}
+
+func (v *Value) hashTreeRoot() string {
+	switch v.t {
+	case TypeContainer:
+		return v.hashTreeRootContainer(false)
+
+	case TypeUint:
+		if v.unixTime != "" {
+			return fmt.Sprintf("if err = hh.PutTime(%s, %t); err != nil {\n return\n}", v.field(), v.milli())
+		}
+		return fmt.Sprintf("hh.Put%s(%s)", uintVToName(v), v.basicValue())
+
+	case TypeBool:
+		return fmt.Sprintf("hh.PutBool(%s)", v.basicValue())
+
+	case TypeBytes:
+		if v.isFixed() {
+			return fmt.Sprintf("if len(%s) != %d {\n return ssz.ErrBytesLength\n}\nhh.PutBytes(%s)", v.basicValue(), v.s, v.basicValue())
+		}
+		tmpl := `{
+			elemIndx := hh.Index()
+			byteLen := uint64(len({{.value}}))
+			{{if .progressive}}hh.Append({{.value}})
+			hh.FillUpTo32()
+			hh.MerkleizeProgressiveWithMixin(elemIndx, byteLen){{else}}if byteLen > {{.max}} {
+				return ssz.ErrListTooBig
+			}
+			hh.Append({{.value}})
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(elemIndx, byteLen, ({{.max}}+31)/32){{end}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"value":       v.basicValue(),
+			"max":         v.m,
+			"progressive": v.progressive,
+		})
+
+	case TypeBitVector:
+		return fmt.Sprintf("if len(%s) != %d {\n return ssz.ErrBytesLength\n}\nhh.PutBytes(%s)", v.field(), v.s, v.field())
+
+	case TypeBitList:
+		if v.progressive {
+			return fmt.Sprintf("if err = ssz.ValidateBitlist(%s, 0); err != nil {\n return\n}\nhh.PutProgressiveBitlist(%s)", v.field(), v.field())
+		}
+		return fmt.Sprintf("if err = ssz.ValidateBitlist(%s, %d); err != nil {\n return\n}\nhh.PutBitlist(%s, %d)", v.field(), v.m, v.field(), v.m)
+
+	case TypeVector, TypeList:
+		return v.hashTreeRootList()
+
+	default:
+		panic(fmt.Errorf("hash not implemented for type %s", v.t.String()))
+	}
+}
+
+func (v *Value) hashTreeRootList() string {
+	v.e.name = v.name + "[" + v.index() + "]"
+
+	// the basic types are packed in chunks instead of hashed one by one
+	var elem string
+	limit := v.s
+	pack := v.e.t == TypeUint || v.e.t == TypeBool
+	if pack {
+		if v.e.t == TypeBool {
+			elem = fmt.Sprintf("hh.AppendBool(%s)", v.e.basicValue())
+		} else {
+			elem = fmt.Sprintf("hh.Append%s(%s)", uintVToName(v.e), v.e.basicValue())
+		}
+		limit = (v.s*v.e.n + 31) / 32
+	} else {
+		elem = v.e.hashTreeRoot()
+	}
+
+	tmpl := `{
+		{{if .progressive}}{{else if .list}}if {{.len}} > {{.size}} {
+			return ssz.ErrListTooBig
+		}{{else}}if len({{.field}}) != {{.size}} {
+			return ssz.ErrVectorLength
+		}{{end}}
+		subIndx := hh.Index()
+		for {{.ii}} := 0; {{.ii}} < len({{.field}}); {{.ii}}++ {
+			{{.elem}}
+		}
+		{{if .pack}}hh.FillUpTo32()
+		{{end}}{{if .progressive}}hh.MerkleizeProgressiveWithMixin(subIndx, uint64(len({{.field}}))){{else if .list}}hh.MerkleizeWithMixin(subIndx, uint64(len({{.field}})), {{.limit}}){{else}}hh.Merkleize(subIndx){{end}}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"ii":          v.index(),
+		"name":        v.name,
+		"field":       v.field(),
+		"list":        v.t == TypeList,
+		"len":         lenValue("len("+v.field()+")", v.s),
+		"size":        v.s,
+		"elem":        elem,
+		"pack":        pack,
+		"limit":       limit,
+		"progressive": v.progressive,
+	})
+}
+
+func (v *Value) hashTreeRootContainer(start bool) string {
+	if !start {
+		str := fmt.Sprintf("if err = %s.HashTreeRootWith%s(hh); err != nil {\n return\n}", v.field(), v.fork)
+		if v.structValue {
+			return str
+		}
+		// a nil pointer is hashed as the zero value
+		return fmt.Sprintf("if %s == nil {\nif err = new(%s).HashTreeRootWith%s(hh); err != nil {\n return\n}\n} else %s", v.field(), v.obj, v.fork, str)
+	}
+	return v.hashTreeRootFields((*Value).hashTreeRoot)
+}
+
+// hashTreeRootFields merkleizes the roots of the fields of a container, each one appended with hash
+func (v *Value) hashTreeRootFields(hash func(*Value) string) string {
+	out := []string{"indx := hh.Index()\n"}
+	for indx, i := range v.o {
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, hash(i)))
+	}
+	out = append(out, "hh.Merkleize(indx)")
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,78 @@
+package main
+
+import "strings"
+
+// fieldHelpers returns true if the functions of the container call a helper for each field.
+// Then, the functions of the big structs (i.e. the states) are small and the stack traces
+// point to the field.
+func (e *env) fieldHelpers(v *Value) bool {
+	return e.opts.fieldHelpers != 0 && len(v.o) >= e.opts.fieldHelpers
+}
+
+// helperName returns the name of the helper of the field (i.e. marshalSSZSlot)
+func helperName(prefix string, v *Value) string {
+	return prefix + strings.Replace(v.name, ".", "", -1)
+}
+
+func (v *Value) marshalHelpers(name string) (str string) {
+	tmpl := `// {{.helper}} ssz marshals the {{.field}} field of the {{.name}} object
+	func (:: {{.receiver}}) {{.helper}}(dst []byte) (res []byte, err error) {
+		{{.offset}}{{.marshal}}
+		return dst, nil
+	}`
+
+	for _, i := range v.o {
+		marshal := i.marshal()
+		offset := ""
+		if !i.isFixed() && strings.Contains(marshal, "offset") {
+			// the lists of dynamic elements write their own offsets
+			offset = "var offset int\n"
+		}
+		str += "\n\n" + execTmpl(tmpl, map[string]interface{}{
+			"helper":   helperName("marshalSSZ"+v.fork, i),
+			"field":    i.name,
+			"name":     name,
+			"receiver": v.receiver(),
+			"offset":   offset,
+			"marshal":  marshal,
+		})
+	}
+	return
+}
+
+func (v *Value) unmarshalHelpers(name string) (str string) {
+	tmpl := `// {{.helper}} ssz unmarshals the {{.field}} field of the {{.name}} object
+	func (:: *{{.name}}) {{.helper}}(buf []byte) (err error) {
+		{{.unmarshal}}
+		return
+	}`
+
+	for _, i := range v.o {
+		str += "\n\n" + execTmpl(tmpl, map[string]interface{}{
+			"helper":    helperName("unmarshalSSZ"+v.fork, i),
+			"field":     i.name,
+			"name":      name,
+			"unmarshal": i.unmarshal("buf"),
+		})
+	}
+	return
+}
+
+func (v *Value) hashTreeRootHelpers(name string) (str string) {
+	tmpl := `// {{.helper}} ssz hashes the {{.field}} field of the {{.name}} object
+	func (:: {{.receiver}}) {{.helper}}(hh *ssz.Hasher) (err error) {
+		{{.hash}}
+		return
+	}`
+
+	for _, i := range v.o {
+		str += "\n\n" + execTmpl(tmpl, map[string]interface{}{
+			"helper":   helperName("hashTreeRoot"+v.fork, i),
+			"field":    i.name,
+			"name":     name,
+			"receiver": v.receiver(),
+			"hash":     i.hashTreeRoot(),
+		})
+	}
+	return
+}
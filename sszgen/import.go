@@ -0,0 +1,269 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// importCmd reads the containers of a schema document (YAML or JSON) and emits the equivalent
+// Go structs annotated with the ssz tags. Then, it generates the encodings for those structs.
+//
+// The types of the fields use the notation of the consensus specs: uint8 to uint64, boolean,
+// BytesN, ByteVector[N], ByteList[N], Bitvector[N], Bitlist[N], Vector[T, N], List[T, N] and
+// the names of the containers of the document.
+func importCmd(args []string) error {
+	var source string
+	var output string
+	var packName string
+
+	flagSet := flag.NewFlagSet("import", flag.ExitOnError)
+	flagSet.StringVar(&source, "path", "", "")
+	flagSet.StringVar(&output, "output", "", "")
+	flagSet.StringVar(&packName, "package", "", "")
+
+	opts := defaultOptions()
+	opts.register(flagSet)
+
+	flagSet.Parse(args)
+	opts.setup()
+
+	data, err := ioutil.ReadFile(source)
+	if err != nil {
+		return err
+	}
+	doc := &schemaDocument{}
+	if err := yaml.Unmarshal(data, doc); err != nil {
+		return fmt.Errorf("%s: %v", source, err)
+	}
+	if packName != "" {
+		doc.Package = packName
+	}
+	if output == "" {
+		output = strings.TrimSuffix(source, filepath.Ext(source)) + ".go"
+	}
+
+	res, err := doc.print(filepath.Base(source))
+	if err != nil {
+		return err
+	}
+	header, err := readHeader(opts.header)
+	if err != nil {
+		return err
+	}
+	res = append(header, res...)
+	if err := ioutil.WriteFile(output, res, 0644); err != nil {
+		return err
+	}
+	return encode(output, nil, "", opts)
+}
+
+// schemaDocument is the language neutral description of a set of containers
+type schemaDocument struct {
+	// Package is the name of the Go package of the structs
+	Package    string             `json:"package,omitempty"`
+	Containers []*schemaContainer `json:"containers"`
+}
+
+type schemaContainer struct {
+	Name   string         `json:"name"`
+	Fields []*schemaField `json:"fields"`
+}
+
+type schemaField struct {
+	// Name is the name of the field in the specs (i.e. 'state_root')
+	Name string `json:"name"`
+	// Type is the SSZ type of the field (i.e. 'List[uint64, 16]')
+	Type string `json:"type"`
+}
+
+func (d *schemaDocument) print(source string) ([]byte, error) {
+	if d.Package == "" {
+		return nil, fmt.Errorf("package not found, use the 'package' flag")
+	}
+
+	containers := map[string]bool{}
+	for _, c := range d.Containers {
+		containers[c.Name] = true
+	}
+
+	out := fmt.Sprintf("// Code generated by fastssz from %s. DO NOT EDIT.\npackage %s\n", source, d.Package)
+	for _, c := range d.Containers {
+		out += fmt.Sprintf("\ntype %s struct {\n", c.Name)
+		for _, f := range c.Fields {
+			typ, err := parseSchemaType(f.Type, containers)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", c.Name, f.Name, err)
+			}
+			out += fmt.Sprintf("%s %s `%s`\n", protoGoName(f.Name), typ.goType, typ.tags(f.Name))
+		}
+		out += "}\n"
+	}
+	return format.Source([]byte(out))
+}
+
+// schemaGoType is the Go type of a type expression with the dimensions of its ssz tags
+type schemaGoType struct {
+	goType string
+	// sizes and maxes are the dimensions of the 'ssz-size' and 'ssz-max' tags, empty if not set
+	sizes, maxes []string
+	bitlist      bool
+}
+
+func (t *schemaGoType) tags(name string) string {
+	tags := []string{fmt.Sprintf("json:\"%s\"", name)}
+	if t.bitlist {
+		tags = append(tags, "ssz:\"bitlist\"")
+	}
+	dims := func(tag string, dims []string) {
+		for len(dims) != 0 && dims[len(dims)-1] == "" {
+			dims = dims[:len(dims)-1]
+		}
+		if len(dims) == 0 {
+			return
+		}
+		res := []string{}
+		for _, dim := range dims {
+			if dim == "" {
+				dim = "?"
+			}
+			res = append(res, dim)
+		}
+		tags = append(tags, fmt.Sprintf("%s:\"%s\"", tag, strings.Join(res, ",")))
+	}
+	dims("ssz-size", t.sizes)
+	dims("ssz-max", t.maxes)
+	return strings.Join(tags, " ")
+}
+
+var schemaUintTypes = map[string]string{
+	"uint8":  "uint8",
+	"uint16": "uint16",
+	"uint32": "uint32",
+	"uint64": "uint64",
+}
+
+// parseSchemaType converts a type expression (i.e. 'Vector[Bytes32, 64]') to its Go type
+func parseSchemaType(str string, containers map[string]bool) (*schemaGoType, error) {
+	str = strings.TrimSpace(str)
+	name, args, err := splitSchemaType(str)
+	if err != nil {
+		return nil, err
+	}
+	num := func(indx int) (string, error) {
+		if len(args) != indx+1 {
+			return "", fmt.Errorf("type '%s' expects %d arguments", str, indx+1)
+		}
+		n, err := strconv.ParseUint(args[indx], 10, 64)
+		if err != nil || n == 0 {
+			return "", fmt.Errorf("invalid length '%s' in type '%s'", args[indx], str)
+		}
+		return args[indx], nil
+	}
+
+	if len(args) == 0 {
+		if typ, ok := schemaUintTypes[name]; ok {
+			return &schemaGoType{goType: typ}, nil
+		}
+		if name == "boolean" || name == "bool" {
+			return &schemaGoType{goType: "bool"}, nil
+		}
+		if strings.HasPrefix(name, "Bytes") {
+			if n, err := strconv.ParseUint(strings.TrimPrefix(name, "Bytes"), 10, 64); err == nil && n != 0 {
+				return &schemaGoType{goType: "[]byte", sizes: []string{strconv.FormatUint(n, 10)}, maxes: []string{""}}, nil
+			}
+		}
+		if containers[name] {
+			return &schemaGoType{goType: "*" + name}, nil
+		}
+		return nil, fmt.Errorf("type '%s' not supported", str)
+	}
+
+	switch name {
+	case "ByteVector", "ByteList", "Bitlist":
+		n, err := num(0)
+		if err != nil {
+			return nil, err
+		}
+		if name == "ByteVector" {
+			return &schemaGoType{goType: "[]byte", sizes: []string{n}, maxes: []string{""}}, nil
+		}
+		return &schemaGoType{goType: "[]byte", sizes: []string{""}, maxes: []string{n}, bitlist: name == "Bitlist"}, nil
+
+	case "Bitvector":
+		// the bytes of the bitvector, the encoding and the root are the same
+		n, err := num(0)
+		if err != nil {
+			return nil, err
+		}
+		bits, _ := strconv.ParseUint(n, 10, 64)
+		return &schemaGoType{goType: "[]byte", sizes: []string{strconv.FormatUint((bits+7)/8, 10)}, maxes: []string{""}}, nil
+
+	case "Vector", "List":
+		n, err := num(1)
+		if err != nil {
+			return nil, err
+		}
+		elem, err := parseSchemaType(args[0], containers)
+		if err != nil {
+			return nil, err
+		}
+		size, max := n, ""
+		if name == "List" {
+			size, max = "", n
+		}
+		if elem.goType == "uint8" {
+			// a vector or a list of bytes
+			return &schemaGoType{goType: "[]byte", sizes: []string{size}, maxes: []string{max}}, nil
+		}
+		return &schemaGoType{
+			goType:  "[]" + elem.goType,
+			sizes:   append([]string{size}, elem.sizes...),
+			maxes:   append([]string{max}, elem.maxes...),
+			bitlist: elem.bitlist,
+		}, nil
+	}
+	return nil, fmt.Errorf("type '%s' not supported", str)
+}
+
+// splitSchemaType splits a type expression in its name and its top level arguments
+// (i.e. 'List', ['Vector[uint64, 4]', '16'] for 'List[Vector[uint64, 4], 16]')
+func splitSchemaType(str string) (string, []string, error) {
+	indx := strings.Index(str, "[")
+	if indx == -1 {
+		return str, nil, nil
+	}
+	if !strings.HasSuffix(str, "]") {
+		return "", nil, fmt.Errorf("type '%s' does not end with ']'", str)
+	}
+	name, inner := str[:indx], str[indx+1:len(str)-1]
+
+	args := []string{}
+	depth, start := 0, 0
+	for i, c := range inner {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth < 0 {
+				return "", nil, fmt.Errorf("unbalanced brackets in type '%s'", str)
+			}
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(inner[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return "", nil, fmt.Errorf("unbalanced brackets in type '%s'", str)
+	}
+	return name, append(args, strings.TrimSpace(inner[start:])), nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestImportExport(t *testing.T) {
+	// the types use the notation of the export (i.e. Bytes32 instead of ByteVector[32])
+	doc := &schemaDocument{
+		Package: "types",
+		Containers: []*schemaContainer{
+			{
+				Name: "Checkpoint",
+				Fields: []*schemaField{
+					{Name: "epoch", Type: "uint64"},
+					{Name: "root", Type: "Bytes32"},
+				},
+			},
+			{
+				Name: "Obj",
+				Fields: []*schemaField{
+					{Name: "a", Type: "uint8"},
+					{Name: "b", Type: "uint16"},
+					{Name: "c", Type: "uint32"},
+					{Name: "d", Type: "boolean"},
+					{Name: "extra_data", Type: "ByteList[64]"},
+					{Name: "aggregation_bits", Type: "Bitlist[2048]"},
+					{Name: "slashings", Type: "Vector[uint64, 4]"},
+					{Name: "block_roots", Type: "Vector[Bytes32, 2]"},
+					{Name: "historical_roots", Type: "List[Bytes32, 16]"},
+					{Name: "balances", Type: "List[uint64, 1024]"},
+					{Name: "source", Type: "Checkpoint"},
+					{Name: "checkpoints", Type: "List[Checkpoint, 8]"},
+				},
+			},
+		},
+	}
+
+	dir, err := ioutil.TempDir(".", "_sszgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := filepath.Join(dir, "types.json")
+	if err := ioutil.WriteFile(source, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := importCmd([]string{"--path", source}); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("go", "vet", "./"+dir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("the imported code does not build: %v\n%s", err, out)
+	}
+
+	// the export of the structs is the same document
+	output := filepath.Join(dir, "export.json")
+	if err := exportCmd([]string{"--path", filepath.Join(dir, "types.go"), "--output", output}); err != nil {
+		t.Fatal(err)
+	}
+	if data, err = ioutil.ReadFile(output); err != nil {
+		t.Fatal(err)
+	}
+	doc2 := &schemaDocument{}
+	if err := json.Unmarshal(data, doc2); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(doc, doc2) {
+		t.Fatalf("bad export:\n%s", data)
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"text/tabwriter"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// inspectCmd prints the SSZ layout of the structs: the size of the fixed part, the offsets
+// of the fields in it, which fields are variable, their limits and the chunks of their trees.
+func inspectCmd(args []string) error {
+	var source string
+	var typ string
+
+	flagSet := flag.NewFlagSet("inspect", flag.ExitOnError)
+	flagSet.StringVar(&source, "path", "", "")
+	flagSet.StringVar(&typ, "type", "", "")
+
+	opts := defaultOptions()
+	opts.register(flagSet)
+
+	flagSet.Parse(args)
+	opts.setup()
+
+	if typ == "" {
+		return fmt.Errorf("the type to inspect is not set")
+	}
+	for indx, name := range splitTargets(typ) {
+		schema, err := targetSchema(source, name, opts)
+		if err != nil {
+			return err
+		}
+		if indx != 0 {
+			fmt.Println()
+		}
+		if err := inspect(os.Stdout, schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inspect writes the layout of a container schema
+func inspect(w io.Writer, s *ssz.Schema) error {
+	variable := "fixed"
+	if !s.IsFixed() {
+		variable = "variable"
+	}
+	fmt.Fprintf(w, "%s (%s)\n", s.Name, variable)
+	fmt.Fprintf(w, "  fixed part: %d bytes\n", fixedPart(s))
+	if s.IsFixed() {
+		fmt.Fprintf(w, "  size: %d bytes\n", s.FixedSize())
+	} else if s.MaxSize() == math.MaxUint64 {
+		fmt.Fprintf(w, "  size: %d bytes or more\n", s.MinSize())
+	} else {
+		fmt.Fprintf(w, "  size: %d to %d bytes\n", s.MinSize(), s.MaxSize())
+	}
+	fmt.Fprintf(w, "  fields: %d, tree depth: %d\n\n", len(s.Fields), depth(s.ChunkCount()))
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "OFFSET\tSIZE\tFIELD\tTYPE\tVARIABLE\tLIMIT\tCHUNKS")
+
+	offset := uint64(0)
+	for _, f := range s.Fields {
+		size := fmt.Sprintf("%d", f.Schema.FixedSize())
+		variable := "no"
+		if !f.Schema.IsFixed() {
+			size += " (offset)"
+			variable = "yes"
+		}
+		limit, chunks := "-", fmt.Sprintf("%d", f.Schema.ChunkCount())
+		switch {
+		case f.Schema.Progressive:
+			limit, chunks = "none", "-"
+		case f.Schema.Kind == ssz.KindByteList || f.Schema.Kind == ssz.KindBitList || f.Schema.Kind == ssz.KindList:
+			limit = fmt.Sprintf("%d", f.Schema.Max)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", offset, size, f.Name, f.Schema, variable, limit, chunks)
+		offset += f.Schema.FixedSize()
+	}
+	return tw.Flush()
+}
+
+// fixedPart returns the size of the fixed part of a container, with the offsets of the variable fields
+func fixedPart(s *ssz.Schema) uint64 {
+	size := uint64(0)
+	for _, f := range s.Fields {
+		size += f.Schema.FixedSize()
+	}
+	return size
+}
+
+// depth returns the depth of the tree with the given number of chunks
+func depth(chunks uint64) int {
+	d := uint(0)
+	for uint64(1)<<d < chunks {
+		d++
+	}
+	return int(d)
+}
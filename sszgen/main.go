@@ -8,10 +8,13 @@ import (
 	"go/format"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
-	"reflect"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
@@ -19,58 +22,99 @@ import (
 
 const bytesPerLengthOffset = 4
 
+// commands are the sszgen subcommands that do not output Go encodings
+var commands = map[string]func(args []string) error{
+	"dump":    dumpCmd,
+	"export":  exportCmd,
+	"import":  importCmd,
+	"inspect": inspectCmd,
+	"proto":   protoCmd,
+	"python":  pythonCmd,
+	"sizes":   sizesCmd,
+	"spec":    specCmd,
+}
+
 func main() {
+	if len(os.Args) > 1 {
+		if cmd, ok := commands[os.Args[1]]; ok {
+			if err := cmd(os.Args[2:]); err != nil {
+				fmt.Printf("[ERR]: %v", err)
+			}
+			return
+		}
+	}
+
 	var source string
 	var objsStr string
 	var output string
+	var packageOutput bool
 
 	flag.StringVar(&source, "path", "", "")
 	flag.StringVar(&objsStr, "objs", "", "")
 	flag.StringVar(&output, "output", "", "")
+	flag.BoolVar(&packageOutput, "package-output", false, "")
+
+	opts := defaultOptions()
+	opts.register(flag.CommandLine)
 
 	flag.Parse()
+	opts.setup()
 
-	var targets []string
-	if objsStr != "" {
-		targets = strings.Split(strings.TrimSpace(objsStr), ",")
+	if packageOutput && output == "" {
+		var err error
+		if output, err = packageOutputPath(source); err != nil {
+			fmt.Printf("[ERR]: %v", err)
+			return
+		}
 	}
-
-	if err := encode(source, targets, output); err != nil {
+	if err := encode(source, splitTargets(objsStr), output, opts); err != nil {
 		fmt.Printf("[ERR]: %v", err)
 	}
 }
 
+func splitTargets(objsStr string) []string {
+	if objsStr == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSpace(objsStr), ",")
+}
+
 // The SSZ code generation works in three steps:
 // 1. Parse the Go input with the go/parser library to generate an AST representation.
 // 2. Convert the AST into an Internal Representation (IR) to describe the structs and fields
 // using the Value object.
 // 3. Use the IR to print the encoding functions
 
-func encode(source string, targets []string, output string) error {
-	files, err := parseInput(source) // 1.
+func encode(source string, targets []string, output string, opts *options) error {
+	e, err := newEnv(source, targets, opts) // 1. and 2.
 	if err != nil {
 		return err
 	}
 
-	// read package
-	var packName string
-	for _, file := range files {
-		packName = file.Name.Name
-	}
-
-	e := &env{
-		source:   source,
-		files:    files,
-		objs:     map[string]*Value{},
-		packName: packName,
-		targets:  targets,
+	// 3.
+	stdout := source == stdinSource && output == ""
+	if stdout {
+		// a single output written to the standard output
+		if opts.splitSize != 0 || opts.examples {
+			return fmt.Errorf("the split-size and examples options require an output file")
+		}
+		output = stdinName
+	} else if output != "" {
+		ok, err := isOutputDir(output)
+		if err != nil {
+			return err
+		}
+		if ok && source == stdinSource {
+			return fmt.Errorf("the output of the standard input is not a directory")
+		}
+		if ok {
+			// one output for each source file in the directory
+			e.outputDir, output = output, ""
+		}
 	}
-
-	if err := e.generateIR(); err != nil { // 2.
+	if e.declared, err = e.declaredErrors(output); err != nil {
 		return err
 	}
-
-	// 3.
 	var out map[string]string
 	if output == "" {
 		out = e.generateEncodings()
@@ -82,21 +126,155 @@ func encode(source string, targets []string, output string) error {
 		// empty output
 		panic("No files to generate")
 	}
+	if opts.examples {
+		for name, str := range e.generateExamples(output) {
+			out[name] = str
+		}
+	}
 
+	header, err := readHeader(opts.header)
+	if err != nil {
+		return err
+	}
 	for name, str := range out {
-		output := []byte(str)
+		output := append(append([]byte{}, header...), str...)
 
 		output, err = format.Source(output)
 		if err != nil {
 			return err
 		}
+		if stdout {
+			if _, err := os.Stdout.Write(output); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := ioutil.WriteFile(name, output, 0644); err != nil {
 			return err
 		}
 	}
+	if stdout {
+		return nil
+	}
+	if err := removeStaleParts(out); err != nil {
+		return err
+	}
+	if opts.report != "" {
+		return e.writeReport(opts.report, out)
+	}
+	return nil
+}
+
+const generatedHeader = "// Code generated by fastssz. DO NOT EDIT."
+
+// readHeader reads the file with the header of the generated files (i.e. a license). Its
+// content is inserted verbatim at the top of the files, it must be made of Go comments.
+func readHeader(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasSuffix(data, []byte("\n")) {
+		data = append(data, '\n')
+	}
+	// a blank line so that the header is not the doc comment of the package
+	return append(data, '\n'), nil
+}
+
+// removeStaleParts removes the parts of the outputs written with the split-size option
+// in a previous run that have not been written again (i.e. the output is smaller now).
+func removeStaleParts(out map[string]string) error {
+	for name := range out {
+		if unsplitName(name) != name {
+			continue
+		}
+		parts, err := filepath.Glob(partRegexp.ReplaceAllString(partName(name, 1), "_*$1"))
+		if err != nil {
+			return err
+		}
+		for _, part := range parts {
+			if _, ok := out[part]; ok || unsplitName(part) != name {
+				continue
+			}
+			data, err := ioutil.ReadFile(part)
+			if err != nil {
+				return err
+			}
+			if !bytes.Contains(data, []byte(generatedHeader)) {
+				continue
+			}
+			if err := os.Remove(part); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
+// newEnv parses the Go input and builds the IR for the targets. It is
+// shared by the encoding generation and the other sszgen commands.
+func newEnv(source string, targets []string, opts *options) (*env, error) {
+	fset := token.NewFileSet()
+	files, err := parseInput(fset, source)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.discover && targets == nil {
+		if targets, err = discoverTargets(files); err != nil {
+			return nil, err
+		}
+	}
+
+	// read package
+	var packName string
+	for _, file := range files {
+		packName = file.Name.Name
+	}
+
+	e := &env{
+		source:   source,
+		fset:     fset,
+		files:    files,
+		objs:     map[string]*Value{},
+		packName: packName,
+		targets:  targets,
+		opts:     opts,
+		types:    namedTypes(files),
+		pkgTypes: map[string]map[string]ast.Expr{},
+		pkgNames: map[string]string{},
+	}
+
+	if err := e.generateIR(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// stdinSource is the path of the source read from the standard input
+const stdinSource = "-"
+
+// stdinName is the name of the file read from the standard input in the positions of the errors
+const stdinName = "<stdin>"
+
+// sourceDir returns the directory of the source, the current one for the standard input
+func (e *env) sourceDir() (string, error) {
+	if e.source == stdinSource {
+		return ".", nil
+	}
+	ok, err := isDir(e.source)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return filepath.Dir(e.source), nil
+	}
+	return e.source, nil
+}
+
 func isDir(path string) (bool, error) {
 	fileInfo, err := os.Stat(path)
 	if err != nil {
@@ -105,16 +283,29 @@ func isDir(path string) (bool, error) {
 	return fileInfo.IsDir(), nil
 }
 
-func parseInput(source string) (map[string]*ast.File, error) {
+func parseInput(fset *token.FileSet, source string) (map[string]*ast.File, error) {
 	files := map[string]*ast.File{}
 
+	if source == stdinSource {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		astfile, err := parser.ParseFile(fset, stdinName, data, parser.AllErrors|parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files[stdinName] = astfile
+		return files, nil
+	}
+
 	ok, err := isDir(source)
 	if err != nil {
 		return nil, err
 	}
 	if ok {
 		// dir
-		astFiles, err := parser.ParseDir(token.NewFileSet(), source, nil, parser.AllErrors)
+		astFiles, err := parser.ParseDir(fset, source, nil, parser.AllErrors|parser.ParseComments)
 		if err != nil {
 			return nil, err
 		}
@@ -123,7 +314,7 @@ func parseInput(source string) (map[string]*ast.File, error) {
 		}
 	} else {
 		// single file
-		astfile, err := parser.ParseFile(token.NewFileSet(), source, nil, parser.AllErrors)
+		astfile, err := parser.ParseFile(fset, source, nil, parser.AllErrors|parser.ParseComments)
 		if err != nil {
 			return nil, err
 		}
@@ -153,6 +344,35 @@ type Value struct {
 	c bool
 	// another auxiliary int number
 	m uint64
+	// struct tags of the field this value represents
+	tags string
+	// protobuf wrapper type of the value (i.e. wrapperspb.UInt64Value)
+	wrapper string
+	// Go type of an uint256 value (i.e. *uint256.Int)
+	uint256 string
+	// Go type of the fixed bytes that are an array (i.e. [32]byte or common.Hash), which are sliced with [:]
+	array string
+	// ssz tag of a time.Time value encoded as an uint64 timestamp, either 'unix' or 'unix-milli'
+	unixTime string
+	// progressive is set for the lists and bitlists without limit that are merkleized progressively
+	progressive bool
+	// byValue is set if the marshal methods of the container use a value receiver
+	byValue bool
+	// structValue is set for a container stored by value in its field (i.e. V instead of *V)
+	structValue bool
+	// fork is the suffix of the marshal methods of a container whose fields depend on
+	// the fork (i.e. Altair for MarshalSSZAltair), empty for the methods of the latest fork
+	fork string
+	// pool is set if the nested containers are taken from the pool of UnmarshalSSZWithPool
+	pool bool
+	// noCopy is set if the byte fields alias the input of UnmarshalSSZNoCopy
+	noCopy bool
+	// buffers is set if the value is marshaled by MarshalSSZToBuffers
+	buffers bool
+	// getter is set if the value is read with the protobuf getter of the field
+	getter bool
+	// ptr is set for the bytes of a *[]byte field, a nil pointer is encoded as the zero value
+	ptr bool
 }
 
 func (v *Value) copy() *Value {
@@ -168,6 +388,17 @@ func (v *Value) copy() *Value {
 	return vv
 }
 
+// each calls f with the value and its nested values
+func (v *Value) each(f func(v *Value)) {
+	f(v)
+	for _, i := range v.o {
+		i.each(f)
+	}
+	if v.e != nil {
+		v.e.each(f)
+	}
+}
+
 // Type is a SSZ type
 type Type int
 
@@ -215,6 +446,8 @@ func (t Type) String() string {
 
 type env struct {
 	source string
+	// file set of the files, used to report the position of the types
+	fset *token.FileSet
 	// map of files with their Go AST format
 	files map[string]*ast.File
 	// name of the package
@@ -227,45 +460,228 @@ type env struct {
 	order map[string][]string
 	// target structures to encode
 	targets []string
+	// settings to read the Go types
+	opts *options
+	// map of the named types that are not structs with their Go AST format
+	types map[string]ast.Expr
+	// map of the types of other packages by import path
+	pkgTypes map[string]map[string]ast.Expr
+	// map of the package names by import path
+	pkgNames map[string]string
+	// types with the '//sszgen:value-receiver' comment
+	valueReceivers map[string]bool
+	// error variables that are already declared in the package
+	declared map[string]bool
+	// structs whose fields depend on the fork with all their fields, the objs have the fields of the latest fork
+	forked map[string]*Value
+	// structs and fields that are not generated, for the report
+	skipped []reportSkipped
+	// outputDir is the directory of the outputs of the source files, empty to write them next to the sources
+	outputDir string
 }
 
 const encodingPrefix = "_encoding.go"
 
+// packageOutputName is the file with all the encodings of the package in the package-output mode
+const packageOutputName = "ssz_encoding.gen.go"
+
+// packageOutputPath returns the path of the single output file for the package of the source.
+// The name does not depend on the source files, renaming or splitting them does not leave
+// stale encodings behind.
+func packageOutputPath(source string) (string, error) {
+	ok, err := isDir(source)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		source = filepath.Dir(source)
+	}
+	return filepath.Join(source, packageOutputName), nil
+}
+
 func (e *env) generateOutputEncodings(output string) map[string]string {
 	out := map[string]string{}
 
 	orders := []string{}
-	for _, order := range e.order {
-		orders = append(orders, order...)
+	for _, name := range e.orderedFiles() {
+		orders = append(orders, e.order[name]...)
 	}
 
-	res, ok := e.print(true, orders)
+	parts, ok := e.print(orders)
 	if !ok {
 		return nil
 	}
-	out[output] = res
+	for indx, part := range parts {
+		out[partName(output, indx)] = part
+	}
 	return out
 }
 
 func (e *env) generateEncodings() map[string]string {
 	outs := map[string]string{}
 
-	firstDone := true
-	for name, order := range e.order {
-		// remove .go prefix and replace if with our own
-		ext := filepath.Ext(name)
-		name = strings.TrimSuffix(name, ext)
-		name += encodingPrefix
-
-		vvv, ok := e.print(firstDone, order)
+	for _, name := range e.orderedFiles() {
+		parts, ok := e.print(e.order[name])
 		if ok {
-			firstDone = false
-			outs[name] = vvv
+			for indx, part := range parts {
+				outs[partName(e.encodingName(name), indx)] = part
+			}
 		}
 	}
 	return outs
 }
 
+// partName returns the name of the file for a part of a split output. The first part
+// uses the name of the output and the others add the part number (i.e. a_encoding_2.go).
+func partName(name string, indx int) string {
+	if indx == 0 {
+		return name
+	}
+	dir, base := filepath.Split(name)
+	ext := ""
+	if i := strings.Index(base, "."); i != -1 {
+		base, ext = base[:i], base[i:]
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s_%d%s", base, indx+1, ext))
+}
+
+var partRegexp = regexp.MustCompile(`_[0-9]+(\.[^/]*)$`)
+
+// unsplitName returns the name of the output of a part written by partName
+func unsplitName(name string) string {
+	return partRegexp.ReplaceAllString(name, "$1")
+}
+
+// encodingName returns the name of the file with the encodings of a source file
+func encodingName(name string) string {
+	// remove .go prefix and replace if with our own
+	ext := filepath.Ext(name)
+	return strings.TrimSuffix(name, ext) + encodingPrefix
+}
+
+// encodingName returns the name of the output of a source file, in the output directory if it is set
+func (e *env) encodingName(name string) string {
+	if e.outputDir == "" {
+		return encodingName(name)
+	}
+	return filepath.Join(e.outputDir, filepath.Base(encodingName(name)))
+}
+
+// isOutputDir returns true if the output is a directory. An output with a trailing
+// separator is a directory, which is created if it does not exist.
+func isOutputDir(output string) (bool, error) {
+	if strings.HasSuffix(output, string(filepath.Separator)) {
+		return true, os.MkdirAll(output, 0755)
+	}
+	ok, err := isDir(output)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return ok, err
+}
+
+// orderedFiles returns the names of the files with structs sorted so that
+// the output (i.e. the file that declares the error variables) is stable.
+func (e *env) orderedFiles() []string {
+	files := []string{}
+	for name := range e.order {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// orderedStructs returns the names of the structs of the files in order
+func (e *env) orderedStructs() []string {
+	names := []string{}
+	for _, file := range e.orderedFiles() {
+		for _, name := range e.order[file] {
+			if _, ok := e.raw[name]; ok {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// declaredErrors returns the error variables declared by the other files of the
+// package, i.e. the encodings generated for other files in a previous run.
+// The files that are going to be written are not read.
+func (e *env) declaredErrors(output string) (map[string]bool, error) {
+	skip := map[string]bool{}
+	if output != "" {
+		skip[output] = true
+	} else {
+		for name := range e.order {
+			skip[e.encodingName(name)] = true
+		}
+	}
+	for name := range skip {
+		abs, err := filepath.Abs(name)
+		if err != nil {
+			return nil, err
+		}
+		skip[abs] = true
+	}
+
+	if e.source == stdinSource {
+		// the other files of the package are not known
+		return map[string]bool{}, nil
+	}
+	dir, err := e.sourceDir()
+	if err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	if e.outputDir != "" {
+		// the encodings of the other runs in the output directory
+		outputs, err := filepath.Glob(filepath.Join(e.outputDir, "*.go"))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, outputs...)
+	}
+
+	declared := map[string]bool{}
+	for _, name := range files {
+		abs, err := filepath.Abs(name)
+		if err != nil {
+			return nil, err
+		}
+		if e.opts.splitSize != 0 && skip[unsplitName(abs)] {
+			// part of a split output that is going to be written again
+			continue
+		}
+		if skip[abs] || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), name, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		if file.Name.Name != e.packName {
+			continue
+		}
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				for _, ident := range spec.(*ast.ValueSpec).Names {
+					if _, ok := errorFunctions[ident.Name]; ok {
+						declared[ident.Name] = true
+					}
+				}
+			}
+		}
+	}
+	return declared, nil
+}
+
 var errorFunctions = map[string]string{
 	"errOffset":              "incorrect offset",
 	"errSize":                "incorrect size",
@@ -277,14 +693,20 @@ var errorFunctions = map[string]string{
 	"errListTooBig":          "incorrect list size, too big",
 }
 
-func (e *env) print(first bool, order []string) (string, bool) {
-	tmpl := `// Code generated by fastssz. DO NOT EDIT.
+// print prints the encodings of the objects in order. The output is a single file unless
+// the split-size option is set, then the objects are split in parts of about that size.
+// The error variables are declared in the first part.
+func (e *env) print(order []string) ([]string, bool) {
+	tmpl := generatedHeader + `
 	package {{.package}}
 	
 	import (
 		{{ if .errorFuncs }}"fmt"
+		{{ end }}{{ if .random }}"math/rand"
 		{{ end }}
 		ssz "github.com/ferranbt/fastssz"
+		{{ range .imports }}{{ . }}
+		{{ end }}
 	)
 
 	{{ if .errorFuncs }}
@@ -298,44 +720,123 @@ func (e *env) print(first bool, order []string) (string, bool) {
 		{{ .Marshal }}
 		{{ .Unmarshal }}
 		{{ .Size }}
+		{{ .HashTreeRoot }}
+		{{ .Forks }}
+		{{ .Schema }}
+		{{ .Random }}
+		{{ .Text }}
 	{{ end }}
 	`
 
-	data := map[string]interface{}{
-		"package": e.packName,
-	}
-
-	if first {
-		// Marshal and Unmarshal function return global error functions when the safe checks fail.
-		// We must ensure there is only one copy of those functions in the package. We only include
-		// the functions on the first file with content.
-		data["errorFuncs"] = errorFunctions
-	}
-
 	type Obj struct {
-		Size, Marshal, Unmarshal string
+		Size, Marshal, Unmarshal, HashTreeRoot, Forks, Schema, Random, Text string
+		code                                                                string
+		value                                                               *Value
 	}
 
 	objs := []*Obj{}
 	// Print the objects in the order in which they appear on the file.
 	for _, name := range order {
+		if text, ok := e.text(name); ok {
+			objs = append(objs, &Obj{Text: text, code: text})
+			continue
+		}
 		obj, ok := e.objs[name]
 		if !ok {
 			continue
 		}
-		objs = append(objs, &Obj{
-			Marshal:   e.marshal(name, obj),
-			Unmarshal: e.unmarshal(name, obj),
-			Size:      e.size(name, obj),
-		})
+		res := &Obj{
+			Marshal:      e.marshal(name, obj),
+			Unmarshal:    e.unmarshal(name, obj),
+			Size:         e.size(name, obj),
+			HashTreeRoot: e.hashTreeRoot(name, obj),
+			value:        obj,
+		}
+		if full, ok := e.forked[name]; ok {
+			res.Forks = e.forkVariants(name, full)
+		}
+		if e.opts.schema {
+			res.Schema = e.schema(name, obj)
+		}
+		if e.opts.random {
+			res.Random = e.random(name, obj)
+		}
+		res.code = strings.Join([]string{res.Marshal, res.Unmarshal, res.Size, res.HashTreeRoot, res.Forks, res.Schema, res.Random}, "\n")
+		objs = append(objs, res)
 	}
 
 	if len(objs) == 0 {
 		// No valid objects found for this file
-		return "", false
+		return nil, false
 	}
-	data["objs"] = objs
-	return execTmpl(tmpl, data), true
+
+	code := []string{}
+	for _, obj := range objs {
+		code = append(code, obj.code)
+	}
+	errorFuncs := e.usedErrors(strings.Join(code, "\n"))
+
+	// split the objects, a part has at least one object even if it is bigger than the size
+	parts := [][]*Obj{}
+	var size int
+	for _, obj := range objs {
+		if len(parts) == 0 || (e.opts.splitSize != 0 && size+len(obj.code) > e.opts.splitSize) {
+			parts = append(parts, []*Obj{})
+			size = 0
+		}
+		parts[len(parts)-1] = append(parts[len(parts)-1], obj)
+		size += len(obj.code)
+	}
+
+	res := []string{}
+	for indx, part := range parts {
+		values := []*Value{}
+		random := false
+		for _, obj := range part {
+			if obj.value != nil {
+				values = append(values, obj.value)
+			}
+			if obj.Random != "" {
+				random = true
+			}
+		}
+		data := map[string]interface{}{
+			"package": e.packName,
+			"random":  random,
+			"objs":    part,
+			"imports": e.usedImports(values),
+		}
+		if indx == 0 {
+			data["errorFuncs"] = errorFuncs
+		}
+		res = append(res, execTmpl(tmpl, data))
+	}
+	return res, true
+}
+
+// usedErrors returns the error variables referenced by the code that have not been declared yet.
+// Marshal and Unmarshal return those global errors when the safe checks fail, each of them is
+// declared only once in the package, in the first file that uses it.
+func (e *env) usedErrors(code string) map[string]string {
+	res := map[string]string{}
+	for name, msg := range errorFunctions {
+		if e.declared[name] {
+			continue
+		}
+		if regexp.MustCompile(`\b` + name + `\b`).MatchString(code) {
+			res[name] = msg
+			e.declared[name] = true
+		}
+	}
+	return res
+}
+
+// receiver returns the receiver type of the marshal methods of the container
+func (v *Value) receiver() string {
+	if v.byValue {
+		return v.obj
+	}
+	return "*" + v.obj
 }
 
 // All the generated functions use the '::' string to represent the pointer receiver
@@ -351,14 +852,32 @@ func (e *env) generateIR() error {
 	e.raw = map[string]*ast.StructType{}
 	e.order = map[string][]string{}
 
+	e.valueReceivers = map[string]bool{}
+
+	// position of the type definitions to detect duplicates
+	positions := map[string]token.Pos{}
+
 	for name, file := range e.files {
 		structOrdering := []string{}
 		for _, dec := range file.Decls {
 			if genDecl, ok := dec.(*ast.GenDecl); ok {
 				for _, spec := range genDecl.Specs {
 					if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+						if pos, ok := positions[typeSpec.Name.Name]; ok {
+							found := []string{e.fset.Position(pos).String(), e.fset.Position(typeSpec.Pos()).String()}
+							sort.Strings(found)
+							return fmt.Errorf("type %s is defined twice: %s and %s", typeSpec.Name.Name, found[0], found[1])
+						}
+						positions[typeSpec.Name.Name] = typeSpec.Pos()
+
 						if structType, ok := typeSpec.Type.(*ast.StructType); ok {
 							e.raw[typeSpec.Name.Name] = structType
+							if hasTypeMarker(genDecl, typeSpec, valueReceiverMarker) {
+								e.valueReceivers[typeSpec.Name.Name] = true
+							}
+							structOrdering = append(structOrdering, typeSpec.Name.Name)
+						} else if e.opts.text && isByteType(typeSpec.Type) {
+							// named byte type for the text marshal functions
 							structOrdering = append(structOrdering, typeSpec.Name.Name)
 						}
 					}
@@ -381,6 +900,23 @@ func (e *env) generateIR() error {
 			}
 		}
 	}
+	// the structs that are not targets are still generated if a target uses them
+	for _, name := range e.orderedStructs() {
+		if _, ok := e.objs[name]; !ok {
+			e.skip(name, "not a target of the objs flag")
+		}
+	}
+
+	// the default functions encode the fields of the latest fork
+	e.forked = map[string]*Value{}
+	if forks := e.forkNames(); len(forks) != 0 {
+		for name, v := range e.objs {
+			if isForked(v) {
+				e.forked[name] = v
+				e.objs[name] = e.forkValue(v, len(forks)-1, "")
+			}
+		}
+	}
 	return nil
 }
 
@@ -403,6 +939,7 @@ func (e *env) encodeItem(name string) (*Value, error) {
 		}
 		v.name = name
 		v.obj = name
+		v.byValue = e.opts.valueReceiver || e.valueReceivers[name]
 		e.objs[name] = v
 	}
 	return v.copy(), nil
@@ -417,24 +954,64 @@ func (e *env) parseASTStructType(name string, typ *ast.StructType) (*Value, erro
 	}
 
 	for _, f := range typ.Fields.List {
+		if len(f.Names) == 0 {
+			if e.opts.skipEmbedded {
+				e.skip(v.name+"."+types.ExprString(f.Type), "embedded field")
+				continue
+			}
+			return nil, fmt.Errorf("embedded field in %s not supported", name)
+		}
 		name := f.Names[0].Name
 		if !isExportedField(name) {
+			e.skip(v.name+"."+name, "unexported field")
 			continue
 		}
 		if strings.HasPrefix(name, "XXX_") {
-			// skip protobuf methods
+			// skip the internal fields of the protobuf types
+			e.skip(v.name+"."+name, "protobuf field")
 			continue
 		}
 		var tags string
 		if f.Tag != nil {
 			tags = f.Tag.Value
 		}
+		if tag, ok := getTags(tags, "ssz"); ok && tag == "inline" {
+			fields, err := e.inlineFields(name, f.Type)
+			if err != nil {
+				return nil, err
+			}
+			v.o = append(v.o, fields...)
+			continue
+		}
 
 		elem, err := e.parseASTFieldType(tags, f.Type)
 		if err != nil {
 			return nil, err
 		}
 		elem.name = name
+		elem.tags = tags
+		if _, _, _, err := e.forkRange(tags); err != nil {
+			return nil, fmt.Errorf("field %s of %s: %v", name, v.name, err)
+		}
+		if e.opts.strict {
+			if err := checkTags(tags, elem); err != nil {
+				return nil, fmt.Errorf("field %s of %s: %v", name, v.name, err)
+			}
+		}
+		if e.opts.useGetters {
+			if elem.array != "" {
+				// the result of the getter cannot be sliced
+				return nil, fmt.Errorf("field %s of %s: byte arrays are not supported with the use-getters option", name, v.name)
+			}
+			if elem.structValue {
+				// the result of the getter is a copy whose methods cannot be called
+				return nil, fmt.Errorf("field %s of %s: structs stored by value are not supported with the use-getters option", name, v.name)
+			}
+			// the elements of the lists are read with the getter of the list too
+			for i := elem; i != nil; i = i.e {
+				i.getter = true
+			}
+		}
 		v.o = append(v.o, elem)
 	}
 
@@ -451,19 +1028,78 @@ func (e *env) parseASTStructType(name string, typ *ast.StructType) (*Value, erro
 	return v, nil
 }
 
+// inlineFields returns the fields of a struct field with the 'ssz:"inline"' tag. Those are
+// encoded as fields of the parent struct (i.e. its field 'Inner.Epoch') instead of as a container.
+func (e *env) inlineFields(name string, expr ast.Expr) ([]*Value, error) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return nil, fmt.Errorf("inline field %s must be a struct of the package, not a pointer", name)
+	}
+	if _, ok := e.raw[ident.Name]; !ok {
+		return nil, fmt.Errorf("inline field %s must be a struct of the package but found %s", name, ident.Name)
+	}
+	inner, err := e.encodeItem(ident.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range inner.o {
+		f.name = name + "." + f.name
+	}
+	return inner.o, nil
+}
+
 // parse the Go AST field
 func (e *env) parseASTFieldType(tags string, expr ast.Expr) (*Value, error) {
 	switch obj := expr.(type) {
 	case *ast.StarExpr:
+		if sel, ok := obj.X.(*ast.SelectorExpr); ok {
+			if v, ok := e.parseUint256Type(sel, true); ok {
+				// *uint256.Int
+				return v, nil
+			}
+			// *wrapperspb.UInt64Value
+			return e.parseWrapperType(tags, sel)
+		}
+		if arr, ok := obj.X.(*ast.ArrayType); ok {
+			// *[]byte
+			v, err := e.parseASTFieldType(tags, arr)
+			if err != nil {
+				return nil, err
+			}
+			if v.t != TypeBytes || v.wrapper != "" || v.obj != "" || v.array != "" {
+				return nil, fmt.Errorf("pointer to slice only supports fixed and dynamic bytes")
+			}
+			v.ptr = true
+			return v, nil
+		}
 		// *Struct
-		return e.encodeItem(obj.X.(*ast.Ident).Name)
+		ident, ok := obj.X.(*ast.Ident)
+		if !ok {
+			return nil, fmt.Errorf("pointer to %s not supported", types.ExprString(obj.X))
+		}
+		if _, ok := e.raw[ident.Name]; !ok {
+			return nil, fmt.Errorf("struct %s not found", ident.Name)
+		}
+		return e.encodeItem(ident.Name)
 
 	case *ast.ArrayType:
+		if obj.Len != nil {
+			// [32]byte
+			return arrayValue(tags, obj)
+		}
 		if isByte(obj.Elt) {
 			// []byte
-			if tag, ok := getTags(tags, "ssz"); ok && tag == "bitlist" {
+			tag, _ := getTags(tags, "ssz")
+			switch tag {
+			case "bitlist":
 				// bitlist
-				return &Value{t: TypeBitList}, nil
+				return bitlistValue(tags)
+			case "progressive-bitlist":
+				// progressive bitlist
+				return &Value{t: TypeBitList, progressive: true}, nil
+			case "progressive":
+				// progressive list of bytes
+				return &Value{t: TypeBytes, progressive: true}, nil
 			}
 			size, ok := getTagsInt(tags, "ssz-size")
 			if ok {
@@ -471,24 +1107,31 @@ func (e *env) parseASTFieldType(tags string, expr ast.Expr) (*Value, error) {
 				return &Value{t: TypeBytes, s: size, n: size}, nil
 			}
 			max, ok := getTagsInt(tags, "ssz-max")
+			if !ok && e.opts.defaultBytesMax > 0 {
+				// untagged bytes with the default limit
+				max, ok = uint64(e.opts.defaultBytesMax), true
+			}
 			if !ok {
 				return nil, fmt.Errorf("[]byte expects either ssz-max or ssz-size")
 			}
 			// dynamic bytes
 			return &Value{t: TypeBytes, m: max}, nil
 		}
-		if isArray(obj.Elt) && isByte(obj.Elt.(*ast.ArrayType).Elt) {
-			// [][]byte
-			f, s, ok := getTagsTuple(tags, "ssz-size")
-			if !ok {
-				return nil, fmt.Errorf("[][]byte expects a ssz-size tag")
-			}
+		tag, _ := getTags(tags, "ssz")
+		f, s, ok := getTagsTuple(tags, "ssz-size")
+		if isArray(obj.Elt) && obj.Elt.(*ast.ArrayType).Len == nil && isByte(obj.Elt.(*ast.ArrayType).Elt) && tag != "bitlist" && ok {
+			// [][]byte of fixed bytes, the lists of dynamic bytes (i.e. the transactions with
+			// 'ssz-size:"?,?" ssz-max:"1048576,1073741824"') are parsed as the other nested slices
 			if f != 0 {
 				// vector
 				return &Value{t: TypeVector, c: true, n: f * s, s: f, e: &Value{t: TypeBytes, n: s, s: s}}, nil
 			}
+			if tag, ok := getTags(tags, "ssz"); ok && tag == "progressive" {
+				// progressive list
+				return &Value{t: TypeList, c: true, e: &Value{t: TypeBytes, n: s, s: s}, progressive: true}, nil
+			}
 			if f == 0 {
-				f, ok = getTagsInt(tags, "ssz-max")
+				f, ok = getTagsDim(tags, "ssz-max")
 				if !ok {
 					return nil, fmt.Errorf("ssz-max not set after '?' field on ssz-size")
 				}
@@ -497,12 +1140,25 @@ func (e *env) parseASTFieldType(tags string, expr ast.Expr) (*Value, error) {
 			return &Value{t: TypeList, c: true, s: f, e: &Value{t: TypeBytes, n: s, s: s}}, nil
 		}
 
-		// []*Struct
-		elem, err := e.parseASTFieldType(tags, obj.Elt)
+		// []*Struct. The tags set the size or max of each dimension (i.e. 'ssz-max:"16,32"'
+		// for a [][]uint64 or 'ssz-size:"?,?,32" ssz-max:"8,16"' for a [][][]byte), the
+		// element is parsed with the tags of the inner dimensions.
+		elem, err := e.parseASTFieldType(innerTags(tags), obj.Elt)
 		if err != nil {
 			return nil, err
 		}
-		if size, ok := getTagsInt(tags, "ssz-size"); ok {
+		if elem.unixTime != "" {
+			return nil, fmt.Errorf("slices of time.Time not supported")
+		}
+		if sel, ok := obj.Elt.(*ast.SelectorExpr); ok && (elem.t == TypeBitList || elem.t == TypeBitVector) {
+			// []bitfield.Bitlist or []bitfield.Bitvector64, the Go type creates the slice
+			elem.obj = sel.X.(*ast.Ident).Name + "." + sel.Sel.Name
+		}
+		if tag, ok := getTags(tags, "ssz"); ok && tag == "progressive" {
+			// progressive list
+			return &Value{t: TypeList, e: elem, progressive: true}, nil
+		}
+		if size, ok := getTagsDim(tags, "ssz-size"); ok {
 			// fixed vector
 			v := &Value{t: TypeVector, s: size, e: elem}
 			if elem.isFixed() {
@@ -512,7 +1168,7 @@ func (e *env) parseASTFieldType(tags string, expr ast.Expr) (*Value, error) {
 			return v, err
 		}
 		// list
-		maxSize, ok := getTagsInt(tags, "ssz-max")
+		maxSize, ok := getTagsDim(tags, "ssz-max")
 		if !ok {
 			return nil, fmt.Errorf("slice expects either ssz-max or ssz-size")
 		}
@@ -534,7 +1190,20 @@ func (e *env) parseASTFieldType(tags string, expr ast.Expr) (*Value, error) {
 		case "bool":
 			v = &Value{t: TypeBool, n: 1}
 		default:
-			panic(fmt.Errorf("basic type %s not found", obj.Name))
+			if _, ok := e.raw[obj.Name]; ok {
+				// Struct stored by value
+				v, err := e.encodeItem(obj.Name)
+				if err != nil {
+					return nil, err
+				}
+				v.structValue = true
+				return v, nil
+			}
+			if expr, ok := e.types[obj.Name]; ok {
+				// named type
+				return e.resolveNamedType(tags, obj.Name, expr)
+			}
+			return nil, fmt.Errorf("type %s not found", obj.Name)
 		}
 		return v, nil
 
@@ -542,15 +1211,220 @@ func (e *env) parseASTFieldType(tags string, expr ast.Expr) (*Value, error) {
 		name := obj.X.(*ast.Ident).Name
 		sel := obj.Sel.Name
 
-		if sel == "Bitlist" {
+		if v, ok := e.parseUint256Type(obj, false); ok {
+			// uint256.Int
+			return v, nil
+		}
+		if v, ok, err := e.parseTimeType(tags, obj); ok {
+			// time.Time
+			return v, err
+		}
+		// the go-bitfield Bitlist and BitvectorN types or the bitfield types of the options
+		bitLen, isBitfield, err := e.bitfieldType(name, sel)
+		if err != nil {
+			return nil, err
+		}
+		if !isBitfield && sel == "Bitlist" {
+			isBitfield = true
+		} else if !isBitfield {
+			bitLen, isBitfield = bitvectorLen(sel)
+		}
+		if isBitfield && bitLen == 0 {
 			// go-bitfield/Bitlist
-			return &Value{t: TypeBitList}, nil
+			if tag, ok := getTags(tags, "ssz"); ok && tag == "progressive-bitlist" {
+				return &Value{t: TypeBitList, progressive: true}, nil
+			}
+			return bitlistValue(tags)
+		}
+		if isBitfield {
+			// go-bitfield/BitvectorN
+			size := (bitLen + 7) / 8
+			if tagSize, ok := getTagsInt(tags, "ssz-size"); ok && tagSize != size {
+				return nil, fmt.Errorf("%s.%s expects a ssz-size of %d but found %d", name, sel, size, tagSize)
+			}
+			return &Value{t: TypeBitVector, n: size, s: size, m: bitLen}, nil
+		}
+		// named type from another package (i.e. gogoproto customtype)
+		typ, err := e.resolveSelector(name, sel)
+		if err != nil {
+			return nil, fmt.Errorf("select for %s.%s not found: %v", name, sel, err)
 		}
-		return nil, fmt.Errorf("select for %s.%s not found", name, sel)
+		return e.resolveNamedType(tags, name+"."+sel, typ)
 
 	default:
-		panic(fmt.Errorf("ast type '%s' not expected", reflect.TypeOf(expr)))
+		return nil, fmt.Errorf("type %s not supported", types.ExprString(expr))
+	}
+}
+
+// basicValue returns the expression to read the value of a basic type
+// (uint, bool and bytes) field as a Go basic type.
+func (v *Value) basicValue() string {
+	if v.wrapper != "" {
+		// the getter of the wrapper returns the zero value if it is nil
+		return v.field() + ".GetValue()"
+	}
+	if v.ptr {
+		return fmt.Sprintf("ssz.DerefBytes(%s, %d)", v.field(), v.s)
+	}
+	if v.array != "" {
+		return v.field() + "[:]"
 	}
+	if v.uint256 != "" {
+		// the ssz helpers take the words of the uint256
+		if strings.HasPrefix(v.uint256, "*") {
+			return "(*[4]uint64)(" + v.field() + ")"
+		}
+		return "(*[4]uint64)(&" + v.field() + ")"
+	}
+	if v.obj != "" {
+		// convert the named type
+		switch v.t {
+		case TypeUint:
+			return strings.ToLower(uintVToName(v)) + "(" + v.field() + ")"
+		case TypeBool:
+			return "bool(" + v.field() + ")"
+		}
+	}
+	return v.field()
+}
+
+// index returns the name of the variable that loops over the elements of the value.
+// The nested lists (i.e. [][]uint64) use a different variable for each dimension.
+func (v *Value) index() string {
+	c := string(rune('i' + strings.Count(v.name, "[")))
+	return c + c
+}
+
+// goType returns the Go type of the value, it is used to create the nested lists
+func (v *Value) goType() string {
+	switch v.t {
+	case TypeUint:
+		if v.uint256 != "" {
+			return v.uint256
+		}
+		if v.obj != "" {
+			return v.obj
+		}
+		return strings.ToLower(uintVToName(v))
+	case TypeBool:
+		if v.obj != "" {
+			return v.obj
+		}
+		return "bool"
+	case TypeBytes:
+		if v.array != "" {
+			return v.array
+		}
+		return "[]byte"
+	case TypeBitList, TypeBitVector:
+		if v.obj != "" {
+			return v.obj
+		}
+		return "[]byte"
+	case TypeContainer:
+		if v.structValue {
+			return v.obj
+		}
+		return "*" + v.obj
+	case TypeVector, TypeList:
+		return "[]" + v.e.goType()
+	default:
+		panic(fmt.Errorf("go type not implemented for type %s", v.t.String()))
+	}
+}
+
+// field returns the expression to read the field. With the use-getters option
+// it calls the protobuf getters instead (i.e. ::.GetRoot() or ::.GetRoots()[ii]).
+func (v *Value) field() string {
+	if !v.getter {
+		return "::." + v.name
+	}
+	name, index := v.name, ""
+	if indx := strings.Index(name, "["); indx != -1 {
+		name, index = name[:indx], name[indx:]
+	}
+	// the fields of an inline struct are read with the getters of both structs
+	getters := []string{}
+	for _, part := range strings.Split(name, ".") {
+		getters = append(getters, "Get"+part+"()")
+	}
+	return "::." + strings.Join(getters, ".") + index
+}
+
+// ref returns the expression of the pointer to a container, the address of the field
+// for the structs stored by value (i.e. &::.Data)
+func (v *Value) ref() string {
+	if v.structValue {
+		return "&" + v.field()
+	}
+	return v.field()
+}
+
+// milli returns true if the time.Time value is a timestamp in milliseconds
+func (v *Value) milli() bool {
+	return v.unixTime == "unix-milli"
+}
+
+// allocUint256 returns the statement that allocates a nil *uint256.Int field before it is set
+func (v *Value) allocUint256() string {
+	if !strings.HasPrefix(v.uint256, "*") {
+		return ""
+	}
+	return fmt.Sprintf("if ::.%s == nil {\n::.%s = new(%s)\n}\n", v.name, v.name, v.uint256[1:])
+}
+
+// setBasicValue returns the statement to assign the expression to a basic type field
+func (v *Value) setBasicValue(expr string) string {
+	if v.wrapper != "" {
+		return fmt.Sprintf("::.%s = &%s{Value: %s}", v.name, v.wrapper, expr)
+	}
+	if v.ptr {
+		return fmt.Sprintf("::.%s = new([]byte)\n*::.%s = %s", v.name, v.name, expr)
+	}
+	if v.obj != "" {
+		return fmt.Sprintf("::.%s = %s(%s)", v.name, v.obj, expr)
+	}
+	return fmt.Sprintf("::.%s = %s", v.name, expr)
+}
+
+// arrayValue returns the fixed bytes of a byte array (i.e. [32]byte)
+func arrayValue(tags string, obj *ast.ArrayType) (*Value, error) {
+	if !isByte(obj.Elt) {
+		return nil, fmt.Errorf("only arrays of bytes are supported")
+	}
+	lit, ok := obj.Len.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return nil, fmt.Errorf("the length of a byte array must be an integer literal")
+	}
+	size, err := strconv.ParseUint(lit.Value, 0, 64)
+	if err != nil || size == 0 {
+		return nil, fmt.Errorf("invalid length '%s' of a byte array", lit.Value)
+	}
+	if tagSize, ok := getTagsInt(tags, "ssz-size"); ok && tagSize != size {
+		return nil, fmt.Errorf("[%d]byte expects a ssz-size of %d but found %d", size, size, tagSize)
+	}
+	return &Value{t: TypeBytes, s: size, n: size, array: fmt.Sprintf("[%d]byte", size)}, nil
+}
+
+// bitlistValue returns the IR of a bitlist, whose 'ssz-max' tag is the limit of bits
+func bitlistValue(tags string) (*Value, error) {
+	max, ok := getTagsInt(tags, "ssz-max")
+	if !ok || max == 0 {
+		return nil, fmt.Errorf("bitlist expects a ssz-max tag")
+	}
+	return &Value{t: TypeBitList, m: max}, nil
+}
+
+// bitvectorLen returns the number of bits of a go-bitfield bitvector type (i.e. Bitvector4)
+func bitvectorLen(sel string) (uint64, bool) {
+	if !strings.HasPrefix(sel, "Bitvector") {
+		return 0, false
+	}
+	num, err := strconv.Atoi(strings.TrimPrefix(sel, "Bitvector"))
+	if err != nil || num <= 0 {
+		return 0, false
+	}
+	return uint64(num), true
 }
 
 func isArray(obj ast.Expr) bool {
@@ -603,6 +1477,52 @@ func getTagsTuple(str string, field string) (uint64, uint64, bool) {
 	return first, uint64(second), true
 }
 
+// getTagsDim returns the first dimension of the tags of the format 'ssz-max:"16,32"'.
+// A '?' means that the dimension is not set.
+func getTagsDim(str string, field string) (uint64, bool) {
+	tag, ok := getTags(str, field)
+	if !ok {
+		return 0, false
+	}
+	num, err := strconv.Atoi(strings.Split(tag, ",")[0])
+	if err != nil {
+		return 0, false
+	}
+	return uint64(num), true
+}
+
+// lenValue returns the length to compare with a limit. The limits that do not fit in the
+// int of the 32 bits platforms (i.e. wasm with TinyGo) are compared as uint64.
+func lenValue(expr string, limit uint64) string {
+	if limit > math.MaxInt32 {
+		return fmt.Sprintf("uint64(%s)", expr)
+	}
+	return expr
+}
+
+// innerTags returns the tags of the element of a slice, the ssz-size and ssz-max tags
+// without their first dimension (i.e. 'ssz-max:"16,32"' becomes 'ssz-max:"32"').
+func innerTags(str string) string {
+	res := []string{}
+	for _, tag := range strings.Split(strings.Trim(str, "`"), " ") {
+		if tag == `ssz:"progressive"` {
+			// only the outer list is progressive
+			continue
+		}
+		spl := strings.SplitN(tag, ":", 2)
+		if len(spl) == 2 && (spl[0] == "ssz-size" || spl[0] == "ssz-max") {
+			dims := strings.Split(strings.Trim(spl[1], "\""), ",")
+			if len(dims) == 1 || strings.Trim(strings.Join(dims[1:], ""), "?") == "" {
+				// the inner dimensions have no value (i.e. 'ssz-max:"16,?"')
+				continue
+			}
+			tag = fmt.Sprintf("%s:\"%s\"", spl[0], strings.Join(dims[1:], ","))
+		}
+		res = append(res, tag)
+	}
+	return "`" + strings.Join(res, " ") + "`"
+}
+
 // getTagsInt returns tags of the format 'ssz-size:"32"'
 func getTagsInt(str string, field string) (uint64, bool) {
 	numStr, ok := getTags(str, field)
@@ -695,6 +1615,8 @@ func uintVToName(v *Value) string {
 		panic("not expected")
 	}
 	switch v.n {
+	case 32:
+		return "Uint256"
 	case 8:
 		return "Uint64"
 	case 4:
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writePackage writes the source to a package in the module, so that the generated code
+// builds with the ssz package, and returns the directory and the path of the source
+func writePackage(t *testing.T, source string) (string, string) {
+	dir, err := ioutil.TempDir(".", "_sszgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "types.go")
+	if err := ioutil.WriteFile(path, []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir, path
+}
+
+func TestUnsupportedTypes(t *testing.T) {
+	cases := []struct {
+		field string
+		err   string
+	}{
+		{"A int", "type int not found"},
+		{"A map[string]uint64", "type map[string]uint64 not supported"},
+		{"A *Unknown", "struct Unknown not found"},
+		{"A *uint64", "struct uint64 not found"},
+		{"A chan uint64", "type chan uint64 not supported"},
+		{"A [4]uint64", "only arrays of bytes are supported"},
+	}
+	for _, c := range cases {
+		dir, path := writePackage(t, "package types\n\ntype Obj struct {\n"+c.field+"\n}\n")
+		_, err := newEnv(path, nil, defaultOptions())
+		os.RemoveAll(dir)
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Fatalf("%s: expected '%s' but found %v", c.field, c.err, err)
+		}
+	}
+}
+
+func TestStdin(t *testing.T) {
+	source := "package types\n\ntype Obj struct {\nA uint64\nB []byte `ssz-max:\"32\"`\n}\n"
+	dir, path := writePackage(t, source)
+	defer os.RemoveAll(dir)
+
+	stdin, stdout := os.Stdin, os.Stdout
+	defer func() {
+		os.Stdin, os.Stdout = stdin, stdout
+	}()
+
+	readStdout := func(output string) (string, error) {
+		in, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer in.Close()
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		os.Stdin, os.Stdout = in, w
+
+		res := make(chan []byte)
+		go func() {
+			data, _ := ioutil.ReadAll(r)
+			res <- data
+		}()
+		err = encode(stdinSource, nil, output, defaultOptions())
+		w.Close()
+		data := <-res
+		os.Stdin, os.Stdout = stdin, stdout
+		return string(data), err
+	}
+
+	// the code is written to the standard output
+	code, err := readStdout("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(code, generatedHeader) || !strings.Contains(code, "func (o *Obj) MarshalSSZ()") {
+		t.Fatalf("bad output:\n%s", code)
+	}
+
+	// the standard input does not have files to mirror in a directory
+	if _, err := readStdout(dir + string(filepath.Separator)); err == nil || !strings.Contains(err.Error(), "not a directory") {
+		t.Fatalf("expected the output directory error but found %v", err)
+	}
+}
+
+func TestOutputDir(t *testing.T) {
+	dir, _ := writePackage(t, "package types\n\ntype A struct {\nA uint64\n}\n")
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "other.go"), []byte("package types\n\ntype B struct {\nB uint64\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// the directory is created with the trailing separator
+	output := filepath.Join(dir, "out") + string(filepath.Separator)
+	if err := encode(dir, nil, output, defaultOptions()); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"types_encoding.go", "other_encoding.go"} {
+		if _, err := os.Stat(filepath.Join(output, name)); err != nil {
+			t.Fatalf("output %s not found: %v", name, err)
+		}
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Fatalf("output %s written in the source directory", name)
+		}
+	}
+}
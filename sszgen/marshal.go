@@ -9,14 +9,14 @@ import (
 // 1. MarshalTo(dst []byte) marshals the content to the target array.
 // 2. Marshal() marshals the content to a newly created array.
 func (e *env) marshal(name string, v *Value) string {
-	tmpl := `// MarshalSSZ ssz marshals the {{.name}} object
-	func (:: *{{.name}}) MarshalSSZ() ([]byte, error) {
-		buf := make([]byte, ::.SizeSSZ())
-		return ::.MarshalSSZTo(buf[:0])
+	tmpl := `// MarshalSSZ{{.fork}} ssz marshals the {{.name}} object
+	func (:: {{.receiver}}) MarshalSSZ{{.fork}}() ([]byte, error) {
+		buf := make([]byte, ::.SizeSSZ{{.fork}}())
+		return ::.MarshalSSZTo{{.fork}}(buf[:0])
 	}
 
-	// MarshalSSZTo ssz marshals the {{.name}} object to a target array	
-	func (:: *{{.name}}) MarshalSSZTo(dst []byte) ([]byte, error) {
+	// MarshalSSZTo{{.fork}} ssz marshals the {{.name}} object to a target array	
+	func (:: {{.receiver}}) MarshalSSZTo{{.fork}}(dst []byte) ([]byte, error) {
 		var err error
 		{{.offset}}
 		{{.marshal}}
@@ -24,39 +24,98 @@ func (e *env) marshal(name string, v *Value) string {
 	}`
 
 	data := map[string]interface{}{
-		"name":    name,
-		"marshal": v.marshalContainer(true),
-		"offset":  "",
+		"name":     name,
+		"fork":     v.fork,
+		"receiver": v.receiver(),
+		"marshal":  v.marshalContainer(true),
+		"offset":   "",
 	}
 	if !v.isFixed() {
 		// offset is the position where the offset starts
 		data["offset"] = fmt.Sprintf("offset := int(%d)\n", v.n)
 	}
-	str := execTmpl(tmpl, data)
+	helpers := ""
+	if e.fieldHelpers(v) {
+		data["marshal"] = v.marshalFields(func(i *Value) string {
+			return fmt.Sprintf("if dst, err = ::.%s(dst); err != nil {\n return nil, err\n}", helperName("marshalSSZ"+v.fork, i))
+		})
+		helpers = v.marshalHelpers(name)
+	}
+	str := execTmpl(tmpl, data) + helpers
+	if e.opts.buffers && v.fork == "" {
+		str += "\n\n" + e.marshalBuffers(name, v)
+	}
 	return appendObjSignature(str, v)
 }
 
+// marshalBuffers creates a function that encodes the struct to an ssz.Buffers without copying the large byte fields.
+func (e *env) marshalBuffers(name string, v *Value) string {
+	tmpl := `// MarshalSSZToBuffers ssz marshals the {{.name}} object to the buffers, dst is the encoding since the last referenced field
+	func (:: {{.receiver}}) MarshalSSZToBuffers(bufs *ssz.Buffers, dst []byte) ([]byte, error) {
+		var err error
+		{{.offset}}
+		{{.marshal}}
+		return dst, err
+	}`
+
+	vv := v.copy()
+	vv.each(func(v *Value) {
+		v.buffers = true
+	})
+	offset := ""
+	if !v.isFixed() {
+		offset = fmt.Sprintf("offset := int(%d)\n", v.n)
+	}
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":     name,
+		"receiver": v.receiver(),
+		"offset":   offset,
+		"marshal":  vv.marshalContainer(true),
+	})
+}
+
 func (v *Value) marshal() string {
 	switch v.t {
 	case TypeContainer:
 		return v.marshalContainer(false)
 
+	case TypeBitVector:
+		return fmt.Sprintf("if dst, err = ssz.MarshalFixedBytes(dst, %s, %d); err != nil {\n return nil, errMarshalFixedBytes\n}", v.field(), v.s)
+
 	case TypeBytes:
+		if v.buffers {
+			// the large values are referenced by the buffers
+			check := fmt.Sprintf("if len(%s) != %d {\n return nil, errMarshalFixedBytes\n}\n", v.basicValue(), v.s)
+			if !v.isFixed() {
+				check = ""
+				if !v.progressive {
+					check = fmt.Sprintf("if %s > %d {\n return nil, errMarshalDynamicBytes\n}\n", lenValue("len("+v.basicValue()+")", v.m), v.m)
+				}
+			}
+			return check + fmt.Sprintf("dst = bufs.Append(dst, %s)", v.basicValue())
+		}
 		if v.isFixed() {
 			// fixed. It ensures that the size is correct
-			return fmt.Sprintf("if dst, err = ssz.MarshalFixedBytes(dst, ::.%s, %d); err != nil {\n return nil, errMarshalFixedBytes\n}", v.name, v.s)
+			return fmt.Sprintf("if dst, err = ssz.MarshalFixedBytes(dst, %s, %d); err != nil {\n return nil, errMarshalFixedBytes\n}", v.basicValue(), v.s)
 		}
 		// dynamic
-		return fmt.Sprintf("if len(::.%s) > %d {\n return nil, errMarshalDynamicBytes\n}\ndst = append(dst, ::.%s...)", v.name, v.m, v.name)
+		str := fmt.Sprintf("dst = append(dst, %s...)", v.basicValue())
+		if !v.progressive {
+			str = fmt.Sprintf("if %s > %d {\n return nil, errMarshalDynamicBytes\n}\n", lenValue("len("+v.basicValue()+")", v.m), v.m) + str
+		}
+		return str
 
 	case TypeUint:
-		return fmt.Sprintf("dst = ssz.Marshal%s(dst, ::.%s)", uintVToName(v), v.name)
+		if v.unixTime != "" {
+			return fmt.Sprintf("if dst, err = ssz.MarshalTime(dst, %s, %t); err != nil {\n return nil, err\n}", v.field(), v.milli())
+		}
+		return fmt.Sprintf("dst = ssz.Marshal%s(dst, %s)", uintVToName(v), v.basicValue())
 
 	case TypeBitList:
-		return fmt.Sprintf("dst = append(dst, ::.%s...)", v.name)
+		return fmt.Sprintf("if err = ssz.ValidateBitlist(%s, %d); err != nil {\n return nil, err\n}\ndst = append(dst, %s...)", v.field(), v.m, v.field())
 
 	case TypeBool:
-		return fmt.Sprintf("dst = ssz.MarshalBool(dst, ::.%s)", v.name)
+		return fmt.Sprintf("dst = ssz.MarshalBool(dst, %s)", v.basicValue())
 
 	case TypeVector:
 		if v.e.isFixed() {
@@ -73,17 +132,24 @@ func (v *Value) marshal() string {
 }
 
 func (v *Value) marshalList() string {
-	v.e.name = v.name + "[ii]"
-
-	// bound check
-	str := fmt.Sprintf("if len(::.%s) > %d {\n return nil, errMarshalList\n}\n", v.name, v.s)
+	v.e.name = v.name + "[" + v.index() + "]"
+
+	// bound check, the vectors of dynamic elements are encoded as lists too
+	var str string
+	if v.t == TypeVector {
+		str = fmt.Sprintf("if len(%s) != %d {\n return nil, errMarshalVector\n}\n", v.field(), v.s)
+	} else if !v.progressive {
+		str = fmt.Sprintf("if %s > %d {\n return nil, errMarshalList\n}\n", lenValue("len("+v.field()+")", v.s), v.s)
+	}
 
 	if v.e.isFixed() {
-		tmpl := `for ii := 0; ii < len(::.{{.name}}); ii++ {
+		tmpl := `for {{.ii}} := 0; {{.ii}} < len({{.field}}); {{.ii}}++ {
 			{{.dynamic}}
 		}`
 		str += execTmpl(tmpl, map[string]interface{}{
+			"ii":      v.index(),
 			"name":    v.name,
+			"field":   v.field(),
 			"dynamic": v.e.marshal(),
 		})
 		return str
@@ -94,18 +160,22 @@ func (v *Value) marshalList() string {
 	// 2. marshal each element
 
 	tmpl := `{
-		offset = 4 * len(::.{{.name}})
-		for ii := 0; ii < len(::.{{.name}}); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
+		offset = 4 * len({{.field}})
+		for {{.ii}} := 0; {{.ii}} < len({{.field}}); {{.ii}}++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
 			{{.size}}
 		}
 	}
-	for ii := 0; ii < len(::.{{.name}}); ii++ {
+	for {{.ii}} := 0; {{.ii}} < len({{.field}}); {{.ii}}++ {
 		{{.marshal}}
 	}`
 
 	str += execTmpl(tmpl, map[string]interface{}{
+		"ii":      v.index(),
 		"name":    v.name,
+		"field":   v.field(),
 		"size":    v.e.size("offset"),
 		"marshal": v.e.marshal(),
 	})
@@ -113,16 +183,18 @@ func (v *Value) marshalList() string {
 }
 
 func (v *Value) marshalVector() (str string) {
-	v.e.name = fmt.Sprintf("%s[ii]", v.name)
+	v.e.name = fmt.Sprintf("%s[%s]", v.name, v.index())
 
-	tmpl := `if len(::.{{.name}}) != {{.size}} {
+	tmpl := `if len({{.field}}) != {{.size}} {
 		return nil, errMarshalVector
 	}
-	for ii := 0; ii < {{.size}}; ii++ {
+	for {{.ii}} := 0; {{.ii}} < {{.size}}; {{.ii}}++ {
 		{{.marshal}}
 	}`
 	return execTmpl(tmpl, map[string]interface{}{
+		"ii":      v.index(),
 		"name":    v.name,
+		"field":   v.field(),
 		"size":    v.s,
 		"marshal": v.e.marshal(),
 	})
@@ -130,9 +202,21 @@ func (v *Value) marshalVector() (str string) {
 
 func (v *Value) marshalContainer(start bool) string {
 	if !start {
-		return fmt.Sprintf("if dst, err = ::.%s.MarshalSSZTo(dst); err != nil {\n return nil, err\n}", v.name)
+		str := fmt.Sprintf("if dst, err = %s.MarshalSSZTo%s(dst); err != nil {\n return nil, err\n}", v.field(), v.fork)
+		if v.buffers {
+			str = fmt.Sprintf("if dst, err = bufs.MarshalTo(%s, dst); err != nil {\n return nil, err\n}", v.ref())
+		}
+		if v.structValue {
+			return str
+		}
+		// a nil pointer is encoded as the zero value
+		return fmt.Sprintf("if %s == nil {\nif dst, err = new(%s).MarshalSSZTo%s(dst); err != nil {\n return nil, err\n}\n} else %s", v.field(), v.obj, v.fork, str)
 	}
+	return v.marshalFields((*Value).marshal)
+}
 
+// marshalFields encodes the fixed part and then the dynamic parts of a container, each field with marshal
+func (v *Value) marshalFields(marshal func(*Value) string) string {
 	offset := v.n
 	out := []string{}
 
@@ -140,10 +224,10 @@ func (v *Value) marshalContainer(start bool) string {
 		var str string
 		if i.isFixed() {
 			// write the content
-			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal())
+			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, marshal(i))
 		} else {
 			// write the offset
-			str = fmt.Sprintf("// Offset (%d) '%s'\ndst = ssz.WriteOffset(dst, offset)\n%s\n", indx, i.name, i.size("offset"))
+			str = fmt.Sprintf("// Offset (%d) '%s'\nif dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {\n return nil, err\n}\n%s\n", indx, i.name, i.size("offset"))
 			offset += i.n
 		}
 		out = append(out, str)
@@ -152,7 +236,7 @@ func (v *Value) marshalContainer(start bool) string {
 	// write the dynamic parts
 	for indx, i := range v.o {
 		if !i.isFixed() {
-			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal()))
+			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, marshal(i)))
 		}
 	}
 	return strings.Join(out, "\n")
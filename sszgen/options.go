@@ -0,0 +1,105 @@
+package main
+
+import "flag"
+
+// options are the settings that change how sszgen reads the Go types and
+// which functions it generates
+type options struct {
+	// prysm enables the set of conventions used by the Prysm protobuf types
+	prysm bool
+	// skipEmbedded skips the embedded fields instead of failing
+	skipEmbedded bool
+	// text generates the hex text marshal functions for the named byte types
+	text bool
+	// verify generates the UnmarshalSSZVerify functions that reject non canonical encodings
+	verify bool
+	// buffers generates the MarshalSSZToBuffers functions that reference the large byte fields
+	buffers bool
+	// objectPool generates the UnmarshalSSZWithPool functions that take the nested objects from an ssz.ObjectPool
+	objectPool bool
+	// noCopy generates the UnmarshalSSZNoCopy functions whose byte fields alias the input and the CopySSZ functions
+	noCopy bool
+	// schema generates the SchemaSSZ functions that return the runtime schema of the structs
+	schema bool
+	// examples generates the example_test.go files with an example of the functions of each
+	// struct, which starts from a random object and enables the random option
+	examples bool
+	// random generates the RandomXxx functions that return objects with random values
+	random bool
+	// discover reads the targets from the go:generate directives and the
+	// '//sszgen:generate' comments if no targets are set
+	discover bool
+	// valueReceiver generates the marshal and size functions with value receivers
+	valueReceiver bool
+	// hasherPool uses the Hashers of the default pool in the HashTreeRoot functions
+	hasherPool bool
+	// useGetters reads the fields with the protobuf getters (i.e. GetRoot()) in the
+	// marshal, size and hash functions. Unmarshal assigns the fields directly.
+	useGetters bool
+	// fieldHelpers generates a helper function for each field of the containers with
+	// at least fieldHelpers fields, which are called by the marshal, unmarshal and hash functions
+	fieldHelpers int
+	// splitSize splits the output files in parts of about splitSize bytes
+	splitSize int
+	// forks are the names of the forks of the 'ssz-fork' tags in order, separated by commas
+	forks string
+	// strict fails on the tags that conflict or do not match the Go type of the field
+	// (i.e. both ssz-size and ssz-max for a dimension) instead of ignoring them
+	strict bool
+	// bitfields are the types of other packages encoded as bitlists or bitvectors besides the
+	// go-bitfield ones, separated by commas (i.e. 'github.com/me/bitfield.Bits=bitlist')
+	bitfields string
+	// defaultBytesMax is the limit of the []byte fields without ssz-size and ssz-max tags,
+	// which fail to generate if it is zero
+	defaultBytesMax int
+	// report is the path of the JSON file that describes the generated structs and the skipped ones
+	report string
+	// header is the path of a file whose content is inserted at the top of the generated files
+	header string
+}
+
+func defaultOptions() *options {
+	return &options{
+		hasherPool:   true,
+		fieldHelpers: 30,
+		forks:        defaultForks,
+	}
+}
+
+// register adds the flags for the options to the flag set
+func (o *options) register(flagSet *flag.FlagSet) {
+	flagSet.BoolVar(&o.prysm, "prysm", false, "")
+	flagSet.BoolVar(&o.text, "text", false, "")
+	flagSet.BoolVar(&o.verify, "verify", false, "")
+	flagSet.BoolVar(&o.buffers, "buffers", false, "")
+	flagSet.BoolVar(&o.objectPool, "object-pool", false, "")
+	flagSet.BoolVar(&o.noCopy, "no-copy", false, "")
+	flagSet.BoolVar(&o.schema, "schema", false, "")
+	flagSet.BoolVar(&o.random, "random", false, "")
+	flagSet.BoolVar(&o.examples, "examples", false, "")
+	flagSet.BoolVar(&o.discover, "discover", false, "")
+	flagSet.BoolVar(&o.valueReceiver, "value-receiver", false, "")
+	flagSet.BoolVar(&o.hasherPool, "hasher-pool", true, "")
+	flagSet.BoolVar(&o.useGetters, "use-getters", false, "")
+	flagSet.IntVar(&o.fieldHelpers, "field-helpers", 30, "")
+	flagSet.IntVar(&o.splitSize, "split-size", 0, "")
+	flagSet.StringVar(&o.forks, "forks", defaultForks, "")
+	flagSet.StringVar(&o.header, "header", "", "")
+	flagSet.BoolVar(&o.strict, "strict", false, "")
+	flagSet.IntVar(&o.defaultBytesMax, "default-bytes-max", 0, "")
+	flagSet.StringVar(&o.bitfields, "bitfield-types", "", "")
+	flagSet.StringVar(&o.report, "report", "", "")
+}
+
+// setup applies the presets enabled by the flags. The Prysm types are generated
+// with gogoproto, their named types (customtype, casttype) are always resolved.
+func (o *options) setup() {
+	if o.prysm {
+		o.skipEmbedded = true
+		o.useGetters = true
+	}
+	if o.examples {
+		// the zero values of the fixed size fields do not encode
+		o.random = true
+	}
+}
@@ -0,0 +1,360 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// protoCmd reads the messages of a .proto file and emits the equivalent Go structs
+// annotated with the ssz tags. Then, it generates the encodings for those structs.
+//
+// The ssz tags are derived from the custom field options 'ssz_size' and 'ssz_max' (in any
+// proto package, i.e. '(ethereum.eth.ext.ssz_size) = "32"') and the 'ssz_bitlist' boolean option.
+// The raw Go tags set with the gogoproto 'moretags' option are also included.
+func protoCmd(args []string) error {
+	var source string
+	var output string
+	var packName string
+
+	flagSet := flag.NewFlagSet("proto", flag.ExitOnError)
+	flagSet.StringVar(&source, "path", "", "")
+	flagSet.StringVar(&output, "output", "", "")
+	flagSet.StringVar(&packName, "package", "", "")
+
+	opts := defaultOptions()
+	opts.register(flagSet)
+
+	flagSet.Parse(args)
+	opts.setup()
+
+	data, err := ioutil.ReadFile(source)
+	if err != nil {
+		return err
+	}
+	file, err := parseProto(string(data))
+	if err != nil {
+		return fmt.Errorf("%s: %v", source, err)
+	}
+	if packName != "" {
+		file.goPackage = packName
+	}
+	if output == "" {
+		output = strings.TrimSuffix(source, filepath.Ext(source)) + ".go"
+	}
+
+	res, err := file.print(filepath.Base(source))
+	if err != nil {
+		return err
+	}
+	header, err := readHeader(opts.header)
+	if err != nil {
+		return err
+	}
+	res = append(header, res...)
+	if err := ioutil.WriteFile(output, res, 0644); err != nil {
+		return err
+	}
+	return encode(output, nil, "", opts)
+}
+
+type protoFile struct {
+	goPackage string
+	messages  []*protoMessage
+}
+
+type protoMessage struct {
+	name   string
+	fields []*protoField
+}
+
+type protoField struct {
+	name     string
+	typ      string
+	repeated bool
+	options  map[string]string
+}
+
+var protoBasicTypes = map[string]string{
+	"uint64":  "uint64",
+	"fixed64": "uint64",
+	"uint32":  "uint32",
+	"fixed32": "uint32",
+	"bool":    "bool",
+	"bytes":   "[]byte",
+}
+
+func (p *protoFile) print(source string) ([]byte, error) {
+	if p.goPackage == "" {
+		return nil, fmt.Errorf("package not found, use the 'package' flag")
+	}
+
+	msgs := map[string]bool{}
+	for _, msg := range p.messages {
+		msgs[msg.name] = true
+	}
+
+	out := fmt.Sprintf("// Code generated by fastssz from %s. DO NOT EDIT.\npackage %s\n", source, p.goPackage)
+	for _, msg := range p.messages {
+		out += fmt.Sprintf("\ntype %s struct {\n", msg.name)
+		for _, f := range msg.fields {
+			typ, ok := protoBasicTypes[f.typ]
+			if !ok {
+				if !msgs[f.typ] {
+					return nil, fmt.Errorf("%s.%s: type '%s' not supported", msg.name, f.name, f.typ)
+				}
+				typ = "*" + f.typ
+			}
+			if f.repeated {
+				typ = "[]" + typ
+			}
+			out += fmt.Sprintf("%s %s `%s`\n", protoGoName(f.name), typ, f.tags())
+		}
+		out += "}\n"
+	}
+	return format.Source([]byte(out))
+}
+
+func (f *protoField) tags() string {
+	tags := []string{fmt.Sprintf("json:\"%s\"", f.name)}
+	if val, ok := f.option("ssz_bitlist"); ok && val == "true" {
+		tags = append(tags, "ssz:\"bitlist\"")
+	}
+	if val, ok := f.option("ssz_size"); ok {
+		tags = append(tags, fmt.Sprintf("ssz-size:\"%s\"", val))
+	}
+	if val, ok := f.option("ssz_max"); ok {
+		tags = append(tags, fmt.Sprintf("ssz-max:\"%s\"", val))
+	}
+	if val, ok := f.option("moretags"); ok {
+		tags = append(tags, val)
+	}
+	return strings.Join(tags, " ")
+}
+
+// option returns the value of the option whose name (without the package) is name
+func (f *protoField) option(name string) (string, bool) {
+	for k, v := range f.options {
+		if k == name || strings.HasSuffix(k, "."+name) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// protoGoName converts a proto field name to the Go name used by protoc-gen-go
+func protoGoName(name string) string {
+	res := ""
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		res += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return res
+}
+
+// parseProto parses the subset of proto3 used to describe SSZ
+// objects: messages with scalar, bytes, message and repeated fields.
+func parseProto(str string) (*protoFile, error) {
+	p := &protoParser{tokens: tokenizeProto(str)}
+	file := &protoFile{}
+
+	for !p.done() {
+		switch tok := p.next(); tok {
+		case "syntax", "import":
+			p.skipStatement()
+
+		case "package":
+			pkg := p.next()
+			file.goPackage = pkg[strings.LastIndex(pkg, ".")+1:]
+			p.skipStatement()
+
+		case "option":
+			name := p.next()
+			p.expect("=")
+			val := unquoteProto(p.next())
+			if name == "go_package" {
+				// 'github.com/x/y;name' or 'github.com/x/y'
+				if indx := strings.Index(val, ";"); indx != -1 {
+					file.goPackage = val[indx+1:]
+				} else {
+					file.goPackage = filepath.Base(val)
+				}
+			}
+			p.skipStatement()
+
+		case "message":
+			msg, err := p.parseMessage()
+			if err != nil {
+				return nil, err
+			}
+			file.messages = append(file.messages, msg)
+
+		case ";":
+
+		default:
+			return nil, fmt.Errorf("unexpected '%s'", tok)
+		}
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return file, nil
+}
+
+type protoParser struct {
+	tokens []string
+	pos    int
+	err    error
+}
+
+func (p *protoParser) done() bool {
+	return p.err != nil || p.pos >= len(p.tokens)
+}
+
+func (p *protoParser) next() string {
+	if p.pos >= len(p.tokens) {
+		if p.err == nil {
+			p.err = fmt.Errorf("unexpected end of file")
+		}
+		return ""
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+	return tok
+}
+
+func (p *protoParser) expect(tok string) {
+	if found := p.next(); found != tok && p.err == nil {
+		p.err = fmt.Errorf("expected '%s' but found '%s'", tok, found)
+	}
+}
+
+func (p *protoParser) skipStatement() {
+	for !p.done() && p.next() != ";" {
+	}
+}
+
+func (p *protoParser) parseMessage() (*protoMessage, error) {
+	msg := &protoMessage{name: p.next()}
+	p.expect("{")
+
+	for !p.done() {
+		tok := p.next()
+		switch tok {
+		case "}":
+			return msg, nil
+
+		case ";":
+			continue
+
+		case "message", "enum", "oneof", "map", "reserved":
+			return nil, fmt.Errorf("%s: '%s' not supported", msg.name, tok)
+
+		case "option":
+			p.skipStatement()
+			continue
+		}
+
+		f := &protoField{options: map[string]string{}}
+		if tok == "repeated" {
+			f.repeated = true
+			tok = p.next()
+		}
+		f.typ = tok
+		f.name = p.next()
+		p.expect("=")
+		if _, err := strconv.Atoi(p.next()); err != nil && p.err == nil {
+			p.err = fmt.Errorf("%s.%s: field number expected", msg.name, f.name)
+		}
+		if p.pos < len(p.tokens) && p.tokens[p.pos] == "[" {
+			p.next()
+			for !p.done() {
+				name := strings.Trim(p.next(), "()")
+				p.expect("=")
+				f.options[name] = unquoteProto(p.next())
+				if sep := p.next(); sep == "]" {
+					break
+				} else if sep != "," && p.err == nil {
+					p.err = fmt.Errorf("%s.%s: bad options", msg.name, f.name)
+				}
+			}
+		}
+		p.expect(";")
+		msg.fields = append(msg.fields, f)
+	}
+	return nil, p.err
+}
+
+func unquoteProto(str string) string {
+	if res, err := strconv.Unquote(str); err == nil {
+		return res
+	}
+	return str
+}
+
+// tokenizeProto splits the input into identifiers, numbers, quoted
+// strings and punctuation. Comments are removed.
+func tokenizeProto(str string) []string {
+	tokens := []string{}
+	for i := 0; i < len(str); {
+		c := str[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case strings.HasPrefix(str[i:], "//"):
+			for i < len(str) && str[i] != '\n' {
+				i++
+			}
+
+		case strings.HasPrefix(str[i:], "/*"):
+			end := strings.Index(str[i+2:], "*/")
+			if end == -1 {
+				i = len(str)
+			} else {
+				i += end + 4
+			}
+
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(str) && str[j] != c {
+				if str[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j < len(str) {
+				j++
+			}
+			tokens = append(tokens, str[i:j])
+			i = j
+
+		case c == '(' || isProtoIdent(rune(c)):
+			j := i + 1
+			for j < len(str) && (isProtoIdent(rune(str[j])) || (c == '(' && str[j] == ')')) {
+				j++
+				if str[j-1] == ')' {
+					break
+				}
+			}
+			tokens = append(tokens, str[i:j])
+			i = j
+
+		default:
+			tokens = append(tokens, string(c))
+			i++
+		}
+	}
+	return tokens
+}
+
+func isProtoIdent(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.'
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// prysmSource has the layout of the types generated by the gogoproto plugin of Prysm
+const prysmSource = `package types
+
+import (
+	github_com_prysmaticlabs_go_bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+type Message interface{}
+
+type Checkpoint struct {
+	Epoch                uint64   ` + "`protobuf:\"varint,1,opt,name=epoch,proto3\" json:\"epoch,omitempty\"`" + `
+	Root                 []byte   ` + "`protobuf:\"bytes,2,opt,name=root,proto3\" json:\"root,omitempty\" ssz-size:\"32\"`" + `
+	XXX_NoUnkeyedLiteral struct{} ` + "`json:\"-\"`" + `
+	XXX_unrecognized     []byte   ` + "`json:\"-\"`" + `
+	XXX_sizecache        int32    ` + "`json:\"-\"`" + `
+}
+
+func (m *Checkpoint) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type Attestation struct {
+	Message
+	AggregationBits      github_com_prysmaticlabs_go_bitfield.Bitlist ` + "`protobuf:\"bytes,1,opt,name=aggregation_bits,proto3,casttype=github.com/prysmaticlabs/go-bitfield.Bitlist\" ssz-max:\"2048\"`" + `
+	Roots                [][]byte                                     ` + "`protobuf:\"bytes,2,rep,name=roots,proto3\" ssz-size:\"?,32\" ssz-max:\"16\"`" + `
+	Source               *Checkpoint                                  ` + "`protobuf:\"bytes,3,opt,name=source,proto3\"`" + `
+	XXX_NoUnkeyedLiteral struct{}                                     ` + "`json:\"-\"`" + `
+	XXX_unrecognized     []byte                                       ` + "`json:\"-\"`" + `
+	XXX_sizecache        int32                                        ` + "`json:\"-\"`" + `
+}
+
+func (m *Attestation) GetAggregationBits() github_com_prysmaticlabs_go_bitfield.Bitlist {
+	if m != nil {
+		return m.AggregationBits
+	}
+	return nil
+}
+
+func (m *Attestation) GetRoots() [][]byte {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
+func (m *Attestation) GetSource() *Checkpoint {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+`
+
+// prysmTest decodes the encoding of an attestation
+const prysmTest = `package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+func TestAttestation(t *testing.T) {
+	obj := &Attestation{
+		AggregationBits: bitfield.NewBitlist(10),
+		Roots:           [][]byte{make([]byte, 32)},
+		Source:          &Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj2 := new(Attestation)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	buf2, err := obj2.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, buf2) || obj2.Source.Epoch != 1 {
+		t.Fatal("bad decode")
+	}
+	if _, err := obj2.HashTreeRoot(); err != nil {
+		t.Fatal(err)
+	}
+}
+`
+
+func TestPrysmTypes(t *testing.T) {
+	dir, path := writePackage(t, prysmSource)
+	defer os.RemoveAll(dir)
+
+	// the embedded fields fail without the flag
+	if err := encode(path, nil, "", defaultOptions()); err == nil || !strings.Contains(err.Error(), "embedded field") {
+		t.Fatalf("expected the embedded field error but found %v", err)
+	}
+
+	opts := defaultOptions()
+	opts.prysm = true
+	opts.setup()
+	if err := encode(path, nil, "", opts); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "types_encoding.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := string(data)
+	if strings.Contains(code, "XXX_") || !strings.Contains(code, "a.GetSource()") {
+		t.Fatal("expected the getters without the protobuf fields")
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "types_test.go"), []byte(prysmTest), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("go", "test", "./"+dir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("the generated code does not work: %v\n%s", err, out)
+	}
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// pythonCmd renders the IR of the targets as remerkleable class definitions
+// so that the same schemas can be used from Python for differential testing.
+func pythonCmd(args []string) error {
+	var source string
+	var objsStr string
+	var output string
+
+	flagSet := flag.NewFlagSet("python", flag.ExitOnError)
+	flagSet.StringVar(&source, "path", "", "")
+	flagSet.StringVar(&objsStr, "objs", "", "")
+	flagSet.StringVar(&output, "output", "", "")
+
+	opts := defaultOptions()
+	opts.register(flagSet)
+
+	flagSet.Parse(args)
+	opts.setup()
+
+	e, err := newEnv(source, splitTargets(objsStr), opts)
+	if err != nil {
+		return err
+	}
+	res, err := e.python()
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		fmt.Print(res)
+		return nil
+	}
+	return ioutil.WriteFile(output, []byte(res), 0644)
+}
+
+const pythonHeader = `# Code generated by fastssz. DO NOT EDIT.
+from remerkleable.basic import boolean, uint8, uint16, uint32, uint64, uint256
+from remerkleable.bitfields import Bitlist, Bitvector
+from remerkleable.byte_arrays import ByteList, ByteVector
+from remerkleable.complex import Container, List, Vector
+`
+
+// python prints the python classes. Python requires a class to be defined before
+// it is referenced, so the containers are printed after all their dependencies.
+func (e *env) python() (string, error) {
+	out := []string{pythonHeader}
+
+	done := map[string]bool{}
+	var printObj func(name string) error
+	printObj = func(name string) error {
+		if done[name] {
+			return nil
+		}
+		done[name] = true
+
+		v := e.objs[name]
+		for _, f := range v.o {
+			for _, dep := range f.containerDeps() {
+				if err := printObj(dep); err != nil {
+					return err
+				}
+			}
+		}
+		str, err := v.pythonClass(false)
+		if err != nil {
+			return err
+		}
+		out = append(out, str)
+		return nil
+	}
+
+	for _, name := range e.orderedObjs() {
+		if err := printObj(name); err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(out, "\n\n"), nil
+}
+
+// orderedObjs returns the name of the objects in the IR in the order
+// in which they appear on the files.
+func (e *env) orderedObjs() []string {
+	files := []string{}
+	for name := range e.order {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	res := []string{}
+	for _, file := range files {
+		for _, name := range e.order[file] {
+			if _, ok := e.objs[name]; ok {
+				res = append(res, name)
+			}
+		}
+	}
+	return res
+}
+
+// containerDeps returns the names of the containers the value refers to
+func (v *Value) containerDeps() []string {
+	switch v.t {
+	case TypeContainer:
+		return []string{v.obj}
+	case TypeVector, TypeList:
+		return v.e.containerDeps()
+	default:
+		return nil
+	}
+}
+
+// pythonClass prints the container as a python class. If spec is set, the
+// types use the aliases defined in the consensus specs.
+func (v *Value) pythonClass(spec bool) (string, error) {
+	str := fmt.Sprintf("class %s(Container):\n", v.obj)
+	if len(v.o) == 0 {
+		return str + "    pass\n", nil
+	}
+	for _, f := range v.o {
+		typ, err := f.pythonType(spec)
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %v", v.obj, f.name, err)
+		}
+		str += fmt.Sprintf("    %s: %s\n", f.specName(), typ)
+	}
+	return str, nil
+}
+
+// specBytes are the fixed byte vector sizes with an alias in the consensus specs
+var specBytes = map[uint64]bool{1: true, 4: true, 8: true, 20: true, 32: true, 48: true, 96: true}
+
+func (v *Value) pythonType(spec bool) (string, error) {
+	if v.progressive && !spec {
+		return "", fmt.Errorf("progressive lists are not supported in the python output")
+	}
+	switch v.t {
+	case TypeUint:
+		return fmt.Sprintf("uint%d", v.n*8), nil
+
+	case TypeBool:
+		return "boolean", nil
+
+	case TypeBytes:
+		if v.isFixed() {
+			if spec && specBytes[v.s] {
+				return fmt.Sprintf("Bytes%d", v.s), nil
+			}
+			return fmt.Sprintf("ByteVector[%d]", v.s), nil
+		}
+		if v.progressive {
+			return "ProgressiveList[uint8]", nil
+		}
+		return fmt.Sprintf("ByteList[%d]", v.m), nil
+
+	case TypeBitVector:
+		return fmt.Sprintf("Bitvector[%d]", v.m), nil
+
+	case TypeBitList:
+		if v.progressive {
+			return "ProgressiveBitlist", nil
+		}
+		return fmt.Sprintf("Bitlist[%d]", v.m), nil
+
+	case TypeVector, TypeList:
+		elem, err := v.e.pythonType(spec)
+		if err != nil {
+			return "", err
+		}
+		typ := "Vector"
+		if v.progressive {
+			return fmt.Sprintf("ProgressiveList[%s]", elem), nil
+		}
+		if v.t == TypeList {
+			typ = "List"
+		}
+		return fmt.Sprintf("%s[%s, %d]", typ, elem, v.s), nil
+
+	case TypeContainer:
+		return v.obj, nil
+
+	default:
+		return "", fmt.Errorf("python type not implemented for type %s", v.t.String())
+	}
+}
+
+// specName returns the name of the field as used in the consensus specs. It reads
+// the 'json' tag if it exists or converts the Go name to snake case otherwise.
+func (v *Value) specName() string {
+	if tag, ok := getTags(v.tags, "json"); ok {
+		if name := strings.Split(tag, ",")[0]; name != "" && name != "-" {
+			return name
+		}
+	}
+	// the fields of an inline struct use their own name
+	name := v.name
+	if indx := strings.LastIndex(name, "."); indx != -1 {
+		name = name[indx+1:]
+	}
+	return toSnakeCase(name)
+}
+
+func toSnakeCase(str string) string {
+	runes := []rune(str)
+
+	var res []rune
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i != 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				res = append(res, '_')
+			}
+		}
+		res = append(res, unicode.ToLower(r))
+	}
+	return string(res)
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// random creates a function that returns an object with random values that
+// honor the size and max constraints of the fields.
+func (e *env) random(name string, v *Value) string {
+	tmpl := `// Random{{.name}} returns a random {{.name}} object
+	func Random{{.name}}(rng *rand.Rand) *{{.name}} {
+		:: := new({{.name}})
+		{{.random}}
+		return ::
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":   name,
+		"random": v.randomContainer(true),
+	})
+	return appendObjSignature(str, v)
+}
+
+func (v *Value) random() string {
+	switch v.t {
+	case TypeContainer:
+		return v.randomContainer(false)
+
+	case TypeUint:
+		if v.uint256 != "" {
+			return v.allocUint256() + fmt.Sprintf("ssz.RandomUint256(rng, %s)", v.basicValue())
+		}
+		if v.unixTime != "" {
+			return fmt.Sprintf("::.%s = ssz.RandomTime(rng, %t)", v.name, v.milli())
+		}
+		var expr string
+		switch v.n {
+		case 8:
+			expr = "rng.Uint64()"
+		case 4:
+			expr = "rng.Uint32()"
+		default:
+			expr = fmt.Sprintf("%s(rng.Uint32())", strings.ToLower(uintVToName(v)))
+		}
+		return v.setBasicValue(expr)
+
+	case TypeBool:
+		return v.setBasicValue("rng.Intn(2) == 1")
+
+	case TypeBytes:
+		if v.array != "" {
+			return fmt.Sprintf("copy(::.%s[:], ssz.RandomBytes(rng, %d))", v.name, v.s)
+		}
+		if v.isFixed() {
+			return v.setBasicValue(fmt.Sprintf("ssz.RandomBytes(rng, %d)", v.s))
+		}
+		return v.setBasicValue(fmt.Sprintf("ssz.RandomBytes(rng, ssz.RandomLength(rng, %d))", v.m))
+
+	case TypeBitVector:
+		return fmt.Sprintf("::.%s = ssz.RandomBitvector(rng, %d)", v.name, v.m)
+
+	case TypeBitList:
+		return fmt.Sprintf("::.%s = ssz.RandomBitlist(rng, %d)", v.name, v.m)
+
+	case TypeVector, TypeList:
+		return v.randomList()
+
+	default:
+		panic(fmt.Errorf("random not implemented for type %s", v.t.String()))
+	}
+}
+
+func (v *Value) randomList() string {
+	v.e.name = v.name + "[" + v.index() + "]"
+
+	// createSlice uses the 'num' variable for the size of the list if v.s is 0
+	create := *v
+	if v.t == TypeList {
+		create.s = 0
+	}
+
+	tmpl := `{
+		{{if .list}}num := ssz.RandomLength(rng, {{.max}})
+		{{end}}{{.create}}
+		for {{.ii}} := 0; {{.ii}} < len(::.{{.name}}); {{.ii}}++ {
+			{{.random}}
+		}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"ii":     v.index(),
+		"name":   v.name,
+		"list":   v.t == TypeList,
+		"max":    v.s,
+		"create": create.createSlice(),
+		"random": v.e.random(),
+	})
+}
+
+func (v *Value) randomContainer(start bool) string {
+	if !start {
+		if v.structValue {
+			return fmt.Sprintf("::.%s = *Random%s(rng)", v.name, v.obj)
+		}
+		return fmt.Sprintf("::.%s = Random%s(rng)", v.name, v.obj)
+	}
+	out := []string{}
+	for indx, i := range v.o {
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.random()))
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+)
+
+// report is the JSON description of a generation written with the report option,
+// so that the build tools do not have to parse the generated code
+type report struct {
+	// Package is the name of the package of the structs
+	Package string `json:"package"`
+	// Files are the generated files
+	Files []string `json:"files"`
+	// Types are the generated structs in the order of their files
+	Types []reportType `json:"types"`
+	// Skipped are the structs and the fields that were not generated
+	Skipped []reportSkipped `json:"skipped"`
+}
+
+// reportType is the layout of a generated struct
+type reportType struct {
+	Name string `json:"name"`
+	// File is the source file of the struct
+	File string `json:"file"`
+	// Fixed is set if the encoding has a fixed size, which is then Size
+	Fixed bool `json:"fixed"`
+	// Size is the size of the fixed part of the encoding, with the offsets of the variable fields
+	Size uint64 `json:"size"`
+	// MinSize and MaxSize are the bounds of the size of the encoding
+	MinSize uint64        `json:"min_size"`
+	MaxSize uint64        `json:"max_size"`
+	Fields  []reportField `json:"fields"`
+}
+
+// reportField is the layout of a field, the same as the ssz.FieldInfo of the SSZFields functions
+type reportField struct {
+	// Name is the name of the Go field and SpecName the name in the specs
+	Name     string `json:"name"`
+	SpecName string `json:"spec_name"`
+	Type     string `json:"type"`
+	Size     uint64 `json:"size"`
+	Variable bool   `json:"variable"`
+	Limit    uint64 `json:"limit"`
+	Offset   uint64 `json:"offset"`
+	Gindex   uint64 `json:"gindex"`
+}
+
+// reportSkipped is a struct (i.e. 'Block') or a field (i.e. 'Block.XXX_unrecognized') that was skipped
+type reportSkipped struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// skip records a struct or a field that is not generated for the report
+func (e *env) skip(name, reason string) {
+	e.skipped = append(e.skipped, reportSkipped{Name: name, Reason: reason})
+}
+
+// writeReport writes the JSON report of the generated files
+func (e *env) writeReport(path string, out map[string]string) error {
+	r := &report{
+		Package: e.packName,
+		Files:   []string{},
+		Types:   []reportType{},
+		Skipped: e.skipped,
+	}
+	if r.Skipped == nil {
+		r.Skipped = []reportSkipped{}
+	}
+	for name := range out {
+		r.Files = append(r.Files, name)
+	}
+	sort.Strings(r.Files)
+
+	for _, file := range e.orderedFiles() {
+		for _, name := range e.order[file] {
+			v, ok := e.objs[name]
+			if !ok {
+				continue
+			}
+			s := v.runtimeSchema()
+			typ := reportType{
+				Name:    name,
+				File:    file,
+				Fixed:   s.IsFixed(),
+				Size:    fixedPart(s),
+				MinSize: s.MinSize(),
+				MaxSize: s.MaxSize(),
+				Fields:  []reportField{},
+			}
+			for indx, f := range s.FieldsInfo() {
+				typ.Fields = append(typ.Fields, reportField{
+					Name:     v.o[indx].name,
+					SpecName: f.Name,
+					Type:     f.Type,
+					Size:     f.Size,
+					Variable: f.Variable,
+					Limit:    f.Limit,
+					Offset:   f.Offset,
+					Gindex:   f.Gindex,
+				})
+			}
+			r.Types = append(r.Types, typ)
+		}
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReport(t *testing.T) {
+	source := "package types\n\n" +
+		"type Checkpoint struct {\nEpoch uint64\nRoot []byte `json:\"root\" ssz-size:\"32\"`\nsize uint64\n}\n\n" +
+		"type Obj struct {\nA *Checkpoint\nB []uint64 `ssz-max:\"16\"`\n}\n\n" +
+		"type Other struct {\nA uint64\n}\n"
+	dir, path := writePackage(t, source)
+	defer os.RemoveAll(dir)
+
+	opts := defaultOptions()
+	opts.report = filepath.Join(dir, "report.json")
+	if err := encode(path, []string{"Checkpoint", "Obj"}, "", opts); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(opts.report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &report{}
+	if err := json.Unmarshal(data, r); err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Package != "types" || !reflect.DeepEqual(r.Files, []string{filepath.Join(dir, "types_encoding.go")}) {
+		t.Fatalf("bad files %s %v", r.Package, r.Files)
+	}
+	skipped := []reportSkipped{
+		{Name: "Other", Reason: "not a target of the objs flag"},
+		{Name: "Checkpoint.size", Reason: "unexported field"},
+	}
+	if len(r.Skipped) != len(skipped) {
+		t.Fatalf("bad skipped %v", r.Skipped)
+	}
+	for _, s := range skipped {
+		found := false
+		for _, s2 := range r.Skipped {
+			found = found || s == s2
+		}
+		if !found {
+			t.Fatalf("skipped %v not found in %v", s, r.Skipped)
+		}
+	}
+
+	if len(r.Types) != 2 {
+		t.Fatalf("expected 2 types but found %d", len(r.Types))
+	}
+	checkpoint, obj := r.Types[0], r.Types[1]
+	if checkpoint.Name != "Checkpoint" || !checkpoint.Fixed || checkpoint.Size != 40 || checkpoint.MaxSize != 40 {
+		t.Fatalf("bad checkpoint %+v", checkpoint)
+	}
+	if f := checkpoint.Fields[1]; f.Name != "Root" || f.SpecName != "root" || f.Size != 32 || f.Offset != 8 {
+		t.Fatalf("bad root field %+v", f)
+	}
+	if obj.Name != "Obj" || obj.Fixed || obj.Size != 44 || obj.MinSize != 44 || obj.MaxSize != 44+16*8 {
+		t.Fatalf("bad obj %+v", obj)
+	}
+	if f := obj.Fields[1]; f.Name != "B" || !f.Variable || f.Limit != 16 || f.Offset != 40 {
+		t.Fatalf("bad list field %+v", f)
+	}
+}
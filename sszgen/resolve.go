@@ -0,0 +1,267 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// namedTypes returns the types of the package that are not structs (i.e. 'type Slot uint64').
+func namedTypes(files map[string]*ast.File) map[string]ast.Expr {
+	res := map[string]ast.Expr{}
+	for _, file := range files {
+		for _, dec := range file.Decls {
+			genDecl, ok := dec.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+					if _, ok := typeSpec.Type.(*ast.StructType); !ok {
+						res[typeSpec.Name.Name] = typeSpec.Type
+					}
+				}
+			}
+		}
+	}
+	return res
+}
+
+// resolveNamedType returns the IR of a named type using its underlying representation
+// (i.e. the gogoproto customtype 'github_com_prysmaticlabs_eth2_types.Slot' is an uint64).
+// 'obj' is the name of the type as used in the generated code.
+func (e *env) resolveNamedType(tags string, obj string, expr ast.Expr) (*Value, error) {
+	if _, ok := expr.(*ast.SelectorExpr); ok {
+		return nil, fmt.Errorf("type %s is defined as another named type", obj)
+	}
+	v, err := e.parseASTFieldType(tags, expr)
+	if err != nil {
+		return nil, err
+	}
+	if v.t == TypeUint || v.t == TypeBool {
+		// the generated code converts the basic types to and from the named type
+		v.obj = obj
+	}
+	if v.array != "" {
+		// the slices of the named array type (i.e. []common.Hash)
+		v.array = obj
+	}
+	return v, nil
+}
+
+// resolveSelector returns the definition of a type from another package
+func (e *env) resolveSelector(pkg, name string) (ast.Expr, error) {
+	path, err := e.findImport(pkg)
+	if err != nil {
+		return nil, err
+	}
+	types, err := e.loadPackageTypes(path)
+	if err != nil {
+		return nil, err
+	}
+	expr, ok := types[name]
+	if !ok {
+		return nil, fmt.Errorf("type %s not found in package %s", name, path)
+	}
+	if _, ok := expr.(*ast.StructType); ok {
+		return nil, fmt.Errorf("struct %s.%s from another package not supported", pkg, name)
+	}
+	return expr, nil
+}
+
+// findImport returns the import path of the package referenced as 'pkg' in the input files
+func (e *env) findImport(pkg string) (string, error) {
+	for _, file := range e.files {
+		for _, spec := range file.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return "", err
+			}
+			if spec.Name != nil {
+				if spec.Name.Name == pkg {
+					return path, nil
+				}
+				continue
+			}
+			if filepath.Base(path) == pkg {
+				return path, nil
+			}
+			// the package name might be different from the last element of the path
+			if _, err := e.loadPackageTypes(path); err == nil && e.pkgNames[path] == pkg {
+				return path, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("import for package %s not found", pkg)
+}
+
+// loadPackageTypes parses the package with the given import path and returns all its types
+func (e *env) loadPackageTypes(path string) (map[string]ast.Expr, error) {
+	if types, ok := e.pkgTypes[path]; ok {
+		return types, nil
+	}
+
+	dir, err := e.sourceDir()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("go", "list", "-f", "{{.Dir}}", path)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find package %s: %v", path, err)
+	}
+
+	filter := func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), strings.TrimSpace(string(out)), filter, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	types := map[string]ast.Expr{}
+	for name, pkg := range pkgs {
+		e.pkgNames[path] = name
+		for _, file := range pkg.Files {
+			for _, dec := range file.Decls {
+				if genDecl, ok := dec.(*ast.GenDecl); ok {
+					for _, spec := range genDecl.Specs {
+						if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+							types[typeSpec.Name.Name] = typeSpec.Type
+						}
+					}
+				}
+			}
+		}
+	}
+	e.pkgTypes[path] = types
+	return types, nil
+}
+
+// wrapperPackages are the packages with the protobuf well-known wrapper types
+var wrapperPackages = map[string]bool{
+	"google.golang.org/protobuf/types/known/wrapperspb": true,
+	"github.com/golang/protobuf/ptypes/wrappers":        true,
+	"github.com/gogo/protobuf/types":                    true,
+}
+
+// parseWrapperType returns the IR of a protobuf wrapper type field (i.e. *wrapperspb.UInt64Value).
+// The field is encoded as the wrapped basic type and a nil field is encoded as the zero value.
+func (e *env) parseWrapperType(tags string, sel *ast.SelectorExpr) (*Value, error) {
+	pkg := sel.X.(*ast.Ident).Name
+	name := pkg + "." + sel.Sel.Name
+
+	path, err := e.findImport(pkg)
+	if err != nil {
+		return nil, err
+	}
+	if !wrapperPackages[path] {
+		return nil, fmt.Errorf("pointer to %s not supported", name)
+	}
+
+	var v *Value
+	switch sel.Sel.Name {
+	case "UInt64Value":
+		v = &Value{t: TypeUint, n: 8}
+	case "UInt32Value":
+		v = &Value{t: TypeUint, n: 4}
+	case "BoolValue":
+		v = &Value{t: TypeBool, n: 1}
+	case "BytesValue":
+		if v, err = e.parseASTFieldType(tags, &ast.ArrayType{Elt: ast.NewIdent("byte")}); err != nil {
+			return nil, err
+		}
+		if v.t != TypeBytes {
+			return nil, fmt.Errorf("%s only supports fixed and dynamic bytes", name)
+		}
+	default:
+		return nil, fmt.Errorf("wrapper type %s not supported", name)
+	}
+	v.wrapper = name
+	return v, nil
+}
+
+// parseUint256Type returns the IR of a github.com/holiman/uint256.Int field, which is
+// encoded as a little endian uint256 and hashed as a single chunk.
+func (e *env) parseUint256Type(sel *ast.SelectorExpr, ptr bool) (*Value, bool) {
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || sel.Sel.Name != "Int" {
+		return nil, false
+	}
+	if path, err := e.findImport(pkg.Name); err != nil || path != "github.com/holiman/uint256" {
+		return nil, false
+	}
+	typ := pkg.Name + ".Int"
+	if ptr {
+		typ = "*" + typ
+	}
+	return &Value{t: TypeUint, n: 32, uint256: typ}, true
+}
+
+// parseTimeType returns the IR of a time.Time field, which is encoded as the uint64 unix
+// timestamp in seconds or milliseconds of its 'ssz:"unix"' or 'ssz:"unix-milli"' tag.
+func (e *env) parseTimeType(tags string, sel *ast.SelectorExpr) (*Value, bool, error) {
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || sel.Sel.Name != "Time" {
+		return nil, false, nil
+	}
+	if path, err := e.findImport(pkg.Name); err != nil || path != "time" {
+		return nil, false, nil
+	}
+	tag, _ := getTags(tags, "ssz")
+	if tag != "unix" && tag != "unix-milli" {
+		return nil, true, fmt.Errorf("time.Time expects either the ssz tag 'unix' or 'unix-milli'")
+	}
+	return &Value{t: TypeUint, n: 8, unixTime: tag}, true, nil
+}
+
+// usedImports returns the packages referenced by the named
+// types of the values so that the generated file can import them.
+func (e *env) usedImports(objs []*Value) []string {
+	found := map[string]bool{}
+	var walk func(v *Value)
+	walk = func(v *Value) {
+		if v.t != TypeContainer && strings.Contains(v.obj, ".") {
+			found[strings.Split(v.obj, ".")[0]] = true
+		}
+		if v.wrapper != "" {
+			found[strings.Split(v.wrapper, ".")[0]] = true
+		}
+		if v.uint256 != "" {
+			found[strings.Split(strings.TrimPrefix(v.uint256, "*"), ".")[0]] = true
+		}
+		if strings.Contains(v.array, ".") {
+			found[strings.Split(v.array, ".")[0]] = true
+		}
+		for _, o := range v.o {
+			walk(o)
+		}
+		if v.e != nil {
+			walk(v.e)
+		}
+	}
+	for _, v := range objs {
+		walk(v)
+	}
+
+	res := []string{}
+	for pkg := range found {
+		path, err := e.findImport(pkg)
+		if err != nil {
+			continue
+		}
+		res = append(res, fmt.Sprintf("%s \"%s\"", pkg, path))
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// schema creates a function that returns the runtime schema of the struct (ssz.Schema)
+// which is used to work with the struct as a view of a backing tree.
+func (e *env) schema(name string, v *Value) string {
+	tmpl := `// SchemaSSZ returns the ssz schema of the {{.name}} object
+	func (:: {{.receiver}}) SchemaSSZ() *ssz.Schema {
+		return ssz.ContainerSchema("{{.name}}",{{.fields}}
+		)
+	}`
+
+	fields := []string{}
+	for _, f := range v.o {
+		fields = append(fields, fmt.Sprintf("\nssz.NewField(\"%s\", %s),", f.specName(), f.schema()))
+	}
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":     name,
+		"receiver": v.receiver(),
+		"fields":   strings.Join(fields, ""),
+	})
+	return appendObjSignature(str, v) + "\n\n" + e.fieldsInfo(name, v)
+}
+
+// fieldsInfo creates a function that returns the layout of the fields of the struct
+// (ssz.FieldInfo), which is computed with the runtime schema when the code is generated.
+func (e *env) fieldsInfo(name string, v *Value) string {
+	tmpl := `// SSZFields returns the layout of the fields of the {{.name}} object
+	func (:: {{.receiver}}) SSZFields() []ssz.FieldInfo {
+		return []ssz.FieldInfo{ {{.fields}}
+		}
+	}`
+
+	fields := []string{}
+	for _, f := range v.runtimeSchema().FieldsInfo() {
+		fields = append(fields, fmt.Sprintf("\n{Name: %q, Type: %q, Size: %d, Variable: %t, Limit: %d, Offset: %d, Gindex: %d},",
+			f.Name, f.Type, f.Size, f.Variable, f.Limit, f.Offset, f.Gindex))
+	}
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":     name,
+		"receiver": v.receiver(),
+		"fields":   strings.Join(fields, ""),
+	})
+	return appendObjSignature(str, v)
+}
+
+func (v *Value) schema() string {
+	switch v.t {
+	case TypeUint:
+		return fmt.Sprintf("ssz.UintSchema(%d)", v.n)
+
+	case TypeBool:
+		return "ssz.BoolSchema()"
+
+	case TypeBytes:
+		if v.isFixed() {
+			return fmt.Sprintf("ssz.ByteVectorSchema(%d)", v.s)
+		}
+		if v.progressive {
+			return "ssz.ProgressiveListSchema(ssz.UintSchema(1))"
+		}
+		return fmt.Sprintf("ssz.ByteListSchema(%d)", v.m)
+
+	case TypeBitVector:
+		return fmt.Sprintf("ssz.BitvectorSchema(%d)", v.m)
+
+	case TypeBitList:
+		if v.progressive {
+			return "ssz.ProgressiveBitlistSchema()"
+		}
+		return fmt.Sprintf("ssz.BitlistSchema(%d)", v.m)
+
+	case TypeVector:
+		return fmt.Sprintf("ssz.VectorSchema(%s, %d)", v.e.schema(), v.s)
+
+	case TypeList:
+		if v.progressive {
+			return fmt.Sprintf("ssz.ProgressiveListSchema(%s)", v.e.schema())
+		}
+		return fmt.Sprintf("ssz.ListSchema(%s, %d)", v.e.schema(), v.s)
+
+	case TypeContainer:
+		return fmt.Sprintf("new(%s).SchemaSSZ()", v.obj)
+
+	default:
+		panic(fmt.Errorf("schema not implemented for type %s", v.t.String()))
+	}
+}
+
+// runtimeSchema returns the same schema as the generated SchemaSSZ function,
+// which is used by the subcommands that decode the encodings of the structs.
+func (v *Value) runtimeSchema() *ssz.Schema {
+	switch v.t {
+	case TypeUint:
+		return ssz.UintSchema(v.n)
+
+	case TypeBool:
+		return ssz.BoolSchema()
+
+	case TypeBytes:
+		if v.isFixed() {
+			return ssz.ByteVectorSchema(v.s)
+		}
+		if v.progressive {
+			return ssz.ProgressiveListSchema(ssz.UintSchema(1))
+		}
+		return ssz.ByteListSchema(v.m)
+
+	case TypeBitVector:
+		return ssz.BitvectorSchema(v.m)
+
+	case TypeBitList:
+		if v.progressive {
+			return ssz.ProgressiveBitlistSchema()
+		}
+		return ssz.BitlistSchema(v.m)
+
+	case TypeVector:
+		return ssz.VectorSchema(v.e.runtimeSchema(), v.s)
+
+	case TypeList:
+		if v.progressive {
+			return ssz.ProgressiveListSchema(v.e.runtimeSchema())
+		}
+		return ssz.ListSchema(v.e.runtimeSchema(), v.s)
+
+	case TypeContainer:
+		fields := []*ssz.Field{}
+		for _, f := range v.o {
+			fields = append(fields, ssz.NewField(f.specName(), f.runtimeSchema()))
+		}
+		name := v.obj
+		if indx := strings.LastIndex(name, "."); indx != -1 {
+			name = name[indx+1:]
+		}
+		return ssz.ContainerSchema(name, fields...)
+
+	default:
+		panic(fmt.Errorf("schema not implemented for type %s", v.t.String()))
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 )
@@ -10,26 +11,51 @@ import (
 // 1. Fixed: Size that we can determine at compilation time (i.e. uint, fixed bytes, fixed vector...)
 // 2. Dynamic: Size that depends on the input (i.e. lists, dynamic containers...)
 func (e *env) size(name string, v *Value) string {
-	tmpl := `// SizeSSZ returns the ssz encoded size in bytes for the {{.name}} object
-	func (:: *{{.name}}) SizeSSZ() (size int) {
+	tmpl := `// SizeSSZ{{.fork}} returns the ssz encoded size in bytes for the {{.name}} object
+	func (:: {{.receiver}}) SizeSSZ{{.fork}}() (size int) {
 		size = {{.fixed}}{{if .dynamic}}
 
 		{{.dynamic}}
 		{{end}}
 		return
 	}`
+	if v.isFixed() {
+		// the size of the fixed containers is a constant
+		tmpl = `// SizeSSZ{{.fork}} returns the ssz encoded size in bytes for the {{.name}} object
+		func (:: {{.receiver}}) SizeSSZ{{.fork}}() int {
+			return {{.fixed}}
+		}`
+	}
+
+	tmpl += `
 
+	// MaxSizeSSZ{{.fork}} returns the size in bytes of the largest ssz encoding of the {{.name}} object{{if .unbounded}},
+	// which is unbounded with the progressive lists{{end}}
+	func (:: {{.receiver}}) MaxSizeSSZ{{.fork}}() uint64 {
+		return {{.max}}
+	}`
+
+	// the max size is computed with the limits of the tags when the code is generated
+	max := v.runtimeSchema().MaxSize()
 	str := execTmpl(tmpl, map[string]interface{}{
-		"name":    name,
-		"fixed":   v.n,
-		"dynamic": v.sizeContainer("size", true),
+		"name":      name,
+		"fork":      v.fork,
+		"receiver":  v.receiver(),
+		"fixed":     v.n,
+		"dynamic":   v.sizeContainer("size", true),
+		"max":       max,
+		"unbounded": max == math.MaxUint64,
 	})
 	return appendObjSignature(str, v)
 }
 
 func (v *Value) sizeContainer(name string, start bool) string {
 	if !start {
-		return fmt.Sprintf(name+" += ::.%s.SizeSSZ()", v.name)
+		if v.structValue {
+			return fmt.Sprintf("%s += %s.SizeSSZ%s()", name, v.field(), v.fork)
+		}
+		// a nil pointer has the size of the zero value
+		return fmt.Sprintf("if %s == nil {\n%s += new(%s).SizeSSZ%s()\n} else {\n%s += %s.SizeSSZ%s()\n}", v.field(), name, v.obj, v.fork, name, v.field(), v.fork)
 	}
 	out := []string{}
 	for indx, v := range v.o {
@@ -44,9 +70,7 @@ func (v *Value) sizeContainer(name string, start bool) string {
 // during marshalling to figure out the size of the offset
 func (v *Value) size(name string) string {
 	if v.isFixed() {
-		if v.t == TypeContainer {
-			return v.sizeContainer(name, false)
-		}
+		// the size of the fixed values is known when the code is generated
 		if v.n == 1 {
 			return name + "++"
 		}
@@ -61,22 +85,24 @@ func (v *Value) size(name string) string {
 		fallthrough
 
 	case TypeBytes:
-		return fmt.Sprintf(name+" += len(::.%s)", v.name)
+		return fmt.Sprintf(name+" += len(%s)", v.basicValue())
 
 	case TypeList:
 		fallthrough
 
 	case TypeVector:
 		if v.e.isFixed() {
-			return fmt.Sprintf("%s += len(::.%s) * %d", name, v.name, v.e.n)
+			return fmt.Sprintf("%s += len(%s) * %d", name, v.field(), v.e.n)
 		}
-		v.e.name = v.name + "[ii]"
-		tmpl := `for ii := 0; ii < len(::.{{.name}}); ii++ {
+		v.e.name = v.name + "[" + v.index() + "]"
+		tmpl := `for {{.ii}} := 0; {{.ii}} < len({{.field}}); {{.ii}}++ {
 			{{.size}} += 4
 			{{.dynamic}}
 		}`
 		return execTmpl(tmpl, map[string]interface{}{
+			"ii":      v.index(),
 			"name":    v.name,
+			"field":   v.field(),
 			"size":    name,
 			"dynamic": v.e.size(name),
 		})
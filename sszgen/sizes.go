@@ -0,0 +1,118 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"text/tabwriter"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// sizesCmd prints the breakdown of the sizes of the encodings of a struct by field (ssz.SizeProfile)
+// for the files in the arguments or the input from stdin, to find the fields that dominate the bandwidth.
+// Without any input it prints the bounds of the sizes of the encodings of the structs instead, which
+// are the limits of the gossip and req/resp messages.
+func sizesCmd(args []string) error {
+	var source string
+	var typ string
+
+	flagSet := flag.NewFlagSet("sizes", flag.ExitOnError)
+	flagSet.StringVar(&source, "path", "", "")
+	flagSet.StringVar(&typ, "type", "", "")
+
+	opts := defaultOptions()
+	opts.register(flagSet)
+
+	flagSet.Parse(args)
+	opts.setup()
+
+	if typ == "" {
+		return fmt.Errorf("the type to decode is not set")
+	}
+	schemas := []*ssz.Schema{}
+	for _, name := range splitTargets(typ) {
+		schema, err := targetSchema(source, name, opts)
+		if err != nil {
+			return err
+		}
+		schemas = append(schemas, schema)
+	}
+
+	var stdin []byte
+	if flagSet.NArg() == 0 && !isTerminal(os.Stdin) {
+		buf, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		stdin = buf
+	}
+	if flagSet.NArg() == 0 && len(stdin) == 0 {
+		for indx, schema := range schemas {
+			if indx != 0 {
+				fmt.Println()
+			}
+			if err := sizeBounds(os.Stdout, schema); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(schemas) != 1 {
+		return fmt.Errorf("the inputs are decoded with a single type")
+	}
+
+	profile := ssz.NewSizeProfile(schemas[0])
+	if flagSet.NArg() == 0 {
+		if err := profile.Add(stdin); err != nil {
+			return err
+		}
+	}
+	for _, input := range flagSet.Args() {
+		buf, err := ioutil.ReadFile(input)
+		if err != nil {
+			return err
+		}
+		if err := profile.Add(buf); err != nil {
+			return fmt.Errorf("%s: %v", input, err)
+		}
+	}
+	return profile.Report(os.Stdout)
+}
+
+// sizeBounds writes the minimum and the maximum size of the encodings of a container
+// and its fields, with the limits of the lists. The progressive lists are unbounded.
+func sizeBounds(w io.Writer, s *ssz.Schema) error {
+	fmt.Fprintf(w, "%s\n", s.Name)
+	fmt.Fprintf(w, "  min size: %d bytes\n", s.MinSize())
+	fmt.Fprintf(w, "  fixed part: %d bytes\n", fixedPart(s))
+	if max := maxSize(s); max == "unbounded" {
+		fmt.Fprintf(w, "  max size: unbounded\n\n")
+	} else {
+		fmt.Fprintf(w, "  max size: %s bytes\n\n", max)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "FIELD\tTYPE\tMIN\tMAX")
+	for _, f := range s.Fields {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", f.Name, f.Schema, f.Schema.MinSize(), maxSize(f.Schema))
+	}
+	return tw.Flush()
+}
+
+// maxSize returns the maximum size of the encodings of a type in bytes
+func maxSize(s *ssz.Schema) string {
+	if s.MaxSize() == math.MaxUint64 {
+		return "unbounded"
+	}
+	return fmt.Sprintf("%d", s.MaxSize())
+}
+
+// isTerminal returns true if the file is a terminal and not a pipe or a file
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
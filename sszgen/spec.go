@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// specCmd renders the IR of the targets in the notation used by the consensus specs
+// (i.e. 'class Checkpoint(Container):') with all the constants resolved. It is intended
+// to diff the Go types against the published specs.
+func specCmd(args []string) error {
+	var source string
+	var objsStr string
+	var output string
+
+	flagSet := flag.NewFlagSet("spec", flag.ExitOnError)
+	flagSet.StringVar(&source, "path", "", "")
+	flagSet.StringVar(&objsStr, "objs", "", "")
+	flagSet.StringVar(&output, "output", "", "")
+
+	opts := defaultOptions()
+	opts.register(flagSet)
+
+	flagSet.Parse(args)
+	opts.setup()
+
+	e, err := newEnv(source, splitTargets(objsStr), opts)
+	if err != nil {
+		return err
+	}
+	res, err := e.spec()
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		fmt.Print(res)
+		return nil
+	}
+	return ioutil.WriteFile(output, []byte(res), 0644)
+}
+
+// spec prints the containers in the order in which they appear on the files
+// which is usually the same order as in the specs.
+func (e *env) spec() (string, error) {
+	out := []string{}
+	for _, name := range e.orderedObjs() {
+		str, err := e.objs[name].pythonClass(true)
+		if err != nil {
+			return "", err
+		}
+		out = append(out, str)
+	}
+	return strings.Join(out, "\n"), nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tagDims returns the dimensions of a 'ssz-size' or 'ssz-max' tag without the trailing
+// empty ones, a '?' is an empty dimension
+func tagDims(tags string, field string) []string {
+	tag, ok := getTags(tags, field)
+	if !ok {
+		return nil
+	}
+	dims := strings.Split(tag, ",")
+	for indx, dim := range dims {
+		if dim == "?" {
+			dims[indx] = ""
+		}
+	}
+	for len(dims) != 0 && dims[len(dims)-1] == "" {
+		dims = dims[:len(dims)-1]
+	}
+	return dims
+}
+
+// dimAt returns the dimension at the index or an empty one
+func dimAt(dims []string, indx int) string {
+	if indx < len(dims) {
+		return dims[indx]
+	}
+	return ""
+}
+
+// checkTags fails if the tags of a field conflict or do not match its Go type, instead of
+// ignoring the values that are not used like the parser does. It is used with the strict option.
+func checkTags(tags string, v *Value) error {
+	sizes, maxes := tagDims(tags, "ssz-size"), tagDims(tags, "ssz-max")
+	for _, dim := range append(append([]string{}, sizes...), maxes...) {
+		if _, err := strconv.ParseUint(dim, 10, 64); dim != "" && err != nil {
+			return fmt.Errorf("invalid dimension '%s'", dim)
+		}
+	}
+
+	// the dimensions of the type are the sequences and the bytes and bitfields, the elements
+	// of the innermost dimension (i.e. the uint64 of a [][]uint64) do not have a size or a max
+	dim := 0
+	var last *Value
+	for i := v; i != nil; i = i.e {
+		size, max := dimAt(sizes, dim), dimAt(maxes, dim)
+		if i.t == TypeContainer || i.t == TypeUint || i.t == TypeBool {
+			if size != "" || max != "" {
+				return fmt.Errorf("ssz-size or ssz-max set for the %s at dimension %d", i.t.String(), dim)
+			}
+			break
+		}
+		switch {
+		case size != "" && max != "":
+			return fmt.Errorf("both ssz-size and ssz-max set for the dimension %d", dim)
+		case i.t == TypeBitList && size != "":
+			return fmt.Errorf("ssz-size set for the bitlist at dimension %d", dim)
+		case i.t == TypeBitVector && max != "":
+			return fmt.Errorf("ssz-max set for the bitvector at dimension %d", dim)
+		case i.progressive && max != "":
+			return fmt.Errorf("ssz-max set for the progressive %s at dimension %d", i.t.String(), dim)
+		}
+		last = i
+		dim++
+	}
+	if len(sizes) > dim || len(maxes) > dim {
+		return fmt.Errorf("the tags have more dimensions than the type (%d)", dim)
+	}
+
+	tag, ok := getTags(tags, "ssz")
+	if !ok {
+		return nil
+	}
+	switch tag {
+	case "bitlist":
+		if last == nil || last.t != TypeBitList || last.progressive {
+			return fmt.Errorf("ssz tag 'bitlist' set for a type without bitlist")
+		}
+	case "progressive-bitlist":
+		if last == nil || last.t != TypeBitList || !last.progressive {
+			return fmt.Errorf("ssz tag 'progressive-bitlist' set for a type without bitlist")
+		}
+	case "unix", "unix-milli":
+		if v.unixTime == "" {
+			return fmt.Errorf("ssz tag '%s' set for a type that is not a time.Time", tag)
+		}
+	case "progressive":
+		if !v.progressive {
+			return fmt.Errorf("ssz tag 'progressive' set for a type that is not a list")
+		}
+	default:
+		return fmt.Errorf("unknown ssz tag '%s'", tag)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestStrictTags(t *testing.T) {
+	cases := []struct {
+		field string
+		err   string
+	}{
+		{
+			"A []byte `ssz-size:\"32\" ssz-max:\"64\"`",
+			"both ssz-size and ssz-max set for the dimension 0",
+		},
+		{
+			"A [][]byte `ssz-size:\"?,32\" ssz-max:\"16,64\"`",
+			"both ssz-size and ssz-max set for the dimension 1",
+		},
+		{
+			"A []byte `ssz:\"bitlist\" ssz-size:\"32\" ssz-max:\"2048\"`",
+			"both ssz-size and ssz-max set for the dimension 0",
+		},
+		{
+			"A []uint64 `ssz-max:\"4\" ssz-size:\"?,abc\"`",
+			"invalid dimension 'abc'",
+		},
+		{
+			"A uint64 `ssz-max:\"4\"`",
+			"at dimension 0",
+		},
+		{
+			"A []uint64 `ssz-size:\"4,8\"`",
+			"at dimension 1",
+		},
+		{
+			"A []uint64 `ssz-size:\"4,?,8\"`",
+			"the tags have more dimensions than the type (1)",
+		},
+		{
+			"A []uint64 `ssz:\"bitlist\" ssz-max:\"4\"`",
+			"ssz tag 'bitlist' set for a type without bitlist",
+		},
+		{
+			"A []uint64 `ssz:\"unix\" ssz-max:\"4\"`",
+			"ssz tag 'unix' set for a type that is not a time.Time",
+		},
+		{
+			"A []byte `ssz:\"other\" ssz-max:\"4\"`",
+			"unknown ssz tag 'other'",
+		},
+	}
+
+	opts := defaultOptions()
+	opts.strict = true
+	for _, c := range cases {
+		dir, path := writePackage(t, "package types\n\ntype Obj struct {\n"+c.field+"\n}\n")
+		// the parser alone ignores the values that are not used
+		if _, err := newEnv(path, nil, defaultOptions()); err != nil {
+			os.RemoveAll(dir)
+			t.Fatalf("%s: expected no error without the strict option but found %v", c.field, err)
+		}
+		_, err := newEnv(path, nil, opts)
+		os.RemoveAll(dir)
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Fatalf("%s: expected '%s' but found %v", c.field, c.err, err)
+		}
+	}
+
+	// the valid tags pass
+	valid := "package types\n\ntype Obj struct {\n" +
+		"A []byte `ssz:\"bitlist\" ssz-max:\"2048\"`\n" +
+		"B [][]byte `ssz-size:\"?,32\" ssz-max:\"16\"`\n" +
+		"C []uint64 `ssz-size:\"4\"`\n" +
+		"D uint64\n" +
+		"}\n"
+	dir, path := writePackage(t, valid)
+	defer os.RemoveAll(dir)
+	if _, err := newEnv(path, nil, opts); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"go/ast"
+)
+
+// isByteType returns true if the type is a fixed or dynamic array of bytes
+func isByteType(expr ast.Expr) bool {
+	arr, ok := expr.(*ast.ArrayType)
+	return ok && isByte(arr.Elt)
+}
+
+// text creates the MarshalText and UnmarshalText functions for a named byte type
+// (i.e. 'type Root [32]byte') that encode the value as 0x prefixed hex. It returns
+// false if the name is not a named byte type or it is not a target.
+func (e *env) text(name string) (string, bool) {
+	if !e.opts.text {
+		return "", false
+	}
+	expr, ok := e.types[name]
+	if !ok || !isByteType(expr) {
+		return "", false
+	}
+	if e.targets != nil && !contains(name, e.targets) {
+		return "", false
+	}
+
+	tmpl := `// MarshalText encodes the {{.name}} object as 0x prefixed hex
+	func (:: {{.name}}) MarshalText() ([]byte, error) {
+		return ssz.MarshalHex(::[:])
+	}
+
+	// UnmarshalText decodes the {{.name}} object from hex
+	func (:: *{{.name}}) UnmarshalText(text []byte) error {
+		{{if .fixed}}return ssz.UnmarshalFixedHex(::[:], text){{else}}buf, err := ssz.UnmarshalHex(text)
+		if err != nil {
+			return err
+		}
+		*:: = buf
+		return nil{{end}}
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":  name,
+		"fixed": expr.(*ast.ArrayType).Len != nil,
+	})
+	return appendObjSignature(str, &Value{name: name}), true
+}
@@ -2,26 +2,111 @@ package main
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 )
 
 // unmarshal creates a function that decodes the structs with the input byte in SSZ format.
 func (e *env) unmarshal(name string, v *Value) string {
-	tmpl := `// UnmarshalSSZ ssz unmarshals the {{.name}} object
-	func (:: *{{.name}}) UnmarshalSSZ(buf []byte) error {
+	tmpl := `// UnmarshalSSZ{{.fork}} ssz unmarshals the {{.name}} object
+	func (:: *{{.name}}) UnmarshalSSZ{{.fork}}(buf []byte) error {
 		var err error
 		{{.unmarshal}}
 		return err
 	}`
 
+	body := v.umarshalContainer(true, "buf")
+	if e.fieldHelpers(v) {
+		body = v.unmarshalFields(func(i *Value, dst string) string {
+			return fmt.Sprintf("if err = ::.%s(%s); err != nil {\n return err\n}", helperName("unmarshalSSZ"+v.fork, i), dst)
+		})
+	}
 	str := execTmpl(tmpl, map[string]interface{}{
 		"name":      name,
-		"unmarshal": v.umarshalContainer(true, "buf"),
+		"fork":      v.fork,
+		"unmarshal": body,
 	})
+	if e.fieldHelpers(v) {
+		str += v.unmarshalHelpers(name)
+	}
+	if e.opts.verify && v.fork == "" {
+		str += "\n\n" + e.unmarshalVerify(name)
+	}
+	if e.opts.objectPool && v.fork == "" {
+		str += "\n\n" + e.unmarshalPool(name, v)
+	}
+	if e.opts.noCopy && v.fork == "" {
+		str += "\n\n" + e.unmarshalNoCopy(name, v)
+	}
 	return appendObjSignature(str, v)
 }
 
+// unmarshalPool creates a function that decodes the struct with the nested objects of an ssz.ObjectPool.
+func (e *env) unmarshalPool(name string, v *Value) string {
+	tmpl := `// UnmarshalSSZWithPool ssz unmarshals the {{.name}} object with the nested objects of the pool
+	func (:: *{{.name}}) UnmarshalSSZWithPool(buf []byte, pool ssz.ObjectPool) error {
+		var err error
+		{{.unmarshal}}
+		return err
+	}`
+
+	vv := v.copy()
+	vv.each(func(v *Value) {
+		v.pool = true
+	})
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":      name,
+		"unmarshal": vv.umarshalContainer(true, "buf"),
+	})
+}
+
+// unmarshalNoCopy creates a function that decodes the struct with the byte fields that alias the
+// input and a function that copies the struct with its own memory.
+func (e *env) unmarshalNoCopy(name string, v *Value) string {
+	tmpl := `// UnmarshalSSZNoCopy ssz unmarshals the {{.name}} object, the byte fields alias the input
+	func (:: *{{.name}}) UnmarshalSSZNoCopy(buf []byte) error {
+		var err error
+		{{.unmarshal}}
+		return err
+	}
+
+	// CopySSZ returns a copy of the {{.name}} object that does not share memory with it
+	// (i.e. with the input of UnmarshalSSZNoCopy)
+	func (:: *{{.name}}) CopySSZ() (*{{.name}}, error) {
+		buf, err := ::.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		obj := new({{.name}})
+		if err := obj.UnmarshalSSZ(buf); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	}`
+
+	vv := v.copy()
+	vv.each(func(v *Value) {
+		v.noCopy = true
+	})
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":      name,
+		"unmarshal": vv.umarshalContainer(true, "buf"),
+	})
+}
+
+// unmarshalVerify creates a function that decodes the struct and fails if the input is not its canonical encoding.
+func (e *env) unmarshalVerify(name string) string {
+	tmpl := `// UnmarshalSSZVerify ssz unmarshals the {{.name}} object and fails if the input is not its canonical encoding
+	func (:: *{{.name}}) UnmarshalSSZVerify(buf []byte) error {
+		return ssz.UnmarshalVerify(::, buf)
+	}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"name": name,
+	})
+}
+
 func (v *Value) unmarshal(dst string) string {
 	// we use dst as the input buffer where the SSZ data to decode the value is.
 	switch v.t {
@@ -29,24 +114,70 @@ func (v *Value) unmarshal(dst string) string {
 		return v.umarshalContainer(false, dst)
 
 	case TypeBytes:
-		// both fixed and dynamic are decoded equally
-		return fmt.Sprintf("::.%s = append(::.%s, %s...)", v.name, v.name, dst)
+		// both fixed and dynamic are decoded equally, the dynamic bytes check the 'ssz-max' limit first
+		limit := ""
+		if !v.isFixed() && !v.progressive {
+			limit = fmt.Sprintf("if %s > %d {\n return errListTooBig\n}\n", lenValue("len("+dst+")", v.m), v.m)
+		}
+		if v.wrapper != "" {
+			return limit + v.setBasicValue(fmt.Sprintf("append([]byte{}, %s...)", dst))
+		}
+		if v.array != "" {
+			// the arrays are always copied
+			return fmt.Sprintf("copy(::.%s[:], %s)", v.name, dst)
+		}
+		if v.ptr {
+			return limit + fmt.Sprintf("if ::.%s == nil {\n::.%s = new([]byte)\n}\n*::.%s = %s", v.name, v.name, v.name, v.bytesValue(dst))
+		}
+		return limit + fmt.Sprintf("::.%s = %s", v.name, v.bytesValue(dst))
 
 	case TypeUint:
-		return fmt.Sprintf("::.%s = ssz.Unmarshall%s(%s)", v.name, uintVToName(v), dst)
+		if v.uint256 != "" {
+			return v.allocUint256() + fmt.Sprintf("ssz.UnmarshalUint256(%s, %s)", v.basicValue(), dst)
+		}
+		if v.unixTime != "" {
+			return fmt.Sprintf("if ::.%s, err = ssz.UnmarshalTime(%s, %t); err != nil {\n return err\n}", v.name, dst, v.milli())
+		}
+		return v.setBasicValue(fmt.Sprintf("ssz.Unmarshall%s(%s)", uintVToName(v), dst))
+
+	case TypeBitVector:
+		tmpl := `if err = ssz.ValidateBitvector({{.dst}}, {{.bits}}); err != nil {
+			return err
+		}
+		::.{{.name}} = {{.value}}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"dst":   dst,
+			"bits":  v.m,
+			"value": v.bytesValue(dst),
+		})
 
 	case TypeBitList:
-		return fmt.Sprintf("::.%s = append(::.%s, %s...)", v.name, v.name, dst)
+		tmpl := `if err = ssz.ValidateBitlist({{.dst}}, {{.max}}); err != nil {
+			return err
+		}
+		::.{{.name}} = {{.value}}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"dst":   dst,
+			"max":   v.m,
+			"value": v.bytesValue(dst),
+		})
 
 	case TypeVector:
 		if v.e.isFixed() {
-			dst = fmt.Sprintf("%s[ii*%d: (ii+1)*%d]", dst, v.e.n, v.e.n)
+			ii := v.index()
+			validate := v.validateBools(dst)
+			dst = fmt.Sprintf("%s[%s*%d: (%s+1)*%d]", dst, ii, v.e.n, ii, v.e.n)
+			v.e.name = v.name + "[" + ii + "]"
 
-			tmpl := `{{.create}}
-			for ii := 0; ii < {{.size}}; ii++ {
+			tmpl := `{{.validate}}{{.create}}
+			for {{.ii}} := 0; {{.ii}} < {{.size}}; {{.ii}}++ {
 				{{.unmarshal}}
 			}`
 			return execTmpl(tmpl, map[string]interface{}{
+				"ii":        ii,
+				"validate":  validate,
 				"create":    v.createSlice(),
 				"size":      v.s,
 				"unmarshal": v.e.unmarshal(dst),
@@ -58,56 +189,100 @@ func (v *Value) unmarshal(dst string) string {
 		return v.unmarshalList()
 
 	case TypeBool:
-		return fmt.Sprintf("::.%s = ssz.UnmarshalBool(%s)", v.name, dst)
+		return v.setBasicValue(fmt.Sprintf("ssz.UnmarshalBool(%s)", dst))
 
 	default:
 		panic(fmt.Errorf("unmarshal not implemented for type %d", v.t))
 	}
 }
 
+// validateBools returns the check of the bytes of a list or vector of booleans, which
+// are decoded one by one as true only if they are 1
+func (v *Value) validateBools(dst string) string {
+	if v.e.t != TypeBool {
+		return ""
+	}
+	return fmt.Sprintf("if err = ssz.ValidateBools(%s); err != nil {\n return err\n}\n", dst)
+}
+
+// bytesValue returns the value of a byte field decoded from dst, either a copy or an alias of the input
+func (v *Value) bytesValue(dst string) string {
+	if v.noCopy {
+		return fmt.Sprintf("ssz.Alias(%s)", dst)
+	}
+	if v.ptr {
+		return fmt.Sprintf("append((*::.%s)[:0], %s...)", v.name, dst)
+	}
+	return fmt.Sprintf("append(::.%s[:0], %s...)", v.name, dst)
+}
+
 func (v *Value) unmarshalList() string {
 
 	// The Go field must have a 'ssz-max' tag to set the maximum number of items
 	maxSize := v.s
 
-	// In order to use createSlice with a dynamic list we need to set v.s to 0
-	v.s = 0
+	// In order to use createSlice with a dynamic list we need to set v.s to 0. We use
+	// a copy since the other generated functions still need the 'ssz-max' tag.
+	create := *v
+	create.s = 0
 
 	if v.e.isFixed() {
-		dst := fmt.Sprintf("buf[ii*%d: (ii+1)*%d]", v.e.n, v.e.n)
+		ii := v.index()
+		dst := fmt.Sprintf("buf[%s*%d: (%s+1)*%d]", ii, v.e.n, ii, v.e.n)
+		v.e.name = v.name + "[" + ii + "]"
 
 		tmpl := `num, ok := ssz.DivideInt(len(buf), {{.size}})
 		if !ok {
 			return errDivideInt
 		}
-		if num > {{.max}} {
+		{{if .max}}if {{.num}} > {{.max}} {
 			return errListTooBig
 		}
-		{{.create}}
-		for ii := 0; ii < num; ii++ {
+		{{end}}{{.validate}}{{.create}}
+		for {{.ii}} := 0; {{.ii}} < num; {{.ii}}++ {
 			{{.unmarshal}}
 		}`
+		max := fmt.Sprintf("%d", maxSize)
+		if v.progressive {
+			max = ""
+		}
 		return execTmpl(tmpl, map[string]interface{}{
+			"ii":        ii,
 			"size":      v.e.n,
-			"max":       maxSize,
-			"create":    v.createSlice(),
+			"max":       max,
+			"num":       lenValue("num", maxSize),
+			"validate":  v.validateBools("buf"),
+			"create":    create.createSlice(),
 			"unmarshal": v.e.unmarshal(dst),
 		})
 	}
 
-	if v.t == TypeVector {
-		panic("it cannot happen")
-	}
-
 	// Decode list with a dynamic element. 'ssz.DecodeDynamicLength' ensures
-	// that the number of elements do not surpass the 'ssz-max' tag.
-
-	tmpl := `num, err := ssz.DecodeDynamicLength(buf, {{.size}})
+	// that the number of elements do not surpass the 'ssz-max' tag. A vector
+	// of dynamic elements (i.e. [2][]uint64) must have all its elements.
+	// The number of elements comes from the first offset, so the list grows
+	// as the elements are decoded from an initial capacity of 'ssz.ListCapacity'.
+	// The list of the object being decoded is reused if it has the capacity for
+	// all the elements, then the elements are decoded in place.
+
+	tmpl := `num, err := ssz.DecodeDynamicLength(buf, {{.max}})
 	if err != nil {
 		return err
 	}
-	{{.create}}
-	err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+	{{if .vector}}if num != {{.size}} {
+		return errOffset
+	}
+	{{end}}if cap(::.{{.name}}) >= num {
+		::.{{.name}} = ::.{{.name}}[:0]
+	} else {
+		::.{{.name}} = make({{.type}}, 0, ssz.ListCapacity(num))
+	}
+	err = ssz.UnmarshalDynamic(buf, num, func({{.indx}} int, buf []byte) (err error) {
+		if len(::.{{.name}}) < cap(::.{{.name}}) {
+			::.{{.name}} = ::.{{.name}}[:len(::.{{.name}})+1]
+		} else {
+			::.{{.name}} = append(::.{{.name}}, {{.zero}})
+		}
 		{{.unmarshal}}
 		return nil
 	})
@@ -115,31 +290,83 @@ func (v *Value) unmarshalList() string {
 		return err
 	}`
 
-	v.e.name = v.name + "[indx]"
-
+	// the nested lists use a different index for each dimension
+	indx := "indx"
+	if strings.Contains(v.name, "[") {
+		indx = v.index()
+	}
+	name := v.name
+	v.e.name = v.name + "[" + indx + "]"
+
+	// the progressive lists have at most an element per offset, as the
+	// lists whose limit does not fit in the int of the 32 bits platforms
+	max := fmt.Sprintf("%d", maxSize)
+	if v.progressive || maxSize > math.MaxInt32 {
+		max = "len(buf)"
+	}
 	data := map[string]interface{}{
+		"indx":      indx,
+		"vector":    v.t == TypeVector,
 		"size":      maxSize,
-		"create":    v.createSlice(),
+		"max":       max,
+		"name":      name,
+		"type":      v.goType(),
+		"zero":      "nil",
 		"unmarshal": v.e.unmarshal("buf"),
 	}
+	if v.e.structValue {
+		data["zero"] = v.e.obj + "{}"
+	}
 	return execTmpl(tmpl, data)
 }
 
 func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	if !start {
-		tmpl := `if ::.{{.name}} == nil {
+		// the structs stored by value are decoded in place
+		tmpl := `{{if not .value}}if ::.{{.name}} == nil {
 			::.{{.name}} = new({{.obj}})
 		}
-		if err = ::.{{.name}}.UnmarshalSSZ({{.dst}}); err != nil {
+		{{end}}if err = ::.{{.name}}.UnmarshalSSZ{{.fork}}({{.dst}}); err != nil {
 			return err
 		}`
+		if v.pool {
+			tmpl = `{{if not .value}}if ::.{{.name}} == nil && pool != nil {
+				::.{{.name}}, _ = pool.Get((*{{.obj}})(nil)).(*{{.obj}})
+			}
+			if ::.{{.name}} == nil {
+				::.{{.name}} = new({{.obj}})
+			}
+			{{end}}if err = ssz.UnmarshalWithPool({{.ref}}, {{.dst}}, pool); err != nil {
+				return err
+			}`
+		}
+		if v.noCopy {
+			tmpl = `{{if not .value}}if ::.{{.name}} == nil {
+				::.{{.name}} = new({{.obj}})
+			}
+			{{end}}if err = ssz.UnmarshalNoCopy({{.ref}}, {{.dst}}); err != nil {
+				return err
+			}`
+		}
+		// the fields are assigned directly, also with the use-getters option
+		ref := "::." + v.name
+		if v.structValue {
+			ref = "&" + ref
+		}
 		return execTmpl(tmpl, map[string]interface{}{
-			"name": v.name,
-			"obj":  v.obj,
-			"dst":  dst,
+			"name":  v.name,
+			"fork":  v.fork,
+			"obj":   v.obj,
+			"dst":   dst,
+			"value": v.structValue,
+			"ref":   ref,
 		})
 	}
+	return v.unmarshalFields((*Value).unmarshal)
+}
 
+// unmarshalFields decodes the fixed part and then the dynamic parts of a container, each field with unmarshal
+func (v *Value) unmarshalFields(unmarshal func(v *Value, dst string) string) (str string) {
 	var offsets []string
 	offsetsMatch := map[string]string{}
 
@@ -197,12 +424,12 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 			incr = 4
 		}
 
-		dst = fmt.Sprintf("%s[%d:%d]", "buf", o0, o0+incr)
+		dst := fmt.Sprintf("%s[%d:%d]", "buf", o0, o0+incr)
 		o0 += incr
 
 		var res string
 		if i.isFixed() {
-			res = fmt.Sprintf("// Field (%d) '%s'\n%s\n\n", indx, i.name, i.unmarshal(dst))
+			res = fmt.Sprintf("// Field (%d) '%s'\n%s\n\n", indx, i.name, unmarshal(i, dst))
 
 		} else {
 			// read the offset
@@ -217,12 +444,13 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 
 			// We need to do two validations for the offset:
 			// 1. The offset is lower than the total size of the input buffer
-			// 2. The offset i needs to be higher than the offset i-1 (Only if the offset is not the first).
+			// 2. The offset i needs to be higher than the offset i-1. The first offset
+			// must point to the end of the fixed part instead.
 
 			if prev, ok := offsetsMatch[offset]; ok {
 				data["more"] = fmt.Sprintf(" || %s > %s", prev, offset)
 			} else {
-				data["more"] = ""
+				data["more"] = fmt.Sprintf(" || %s != %d", offset, v.n)
 			}
 
 			tmpl := `// Offset ({{.indx}}) '{{.name}}'
@@ -259,7 +487,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 				"name":      i.name,
 				"from":      from,
 				"to":        to,
-				"unmarshal": i.unmarshal("buf"),
+				"unmarshal": unmarshal(i, "buf"),
 			})
 			outs = append(outs, res)
 			c++
@@ -270,6 +498,22 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	return
 }
 
+// reuseSlice returns the statement that sets the slice to size elements. The slice of the object
+// being decoded is reused if it has enough capacity, then its elements (i.e. the nested objects
+// and the byte fields) are decoded in place.
+func reuseSlice(name, typ, size string) string {
+	tmpl := `if cap(::.{{.name}}) >= {{.size}} {
+		::.{{.name}} = ::.{{.name}}[:{{.size}}]
+	} else {
+		::.{{.name}} = make([]{{.type}}, {{.size}})
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"name": name,
+		"type": typ,
+		"size": size,
+	})
+}
+
 // createItem is used to initialize slices of objects
 func (v *Value) createSlice() string {
 	if v.t != TypeVector && v.t != TypeList {
@@ -287,16 +531,28 @@ func (v *Value) createSlice() string {
 
 	switch v.e.t {
 	case TypeUint:
+		if v.e.uint256 != "" {
+			// []*uint256.Int
+			return reuseSlice(v.name, v.e.uint256, size)
+		}
+		if v.e.obj != "" {
+			// []NamedInt
+			return reuseSlice(v.name, v.e.obj, size)
+		}
 		// []int uses the Extend functions in the fastssz package
 		return fmt.Sprintf("::.%s = ssz.Extend%s(::.%s, %s)", v.name, uintVToName(v.e), v.name, size)
 
 	case TypeContainer:
-		// []*Struct{}
-		return fmt.Sprintf("::.%s = make([]*%s, %s)", v.name, v.e.obj, size)
+		// []*Struct{} or []Struct{}
+		return reuseSlice(v.name, v.e.goType(), size)
 
 	case TypeBytes:
-		// [][]byte
-		return fmt.Sprintf("::.%s = make([][]byte, %s)", v.name, size)
+		// [][]byte or [][32]byte
+		return reuseSlice(v.name, v.e.goType(), size)
+
+	case TypeBool, TypeVector, TypeList, TypeBitList, TypeBitVector:
+		// []bool, [][]uint64, []bitfield.Bitlist, []bitfield.Bitvector64
+		return reuseSlice(v.name, v.e.goType(), size)
 
 	default:
 		panic(fmt.Sprintf("create not implemented for type %s", v.e.t.String()))
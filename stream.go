@@ -0,0 +1,434 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"hash"
+	"io"
+)
+
+// HashTreeRootReader computes the root of the value with the schema encoded in the next
+// size bytes of the reader. The input is hashed as it is read, only the offsets
+// and the branches of the trees being merkleized are kept in memory. Then, a
+// download can be verified as it arrives (i.e. with an io.TeeReader to store it).
+func HashTreeRootReader(s *Schema, r io.Reader, size uint64) ([32]byte, error) {
+	return defaultProfile.HashTreeRootReader(s, r, size)
+}
+
+type streamHasher struct {
+	r io.Reader
+	p *Profile
+	// hash is nil when the input is only validated
+	hash hash.Hash
+	buf  [64]byte
+	// cache has the roots of the containers in the sequences (HashTreeRootCached)
+	cache RootCache
+}
+
+func (h *streamHasher) hashPair(a, b [32]byte) (res [32]byte) {
+	if h.hash == nil {
+		return
+	}
+	h.hash.Reset()
+	h.hash.Write(a[:])
+	h.hash.Write(b[:])
+	h.hash.Sum(res[:0])
+	return
+}
+
+func (h *streamHasher) read(size uint64) ([]byte, error) {
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(h.r, buf); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, ErrSize
+		}
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (h *streamHasher) readOffset() (uint64, error) {
+	buf, err := h.read(bytesPerLengthOffset)
+	if err != nil {
+		return 0, err
+	}
+	return ReadOffset(buf), nil
+}
+
+// depth returns the depth of the tree of the contents with the chunks of the profile
+func (h *streamHasher) depth(s *Schema) uint8 {
+	return getDepth(s.chunkCount(h.p.ChunkSize))
+}
+
+// leaf returns the leaf of a chunk, which is padded with zeros to the chunk size
+func (h *streamHasher) leaf(chunk []byte) (res [32]byte) {
+	if h.p.Leaf == nil {
+		copy(res[:], chunk)
+		return
+	}
+	if h.hash == nil {
+		return
+	}
+	if uint64(len(chunk)) < h.p.ChunkSize {
+		chunk = append(chunk[:len(chunk):len(chunk)], make([]byte, h.p.ChunkSize-uint64(len(chunk)))...)
+	}
+	return h.p.Leaf(chunk)
+}
+
+// merkleizer computes the root of a tree from the leaves in order, it only keeps
+// the root of the last complete subtree of each depth
+type merkleizer struct {
+	h      *streamHasher
+	branch [65][32]byte
+	count  uint64
+}
+
+func (m *merkleizer) add(chunk [32]byte) {
+	i := 0
+	for ; m.count>>uint(i)&1 == 1; i++ {
+		chunk = m.h.hashPair(m.branch[i], chunk)
+	}
+	m.branch[i] = chunk
+	m.count++
+}
+
+// root returns the root of the tree with the given depth
+func (m *merkleizer) root(depth uint8) [32]byte {
+	if m.h.p.Padding == PadNone {
+		return m.unpaddedRoot()
+	}
+	zeroHashes := &m.h.p.zeroHashes
+	if m.count == 0 {
+		return zeroHashes[depth]
+	}
+	if m.count == 1<<depth {
+		return m.branch[depth]
+	}
+	node := zeroHashes[0]
+	for i := uint8(0); i < depth; i++ {
+		if m.count>>i&1 == 1 {
+			node = m.h.hashPair(m.branch[i], node)
+		} else {
+			node = m.h.hashPair(node, zeroHashes[i])
+		}
+	}
+	return node
+}
+
+// unpaddedRoot returns the root of the tree with only the leaves that were added, the
+// complete subtree of each depth is the left child of the root of the next leaves
+func (m *merkleizer) unpaddedRoot() [32]byte {
+	if m.count == 0 {
+		return m.h.p.zeroHashes[0]
+	}
+	var node [32]byte
+	found := false
+	for i := 0; i < len(m.branch); i++ {
+		if m.count>>uint(i)&1 == 0 {
+			continue
+		}
+		if found {
+			node = m.h.hashPair(m.branch[i], node)
+		} else {
+			node, found = m.branch[i], true
+		}
+	}
+	return node
+}
+
+func (h *streamHasher) mixin(root [32]byte, length uint64) [32]byte {
+	var leaf [32]byte
+	binary.LittleEndian.PutUint64(leaf[:], length)
+	return h.hashPair(root, leaf)
+}
+
+// hashChunks merkleizes the next size bytes as packed chunks
+func (h *streamHasher) hashChunks(size uint64, depth uint8, isBool bool) ([32]byte, error) {
+	m := &merkleizer{h: h}
+	if err := h.readChunks(size, isBool, m.add); err != nil {
+		return [32]byte{}, err
+	}
+	return m.root(depth), nil
+}
+
+// contents returns the functions to add the leaves of the tree of the contents of a vector or a list and to compute its root
+func (h *streamHasher) contents(s *Schema) (func([32]byte), func() [32]byte) {
+	if s.Progressive {
+		p := newProgressiveMerkleizer(h)
+		return p.add, p.root
+	}
+	m := &merkleizer{h: h}
+	return m.add, func() [32]byte {
+		return m.root(h.depth(s))
+	}
+}
+
+// readChunks reads the next size bytes as packed chunks
+func (h *streamHasher) readChunks(size uint64, isBool bool, add func([32]byte)) error {
+	for size != 0 {
+		n := h.p.ChunkSize
+		if size < n {
+			n = size
+		}
+		buf, err := h.read(n)
+		if err != nil {
+			return err
+		}
+		if isBool {
+			for _, b := range buf {
+				if b > 1 {
+					return ErrInvalidBool
+				}
+			}
+		}
+		add(h.leaf(buf))
+		size -= n
+	}
+	return nil
+}
+
+// addChunks adds the leaves of the bytes as packed chunks
+func (h *streamHasher) addChunks(buf []byte, add func([32]byte)) {
+	for i := uint64(0); i < uint64(len(buf)); i += h.p.ChunkSize {
+		end := i + h.p.ChunkSize
+		if end > uint64(len(buf)) {
+			end = uint64(len(buf))
+		}
+		add(h.leaf(buf[i:end]))
+	}
+}
+
+func (h *streamHasher) hashValue(s *Schema, size uint64) ([32]byte, error) {
+	switch s.Kind {
+	case KindUint, KindBool:
+		if size != s.Size {
+			return [32]byte{}, ErrSize
+		}
+		return h.hashChunks(size, 0, s.Kind == KindBool)
+
+	case KindByteVector:
+		if size != s.Size {
+			return [32]byte{}, ErrSize
+		}
+		return h.hashChunks(size, h.depth(s), false)
+
+	case KindByteList:
+		if size > s.Max {
+			return [32]byte{}, ErrListTooBig
+		}
+		root, err := h.hashChunks(size, h.depth(s), false)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		return h.mixin(root, size), nil
+
+	case KindBitVector:
+		// the bitfields are small and the last byte has to be checked before hashing
+		if size != s.FixedSize() {
+			return [32]byte{}, ErrSize
+		}
+		buf, err := h.read(size)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if err := ValidateBitvector(buf, s.Size); err != nil {
+			return [32]byte{}, err
+		}
+		m := &merkleizer{h: h}
+		h.addChunks(buf, m.add)
+		return m.root(h.depth(s)), nil
+
+	case KindBitList:
+		if !s.Progressive && size > s.Max/8+1 {
+			return [32]byte{}, ErrListTooBig
+		}
+		buf, err := h.read(size)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if err := ValidateBitlist(buf, s.Max); err != nil {
+			return [32]byte{}, err
+		}
+		bits, num := parseBitlist(nil, buf)
+		add, contentsRoot := h.contents(s)
+		h.addChunks(bits, add)
+		return h.mixin(contentsRoot(), num), nil
+
+	case KindVector, KindList:
+		return h.hashElems(s, size)
+
+	case KindContainer:
+		return h.hashContainer(s, size)
+
+	case KindUnion:
+		if size == 0 {
+			return [32]byte{}, ErrSize
+		}
+		buf, err := h.read(1)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		opt, err := s.option(uint64(buf[0]))
+		if err != nil {
+			return [32]byte{}, err
+		}
+		var root [32]byte
+		if opt == nil {
+			if size != 1 {
+				return root, ErrSize
+			}
+		} else if root, err = h.hashValue(opt, size-1); err != nil {
+			return root, err
+		}
+		return h.mixin(root, uint64(buf[0])), nil
+
+	default:
+		panic("BUG: unknown kind")
+	}
+}
+
+func (h *streamHasher) hashElems(s *Schema, size uint64) ([32]byte, error) {
+	max := s.limit()
+	if s.Kind == KindVector {
+		max = s.Size
+	}
+
+	var root [32]byte
+	var num uint64
+	if s.Elem.IsBasic() {
+		if size%s.Elem.Size != 0 {
+			return root, ErrSize
+		}
+		if num = size / s.Elem.Size; num > max {
+			return root, ErrListTooBig
+		}
+		if s.Kind == KindVector && num != s.Size {
+			return root, ErrSize
+		}
+		add, contentsRoot := h.contents(s)
+		if err := h.readChunks(size, s.Elem.Kind == KindBool, add); err != nil {
+			return root, err
+		}
+		root = contentsRoot()
+	} else {
+		// the sizes of the elements
+		var sizes []uint64
+		if s.Elem.IsFixed() {
+			elemSize := s.Elem.FixedSize()
+			if elemSize == 0 || size%elemSize != 0 {
+				return root, ErrSize
+			}
+			num = size / elemSize
+			if num <= max {
+				sizes = make([]uint64, num)
+				for i := range sizes {
+					sizes[i] = elemSize
+				}
+			}
+		} else if size != 0 {
+			first, err := h.readOffset()
+			if err != nil {
+				return root, err
+			}
+			if first%bytesPerLengthOffset != 0 || first > size || first == 0 {
+				return root, ErrOffset
+			}
+			num = first / bytesPerLengthOffset
+			if num <= max {
+				offsets := []uint64{first}
+				for i := uint64(1); i < num; i++ {
+					offset, err := h.readOffset()
+					if err != nil {
+						return root, err
+					}
+					if offset < offsets[i-1] || offset > size {
+						return root, ErrOffset
+					}
+					offsets = append(offsets, offset)
+				}
+				offsets = append(offsets, size)
+				sizes = make([]uint64, num)
+				for i := range sizes {
+					sizes[i] = offsets[i+1] - offsets[i]
+				}
+			}
+		}
+		if num > max {
+			return root, ErrListTooBig
+		}
+		if s.Kind == KindVector && num != s.Size {
+			return root, ErrSize
+		}
+
+		cached := h.cache != nil && h.hash != nil && s.Elem.Kind == KindContainer
+		var layout [32]byte
+		if cached {
+			layout = sum256([]byte(s.Elem.layout()))
+		}
+		add, contentsRoot := h.contents(s)
+		for _, elemSize := range sizes {
+			var elem [32]byte
+			var err error
+			if cached {
+				elem, err = h.hashCached(s.Elem, elemSize, layout)
+			} else {
+				elem, err = h.hashValue(s.Elem, elemSize)
+			}
+			if err != nil {
+				return root, err
+			}
+			add(elem)
+		}
+		root = contentsRoot()
+	}
+	if s.Kind == KindList {
+		root = h.mixin(root, num)
+	}
+	return root, nil
+}
+
+func (h *streamHasher) hashContainer(s *Schema, size uint64) ([32]byte, error) {
+	fixed := uint64(0)
+	for _, f := range s.Fields {
+		fixed += f.Schema.FixedSize()
+	}
+	if size < fixed || (s.IsFixed() && size != fixed) {
+		return [32]byte{}, ErrSize
+	}
+
+	roots := make([][32]byte, len(s.Fields))
+	// the positions of the dynamic fields and their offsets
+	dynamic, offsets := []int{}, []uint64{}
+	for i, f := range s.Fields {
+		if f.Schema.IsFixed() {
+			root, err := h.hashValue(f.Schema, f.Schema.FixedSize())
+			if err != nil {
+				return [32]byte{}, err
+			}
+			roots[i] = root
+			continue
+		}
+		offset, err := h.readOffset()
+		if err != nil {
+			return [32]byte{}, err
+		}
+		// the first offset is the end of the fixed part and the others are in order
+		if offset > size || (len(offsets) == 0 && offset != fixed) || (len(offsets) != 0 && offset < offsets[len(offsets)-1]) {
+			return [32]byte{}, ErrOffset
+		}
+		dynamic, offsets = append(dynamic, i), append(offsets, offset)
+	}
+	offsets = append(offsets, size)
+	for j, i := range dynamic {
+		root, err := h.hashValue(s.Fields[i].Schema, offsets[j+1]-offsets[j])
+		if err != nil {
+			return [32]byte{}, err
+		}
+		roots[i] = root
+	}
+
+	m := &merkleizer{h: h}
+	for _, root := range roots {
+		m.add(root)
+	}
+	return m.root(h.depth(s)), nil
+}
@@ -0,0 +1,205 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/bits"
+)
+
+// HashTreeRootPath returns the root of the value at the path of the object (i.e. 'validators' of a
+// state) without hashing the rest of the object. The object must implement SchemaProvider.
+func HashTreeRootPath(obj Marshaler, path string) ([32]byte, error) {
+	s, ok := obj.(SchemaProvider)
+	if !ok {
+		return [32]byte{}, ErrNotSupported
+	}
+	gindex, _, err := s.SchemaSSZ().Gindex(path)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return HashTreeRootGindex(obj, gindex)
+}
+
+// HashTreeRootGindex returns the root of the node at the generalized index of the tree of the
+// object (LazyValue.SubtreeRoot). The object must implement SchemaProvider.
+func HashTreeRootGindex(obj Marshaler, gindex uint64) ([32]byte, error) {
+	s, ok := obj.(SchemaProvider)
+	if !ok {
+		return [32]byte{}, ErrNotSupported
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return NewLazyValue(s.SchemaSSZ(), bytes.NewReader(buf), int64(len(buf))).SubtreeRoot(gindex)
+}
+
+// HashTreeRoot returns the root of the value, the encoding is hashed as it is read
+func (l *LazyValue) HashTreeRoot() ([32]byte, error) {
+	return HashTreeRootReader(l.schema, io.NewSectionReader(l.r, int64(l.offset), int64(l.size)), l.size)
+}
+
+// SubtreeRoot returns the root of the node at the generalized index of the tree of the value, either
+// the root of a nested value or an inner node of the tree of a container or a sequence. Only the
+// values in the subtree of the node are read and hashed (i.e. the validators of a state for the
+// root of its validators field). The nodes inside the tree of a progressive list are not supported.
+func (l *LazyValue) SubtreeRoot(gindex uint64) ([32]byte, error) {
+	if gindex == 0 {
+		return [32]byte{}, ErrInvalidGindex
+	}
+	depth := uint8(bits.Len64(gindex) - 1)
+	// next returns the next steps of the path from the root to the node
+	next := func(steps uint8) uint64 {
+		depth -= steps
+		return gindex >> depth & (1<<steps - 1)
+	}
+
+	for depth != 0 {
+		s := l.schema
+		if s.IsBasic() {
+			return [32]byte{}, ErrInvalidGindex
+		}
+		if s.Kind == KindUnion {
+			selector, v, err := l.Variant()
+			if err != nil {
+				return [32]byte{}, err
+			}
+			if next(1) == 1 {
+				if depth != 0 {
+					return [32]byte{}, ErrInvalidGindex
+				}
+				return uintLeaf(uint64(selector)), nil
+			}
+			if v == nil {
+				// the None option is a zero leaf
+				if depth != 0 {
+					return [32]byte{}, ErrInvalidGindex
+				}
+				return [32]byte{}, nil
+			}
+			l = v
+			continue
+		}
+		if s.hasMixin() && next(1) == 1 {
+			if depth != 0 {
+				return [32]byte{}, ErrInvalidGindex
+			}
+			size, err := l.Len()
+			if err != nil {
+				return [32]byte{}, err
+			}
+			return uintLeaf(size), nil
+		}
+		if s.Progressive {
+			return [32]byte{}, ErrNotSupported
+		}
+
+		treeDepth := s.depth()
+		if depth < treeDepth {
+			// an inner node of the tree of the contents
+			height := treeDepth - depth
+			return l.contentsRoot(next(depth)<<height, height)
+		}
+		indx := next(treeDepth)
+		if s.hasPackedLeaves() {
+			if depth != 0 {
+				return [32]byte{}, ErrInvalidGindex
+			}
+			return l.contentsRoot(indx, 0)
+		}
+		count, err := l.count()
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if indx >= count {
+			// the padding of the tree is a zero leaf
+			if depth != 0 {
+				return [32]byte{}, ErrInvalidGindex
+			}
+			return [32]byte{}, nil
+		}
+		if l, err = l.child(indx); err != nil {
+			return [32]byte{}, err
+		}
+	}
+	return l.HashTreeRoot()
+}
+
+// contentsRoot returns the root of the subtree with the given height of the tree of the contents whose first leaf is first
+func (l *LazyValue) contentsRoot(first uint64, height uint8) ([32]byte, error) {
+	h := &streamHasher{p: defaultProfile, hash: defaultProfile.newHash()}
+	m := &merkleizer{h: h}
+	if l.schema.hasPackedLeaves() {
+		buf, err := l.packedContents()
+		if err != nil {
+			return [32]byte{}, err
+		}
+		for i := first; (i-first)>>height == 0 && i < (uint64(len(buf))+31)/32; i++ {
+			end := 32 * (i + 1)
+			if end > uint64(len(buf)) {
+				end = uint64(len(buf))
+			}
+			m.add(h.leaf(buf[32*i : end]))
+		}
+		return m.root(height), nil
+	}
+	count, err := l.count()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	for i := first; (i-first)>>height == 0 && i < count; i++ {
+		child, err := l.child(i)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		root, err := child.HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, err
+		}
+		m.add(root)
+	}
+	return m.root(height), nil
+}
+
+// hasPackedLeaves returns true if the leaves of the tree of the contents are packed chunks
+func (s *Schema) hasPackedLeaves() bool {
+	return s.Kind != KindContainer && s.Kind != KindVector && s.Kind != KindList || s.isPacked()
+}
+
+// packedContents returns the bytes packed in the leaves of the value, without the length bit of a bitlist
+func (l *LazyValue) packedContents() ([]byte, error) {
+	buf, err := l.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	if l.schema.Kind == KindBitList {
+		if len(buf) == 0 || buf[len(buf)-1] == 0 {
+			return nil, ErrSize
+		}
+		buf, _ = parseBitlist(nil, buf)
+	}
+	return buf, nil
+}
+
+// count returns the number of fields of a container or of elements of a sequence
+func (l *LazyValue) count() (uint64, error) {
+	if l.schema.Kind == KindContainer {
+		return uint64(len(l.schema.Fields)), nil
+	}
+	return l.Len()
+}
+
+// child returns the field or the element at the index
+func (l *LazyValue) child(indx uint64) (*LazyValue, error) {
+	if l.schema.Kind == KindContainer {
+		return l.Field(l.schema.Fields[indx].Name)
+	}
+	return l.Index(indx)
+}
+
+// uintLeaf returns the leaf of an uint64 (i.e. the length mixed in the root of a list)
+func uintLeaf(i uint64) (res [32]byte) {
+	binary.LittleEndian.PutUint64(res[:], i)
+	return
+}
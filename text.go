@@ -0,0 +1,41 @@
+package ssz
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// MarshalHex returns the 0x prefixed hex encoding of buf. It is used by
+// the generated MarshalText functions of the byte based types.
+func MarshalHex(buf []byte) ([]byte, error) {
+	dst := make([]byte, 2+hex.EncodedLen(len(buf)))
+	copy(dst, "0x")
+	hex.Encode(dst[2:], buf)
+	return dst, nil
+}
+
+// UnmarshalHex decodes a hex text with an optional 0x prefix
+func UnmarshalHex(text []byte) ([]byte, error) {
+	if len(text) >= 2 && text[0] == '0' && (text[1] == 'x' || text[1] == 'X') {
+		text = text[2:]
+	}
+	buf := make([]byte, hex.DecodedLen(len(text)))
+	if _, err := hex.Decode(buf, text); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// UnmarshalFixedHex decodes a hex text with an optional 0x prefix into dst. The
+// decoded text must have the same size as dst.
+func UnmarshalFixedHex(dst []byte, text []byte) error {
+	buf, err := UnmarshalHex(text)
+	if err != nil {
+		return err
+	}
+	if len(buf) != len(dst) {
+		return fmt.Errorf("expected size %d but found %d", len(dst), len(buf))
+	}
+	copy(dst, buf)
+	return nil
+}
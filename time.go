@@ -0,0 +1,78 @@
+package ssz
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// The time helpers encode a time.Time as the uint64 unix timestamp in seconds or milliseconds.
+// The zero time is encoded as 0 and 0 is decoded as the zero time, so that the zero value
+// of a struct is encoded as zeros. The times before the unix epoch cannot be encoded.
+
+// ErrTimeRange is returned when a time is out of the range of the unix timestamps
+var ErrTimeRange = fmt.Errorf("time out of the range of the unix timestamps")
+
+// UnixTime returns the unix timestamp of t in seconds or milliseconds
+func UnixTime(t time.Time, milli bool) (uint64, error) {
+	if t.IsZero() {
+		return 0, nil
+	}
+	sec := t.Unix()
+	if sec < 0 {
+		return 0, ErrTimeRange
+	}
+	if !milli {
+		return uint64(sec), nil
+	}
+	if sec > math.MaxInt64/1000-1 {
+		return 0, ErrTimeRange
+	}
+	return uint64(sec)*1000 + uint64(t.Nanosecond()/1e6), nil
+}
+
+// MarshalTime marshals the unix timestamp of t in seconds or milliseconds to dst
+func MarshalTime(dst []byte, t time.Time, milli bool) ([]byte, error) {
+	i, err := UnixTime(t, milli)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalUint64(dst, i), nil
+}
+
+// UnmarshalTime unmarshals a time from the unix timestamp in seconds or milliseconds of the src
+// input. It fails if the timestamp does not fit in the int64 of the time package.
+func UnmarshalTime(src []byte, milli bool) (time.Time, error) {
+	i := UnmarshallUint64(src)
+	if i == 0 {
+		return time.Time{}, nil
+	}
+	if i > math.MaxInt64 {
+		return time.Time{}, ErrTimeRange
+	}
+	if milli {
+		return time.Unix(int64(i/1000), int64(i%1000)*1e6).UTC(), nil
+	}
+	return time.Unix(int64(i), 0).UTC(), nil
+}
+
+// PutTime appends the chunk of the unix timestamp of t in seconds or milliseconds
+func (h *Hasher) PutTime(t time.Time, milli bool) error {
+	i, err := UnixTime(t, milli)
+	if err != nil {
+		return err
+	}
+	h.PutUint64(i)
+	return nil
+}
+
+// RandomTime returns a random time after the unix epoch with a precision of
+// seconds or milliseconds, so that it is not changed by the encoding.
+func RandomTime(rng *rand.Rand, milli bool) time.Time {
+	sec := 1 + rng.Int63n(1<<34)
+	if milli {
+		return time.Unix(sec, int64(rng.Intn(1000))*1e6).UTC()
+	}
+	return time.Unix(sec, 0).UTC()
+}
@@ -0,0 +1,168 @@
+package ssz
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// DecodeStep is a step of the decoding of an encoding with its schema
+type DecodeStep struct {
+	// Path of the value (i.e. body.attestations[3].signature), empty for the object
+	Path string
+	// Type is the SSZ type of the value or 'offset' for the offset of a variable field
+	Type string
+	// Start and End are the byte range of the value in the input
+	Start, End uint64
+	// Offset is the value of an offset
+	Offset uint64
+	// Err is the error of the step in which the decoding fails
+	Err error
+}
+
+// TraceDecode decodes the encoding with the schema and returns the steps leading up to the
+// first error: the fields and the offsets of the containers on the path to the value that
+// fails and that value. For the valid encodings, it returns the steps of the fields of the object.
+func TraceDecode(s *Schema, buf []byte) ([]DecodeStep, error) {
+	return traceValue(s, buf, 0, "")
+}
+
+// decodeTracer is called with the input of the unmarshal failures of the types with a schema
+var decodeTracer atomic.Value
+
+// TraceUnmarshal unmarshals buf into obj. If it fails and the tracing is enabled
+// (i.e. with SetTraceLogger), the steps of the decoding of buf are traced.
+func TraceUnmarshal(obj Unmarshaler, buf []byte) error {
+	err := obj.UnmarshalSSZ(buf)
+	if err == nil {
+		return nil
+	}
+	if tracer, ok := decodeTracer.Load().(func(*Schema, []byte, error)); ok && tracer != nil {
+		if s, ok := obj.(SchemaProvider); ok {
+			tracer(s.SchemaSSZ(), buf, err)
+		}
+	}
+	return err
+}
+
+func tracePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// traceValue returns the step of the value, which is followed by the steps of its parts if it fails
+func traceValue(s *Schema, buf []byte, start uint64, path string) ([]DecodeStep, error) {
+	size := uint64(len(buf))
+	step := DecodeStep{Path: path, Type: s.String(), Start: start, End: start + size}
+	// the steps of the parts end with the step that fails
+	fail := func(steps []DecodeStep, err error) ([]DecodeStep, error) {
+		if len(steps) == 0 {
+			step.Err = err
+			return []DecodeStep{step}, err
+		}
+		return append([]DecodeStep{step}, steps...), err
+	}
+
+	switch s.Kind {
+	case KindContainer:
+		steps, err := traceFields(s, buf, start, path)
+		if err != nil {
+			return fail(steps, err)
+		}
+		if path == "" {
+			return steps, nil
+		}
+		return []DecodeStep{step}, nil
+
+	case KindVector, KindList:
+		if s.Elem.IsBasic() {
+			break
+		}
+		parts, err := s.splitElems(buf)
+		if err != nil {
+			return fail(nil, err)
+		}
+		for indx, part := range parts {
+			// the parts are slices of the input
+			elemStart := start + uint64(cap(buf)-cap(part))
+			steps, err := traceValue(s.Elem, part, elemStart, fmt.Sprintf("%s[%d]", path, indx))
+			if err != nil {
+				return fail(steps, err)
+			}
+		}
+		return []DecodeStep{step}, nil
+
+	case KindUnion:
+		if size == 0 {
+			return fail(nil, ErrSize)
+		}
+		opt, err := s.option(uint64(buf[0]))
+		if err != nil {
+			return fail([]DecodeStep{{Path: tracePath(path, "selector"), Type: "uint8", Start: start, End: start + 1, Err: err}}, err)
+		}
+		if opt == nil {
+			if size != 1 {
+				return fail(nil, ErrSize)
+			}
+			return []DecodeStep{step}, nil
+		}
+		steps, err := traceValue(opt, buf[1:], start+1, tracePath(path, "value"))
+		if err != nil {
+			return fail(steps, err)
+		}
+		return []DecodeStep{step}, nil
+	}
+
+	if _, err := s.nodeFromSSZ(buf); err != nil {
+		return fail(nil, err)
+	}
+	return []DecodeStep{step}, nil
+}
+
+// traceFields returns the steps of the fields and the offsets of a container up to the one that fails
+func traceFields(s *Schema, buf []byte, start uint64, path string) ([]DecodeStep, error) {
+	size := uint64(len(buf))
+	fixed := uint64(0)
+	for _, f := range s.Fields {
+		fixed += f.Schema.FixedSize()
+	}
+	if size < fixed || (s.IsFixed() && size != fixed) {
+		return nil, ErrSize
+	}
+
+	steps := []DecodeStep{}
+	dynamic, offsets := []int{}, []uint64{}
+	pos := uint64(0)
+	for i, f := range s.Fields {
+		fieldPath := tracePath(path, f.Name)
+		if f.Schema.IsFixed() {
+			fieldSteps, err := traceValue(f.Schema, buf[pos:pos+f.Schema.FixedSize()], start+pos, fieldPath)
+			if err != nil {
+				return append(steps, fieldSteps...), err
+			}
+			steps = append(steps, fieldSteps...)
+			pos += f.Schema.FixedSize()
+			continue
+		}
+		offset := ReadOffset(buf[pos : pos+bytesPerLengthOffset])
+		step := DecodeStep{Path: fieldPath, Type: "offset", Start: start + pos, End: start + pos + bytesPerLengthOffset, Offset: offset}
+		// the first offset is the end of the fixed part and the others are in order
+		if offset > size || (len(offsets) == 0 && offset != fixed) || (len(offsets) != 0 && offset < offsets[len(offsets)-1]) {
+			step.Err = ErrOffset
+			return append(steps, step), ErrOffset
+		}
+		steps = append(steps, step)
+		dynamic, offsets = append(dynamic, i), append(offsets, offset)
+		pos += bytesPerLengthOffset
+	}
+	offsets = append(offsets, size)
+	for j, i := range dynamic {
+		fieldSteps, err := traceValue(s.Fields[i].Schema, buf[offsets[j]:offsets[j+1]], start+offsets[j], tracePath(path, s.Fields[i].Name))
+		if err != nil {
+			return append(steps, fieldSteps...), err
+		}
+		steps = append(steps, fieldSteps...)
+	}
+	return steps, nil
+}
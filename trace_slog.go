@@ -0,0 +1,45 @@
+//go:build go1.21
+// +build go1.21
+
+package ssz
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SetTraceLogger enables the tracing of the unmarshal failures in TraceUnmarshal and the
+// helpers that decode the inputs (i.e. UnmarshalFromReader), which log the steps of the
+// decoding with the logger at the debug level. A nil logger disables the tracing.
+func SetTraceLogger(logger *slog.Logger) {
+	if logger == nil {
+		decodeTracer.Store(func(*Schema, []byte, error) {})
+		return
+	}
+	decodeTracer.Store(func(s *Schema, buf []byte, err error) {
+		LogDecode(context.Background(), logger, s, buf)
+	})
+}
+
+// LogDecode decodes the encoding with the schema and logs the steps leading up to the first error (TraceDecode)
+func LogDecode(ctx context.Context, logger *slog.Logger, s *Schema, buf []byte) error {
+	steps, err := TraceDecode(s, buf)
+	for _, step := range steps {
+		attrs := []slog.Attr{
+			slog.String("type", step.Type),
+			slog.Uint64("start", step.Start),
+			slog.Uint64("end", step.End),
+		}
+		if step.Path != "" {
+			attrs = append([]slog.Attr{slog.String("field", step.Path)}, attrs...)
+		}
+		if step.Type == "offset" {
+			attrs = append(attrs, slog.Uint64("offset", step.Offset))
+		}
+		if step.Err != nil {
+			attrs = append(attrs, slog.String("err", step.Err.Error()))
+		}
+		logger.LogAttrs(ctx, slog.LevelDebug, "ssz decode "+s.Name, attrs...)
+	}
+	return err
+}
@@ -0,0 +1,310 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+var (
+	// ErrInvalidGindex is returned when a generalized index does not point to a node of the tree
+	ErrInvalidGindex = fmt.Errorf("invalid generalized index")
+	// ErrGindexOverflow is returned when a generalized index does not fit in 64 bits
+	ErrGindexOverflow = fmt.Errorf("generalized index overflows 64 bits")
+)
+
+// Node is a node of a binary Merkle tree, either a leaf with a 32 bytes chunk or a branch
+// with two children. The nodes are immutable, the functions that modify a tree return a new
+// root that shares all the nodes that did not change with the previous one. The roots are
+// cached once computed, a tree must not be hashed concurrently for the first time. In a
+// partial tree, a leaf may also stand for a subtree that is not known but for its root.
+type Node struct {
+	left, right *Node
+	value       []byte
+	hash        []byte
+	// lazy is set for the branches of a stored tree whose children have not been read yet
+	lazy *lazyNode
+}
+
+// zeroNodes are the trees of depth i with all the leaves set to zero
+var zeroNodes [65]*Node
+
+func init() {
+	zeroNodes[0] = LeafNode(nil)
+	for i := 1; i < len(zeroNodes); i++ {
+		zeroNodes[i] = BranchNode(zeroNodes[i-1], zeroNodes[i-1])
+	}
+}
+
+// LeafNode returns a leaf with the value padded with zeros to 32 bytes
+func LeafNode(value []byte) *Node {
+	if len(value) > 32 {
+		panic(fmt.Sprintf("BUG: leaf of %d bytes", len(value)))
+	}
+	leaf := make([]byte, 32)
+	copy(leaf, value)
+	return &Node{value: leaf}
+}
+
+// BranchNode returns a branch with the two children
+func BranchNode(left, right *Node) *Node {
+	return &Node{left: left, right: right}
+}
+
+// ZeroNode returns a tree of the given depth with all the leaves set to zero
+func ZeroNode(depth uint8) *Node {
+	return zeroNodes[depth]
+}
+
+// zeroDepth returns the depth of the node if it is one of the trees with all the leaves set to zero
+func zeroDepth(n *Node) (uint8, bool) {
+	for i, zero := range zeroNodes {
+		if n == zero {
+			return uint8(i), true
+		}
+	}
+	return 0, false
+}
+
+// IsLeaf returns true if the node has no children
+func (n *Node) IsLeaf() bool {
+	return n.value != nil
+}
+
+// Left returns the left child of a branch. It panics if the child of a stored tree cannot be read.
+func (n *Node) Left() *Node {
+	left, _, err := n.children()
+	if err != nil {
+		panic(err)
+	}
+	return left
+}
+
+// Right returns the right child of a branch. It panics if the child of a stored tree cannot be read.
+func (n *Node) Right() *Node {
+	_, right, err := n.children()
+	if err != nil {
+		panic(err)
+	}
+	return right
+}
+
+// children returns the children of a branch, which are read first for the lazy nodes
+func (n *Node) children() (*Node, *Node, error) {
+	if n.lazy != nil {
+		left, right, err := n.lazy.load()
+		if err != nil {
+			return nil, nil, err
+		}
+		n.left, n.right, n.lazy = left, right, nil
+	}
+	return n.left, n.right, nil
+}
+
+// Value returns the 32 bytes of a leaf
+func (n *Node) Value() []byte {
+	return n.value
+}
+
+// Root returns the hash tree root of the node
+func (n *Node) Root() (res [32]byte) {
+	if n.IsLeaf() {
+		copy(res[:], n.value)
+		return
+	}
+	if n.hash == nil {
+		l, r := n.left.Root(), n.right.Root()
+		h := newHash()
+		h.Write(l[:])
+		h.Write(r[:])
+		n.hash = h.Sum(nil)
+	}
+	copy(res[:], n.hash)
+	return
+}
+
+// Get returns the node at the generalized index, the root is the index 1
+// and the children of the index i are the indexes 2*i and 2*i+1.
+func (n *Node) Get(gindex uint64) (*Node, error) {
+	if gindex == 0 {
+		return nil, ErrInvalidGindex
+	}
+	depth := uint(bits.Len64(gindex) - 1)
+	node := n
+	for i := depth; i > 0; i-- {
+		if node.IsLeaf() {
+			return nil, ErrInvalidGindex
+		}
+		left, right, err := node.children()
+		if err != nil {
+			return nil, err
+		}
+		if gindex>>(i-1)&1 == 1 {
+			node = right
+		} else {
+			node = left
+		}
+	}
+	return node, nil
+}
+
+// Set returns the root of a new tree with the node at the generalized index replaced
+func (n *Node) Set(gindex uint64, node *Node) (*Node, error) {
+	return n.set(gindex, node, false)
+}
+
+// set replaces the node at the generalized index. With expand, the leaves on the path
+// are replaced with branches of zero chunks, which is only valid if all their leaves are set later.
+func (n *Node) set(gindex uint64, node *Node, expand bool) (*Node, error) {
+	if gindex == 0 {
+		return nil, ErrInvalidGindex
+	}
+	depth := uint(bits.Len64(gindex) - 1)
+
+	// the siblings of the nodes on the path from the root to the node
+	siblings := make([]*Node, depth)
+	cur := n
+	for i := uint(0); i < depth; i++ {
+		if cur.IsLeaf() {
+			if !expand {
+				return nil, ErrInvalidGindex
+			}
+			cur = BranchNode(zeroNodes[0], zeroNodes[0])
+		}
+		left, right, err := cur.children()
+		if err != nil {
+			return nil, err
+		}
+		if gindex>>(depth-1-i)&1 == 1 {
+			cur, siblings[i] = right, left
+		} else {
+			cur, siblings[i] = left, right
+		}
+	}
+	for i := depth; i > 0; i-- {
+		if gindex>>(depth-i)&1 == 1 {
+			node = BranchNode(siblings[i-1], node)
+		} else {
+			node = BranchNode(node, siblings[i-1])
+		}
+	}
+	return node, nil
+}
+
+// childGindex returns the generalized index of the i node at the given depth below gindex
+func childGindex(gindex uint64, depth uint8, i uint64) (uint64, error) {
+	if bits.Len64(gindex)+int(depth) > 64 {
+		return 0, ErrGindexOverflow
+	}
+	return gindex<<depth | i, nil
+}
+
+// nodesAt returns the first count nodes at the given depth below the node
+func (n *Node) nodesAt(depth uint8, count uint64) ([]*Node, error) {
+	res := make([]*Node, 0, count)
+	var walk func(node *Node, depth uint8) error
+	walk = func(node *Node, depth uint8) error {
+		if uint64(len(res)) == count {
+			return nil
+		}
+		if depth == 0 {
+			res = append(res, node)
+			return nil
+		}
+		if node.IsLeaf() {
+			return ErrInvalidGindex
+		}
+		left, right, err := node.children()
+		if err != nil {
+			return err
+		}
+		if err := walk(left, depth-1); err != nil {
+			return err
+		}
+		return walk(right, depth-1)
+	}
+	if err := walk(n, depth); err != nil {
+		return nil, err
+	}
+	if uint64(len(res)) != count {
+		return nil, ErrInvalidGindex
+	}
+	return res, nil
+}
+
+// chunkNodes splits the bytes in leaves of 32 bytes, the last one is padded with zeros
+func chunkNodes(buf []byte) []*Node {
+	nodes := make([]*Node, 0, (len(buf)+31)/32)
+	for i := 0; i < len(buf); i += 32 {
+		end := i + 32
+		if end > len(buf) {
+			end = len(buf)
+		}
+		nodes = append(nodes, LeafNode(buf[i:end]))
+	}
+	return nodes
+}
+
+// treeFromNodes returns the tree of the given depth with the nodes as the first
+// leaves. The rest of the leaves are zero.
+func treeFromNodes(nodes []*Node, depth uint8) *Node {
+	if len(nodes) == 0 {
+		return zeroNodes[depth]
+	}
+	if uint64(len(nodes)) > 1<<depth {
+		panic(fmt.Sprintf("BUG: %d nodes in a tree of depth %d", len(nodes), depth))
+	}
+	for i := uint8(0); i < depth; i++ {
+		layer := make([]*Node, 0, (len(nodes)+1)/2)
+		for j := 0; j < len(nodes); j += 2 {
+			if j+1 < len(nodes) {
+				layer = append(layer, BranchNode(nodes[j], nodes[j+1]))
+			} else {
+				layer = append(layer, BranchNode(nodes[j], zeroNodes[i]))
+			}
+		}
+		nodes = layer
+	}
+	return nodes[0]
+}
+
+// mixinNode returns the node of a list with the tree of its contents and its length
+func mixinNode(contents *Node, length uint64) *Node {
+	return BranchNode(contents, lengthNode(length))
+}
+
+func lengthNode(length uint64) *Node {
+	leaf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(leaf, length)
+	return &Node{value: leaf}
+}
+
+// mixinLength returns the length mixed in the node of a list
+func mixinLength(n *Node) (uint64, error) {
+	if n.IsLeaf() {
+		return 0, ErrInvalidGindex
+	}
+	_, right, err := n.children()
+	if err != nil {
+		return 0, err
+	}
+	if !right.IsLeaf() {
+		return 0, ErrInvalidGindex
+	}
+	return binary.LittleEndian.Uint64(right.value), nil
+}
+
+// appendChunks appends the first size bytes of the leaves
+func appendChunks(dst []byte, nodes []*Node, size uint64) ([]byte, error) {
+	for _, node := range nodes {
+		if !node.IsLeaf() {
+			return nil, ErrInvalidGindex
+		}
+		if size < 32 {
+			return append(dst, node.value[:size]...), nil
+		}
+		dst = append(dst, node.value...)
+		size -= 32
+	}
+	return dst, nil
+}
@@ -0,0 +1,48 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"math/rand"
+)
+
+// The uint256 helpers take the four little endian uint64 words of the value, which is the
+// representation of github.com/holiman/uint256.Int, so that a *uint256.Int is passed
+// as (*[4]uint64)(x) without this package depending on it. A nil value is zero.
+
+// MarshalUint256 marshals a little endian uint256 to dst
+func MarshalUint256(dst []byte, i *[4]uint64) []byte {
+	buf := make([]byte, 32)
+	if i != nil {
+		for j, word := range i {
+			binary.LittleEndian.PutUint64(buf[8*j:], word)
+		}
+	}
+	return append(dst, buf...)
+}
+
+// UnmarshalUint256 unmarshals a little endian uint256 from the src input
+func UnmarshalUint256(dst *[4]uint64, src []byte) {
+	for j := range dst {
+		dst[j] = binary.LittleEndian.Uint64(src[8*j:])
+	}
+}
+
+// PutUint256 appends an uint256 chunk
+func (h *Hasher) PutUint256(i *[4]uint64) {
+	h.buf = MarshalUint256(h.buf, i)
+}
+
+// AppendUint256 appends an uint256, which fills a whole chunk
+func (h *Hasher) AppendUint256(i *[4]uint64) {
+	h.buf = MarshalUint256(h.buf, i)
+}
+
+// RandomUint256 sets dst to a random uint256. Some of the values only use the lowest word.
+func RandomUint256(rng *rand.Rand, dst *[4]uint64) {
+	*dst = [4]uint64{rng.Uint64()}
+	if rng.Intn(2) == 0 {
+		for j := 1; j < 4; j++ {
+			dst[j] = rng.Uint64()
+		}
+	}
+}
@@ -0,0 +1,429 @@
+package ssz
+
+import (
+	"fmt"
+)
+
+// Value is a value of a schema built at runtime, which is marshaled, unmarshaled and
+// hashed without a generated Go struct. It implements the Marshaler, Unmarshaler and
+// HashRoot interfaces, then it can be used with the same helpers as the generated types.
+type Value struct {
+	Schema *Schema
+	// Uint and Bool are the values of the basic types
+	Uint uint64
+	Bool bool
+	// Bytes are the contents of a byte vector or a byte list, the SSZ encoding
+	// of a bitvector or a bitlist and the little endian encoding of the uints
+	// larger than 8 bytes (i.e. uint256)
+	Bytes []byte
+	// Elems are the elements of a vector or a list and the fields of a container.
+	// For an union, it is the value of the selected option unless it is None.
+	Elems []*Value
+	// Selector is the selected option of an union
+	Selector uint8
+}
+
+// NewValue returns the default value of the schema
+func NewValue(s *Schema) *Value {
+	v := &Value{Schema: s}
+	switch s.Kind {
+	case KindUint:
+		if s.Size > 8 {
+			v.Bytes = make([]byte, s.Size)
+		}
+	case KindByteVector:
+		v.Bytes = make([]byte, s.Size)
+	case KindBitVector:
+		v.Bytes = make([]byte, s.FixedSize())
+	case KindBitList:
+		v.Bytes = []byte{1}
+	case KindVector:
+		v.Elems = make([]*Value, s.Size)
+		for i := range v.Elems {
+			v.Elems[i] = NewValue(s.Elem)
+		}
+	case KindContainer:
+		v.Elems = make([]*Value, len(s.Fields))
+		for i, f := range s.Fields {
+			v.Elems[i] = NewValue(f.Schema)
+		}
+	case KindUnion:
+		if len(s.Options) != 0 && s.Options[0] != nil {
+			v.Elems = []*Value{NewValue(s.Options[0])}
+		}
+	}
+	return v
+}
+
+// UnmarshalValue decodes the SSZ encoding of a value of the schema
+func UnmarshalValue(s *Schema, buf []byte) (*Value, error) {
+	v := &Value{Schema: s}
+	if err := v.UnmarshalSSZ(buf); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Field returns the value of a field of a container
+func (v *Value) Field(name string) (*Value, error) {
+	if v.Schema.Kind != KindContainer {
+		return nil, fmt.Errorf("field %s of a %s", name, v.Schema.Kind)
+	}
+	indx, ok := v.Schema.FieldIndex(name)
+	if !ok {
+		return nil, fmt.Errorf("field %s not found in %s", name, v.Schema.Name)
+	}
+	if indx >= len(v.Elems) {
+		return nil, fmt.Errorf("field %s not set", name)
+	}
+	return v.Elems[indx], nil
+}
+
+// Variant returns the selector of an union and the value of the selected option, which is nil for None
+func (v *Value) Variant() (uint8, *Value, error) {
+	if v.Schema.Kind != KindUnion {
+		return 0, nil, fmt.Errorf("variant of a %s", v.Schema.Kind)
+	}
+	if err := v.validate(); err != nil {
+		return 0, nil, err
+	}
+	if len(v.Elems) == 0 {
+		return v.Selector, nil, nil
+	}
+	return v.Selector, v.Elems[0], nil
+}
+
+// Append adds an element with the default value at the end of a list and returns it
+func (v *Value) Append() (*Value, error) {
+	if v.Schema.Kind != KindList {
+		return nil, fmt.Errorf("append to a %s", v.Schema.Kind)
+	}
+	if uint64(len(v.Elems)) >= v.Schema.limit() {
+		return nil, ErrListTooBig
+	}
+	elem := NewValue(v.Schema.Elem)
+	v.Elems = append(v.Elems, elem)
+	return elem, nil
+}
+
+// validate checks the sizes of the value against the schema
+func (v *Value) validate() error {
+	s := v.Schema
+	switch s.Kind {
+	case KindUint:
+		if s.Size > 8 && uint64(len(v.Bytes)) != s.Size {
+			return ErrBytesLength
+		}
+		if s.Size < 8 && v.Uint>>(8*s.Size) != 0 {
+			return ErrUintOverflow
+		}
+	case KindByteVector:
+		if uint64(len(v.Bytes)) != s.Size {
+			return ErrBytesLength
+		}
+	case KindByteList:
+		if uint64(len(v.Bytes)) > s.Max {
+			return ErrListTooBig
+		}
+	case KindBitVector:
+		return ValidateBitvector(v.Bytes, s.Size)
+	case KindBitList:
+		return ValidateBitlist(v.Bytes, s.Max)
+	case KindVector:
+		if uint64(len(v.Elems)) != s.Size {
+			return ErrVectorLength
+		}
+	case KindList:
+		if uint64(len(v.Elems)) > s.limit() {
+			return ErrListTooBig
+		}
+	case KindContainer:
+		if len(v.Elems) != len(s.Fields) {
+			return fmt.Errorf("expected %d fields in %s but found %d", len(s.Fields), s.Name, len(v.Elems))
+		}
+	case KindUnion:
+		opt, err := s.option(uint64(v.Selector))
+		if err != nil {
+			return err
+		}
+		if opt == nil {
+			if len(v.Elems) != 0 {
+				return fmt.Errorf("value of the None option")
+			}
+			return nil
+		}
+		if len(v.Elems) != 1 || v.Elems[0] == nil {
+			return fmt.Errorf("expected the value of the option %d", v.Selector)
+		}
+		if v.Elems[0].Schema != opt && !v.Elems[0].Schema.Equal(opt) {
+			return fmt.Errorf("value of the option %d with another schema", v.Selector)
+		}
+		return nil
+	}
+	for i, elem := range v.Elems {
+		if elem == nil {
+			return fmt.Errorf("nil element of a %s", s.Kind)
+		}
+		expected := s.Elem
+		if s.Kind == KindContainer {
+			expected = s.Fields[i].Schema
+		}
+		if elem.Schema != expected && !elem.Schema.Equal(expected) {
+			return fmt.Errorf("element of a %s with another schema", s.Kind)
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the size of the SSZ encoding
+func (v *Value) SizeSSZ() int {
+	switch v.Schema.Kind {
+	case KindUint, KindBool:
+		return int(v.Schema.Size)
+	case KindByteVector, KindByteList, KindBitVector, KindBitList:
+		return len(v.Bytes)
+	case KindUnion:
+		size := 1
+		for _, elem := range v.Elems {
+			size += elem.SizeSSZ()
+		}
+		return size
+	default:
+		size := 0
+		for _, elem := range v.Elems {
+			size += elem.SizeSSZ()
+			if !elem.Schema.IsFixed() {
+				size += bytesPerLengthOffset
+			}
+		}
+		return size
+	}
+}
+
+// MarshalSSZ returns the SSZ encoding
+func (v *Value) MarshalSSZ() ([]byte, error) {
+	return v.MarshalSSZTo(make([]byte, 0, v.SizeSSZ()))
+}
+
+// MarshalSSZTo appends the SSZ encoding to dst
+func (v *Value) MarshalSSZTo(dst []byte) ([]byte, error) {
+	if err := v.validate(); err != nil {
+		return nil, err
+	}
+	switch v.Schema.Kind {
+	case KindUint:
+		if v.Schema.Size > 8 {
+			return append(dst, v.Bytes...), nil
+		}
+		buf := MarshalUint64(nil, v.Uint)
+		return append(dst, buf[:v.Schema.Size]...), nil
+
+	case KindBool:
+		return MarshalBool(dst, v.Bool), nil
+
+	case KindByteVector, KindByteList, KindBitVector, KindBitList:
+		return append(dst, v.Bytes...), nil
+
+	case KindUnion:
+		dst = append(dst, v.Selector)
+		if len(v.Elems) == 0 {
+			return dst, nil
+		}
+		return v.Elems[0].MarshalSSZTo(dst)
+
+	default:
+		offset := 0
+		for _, elem := range v.Elems {
+			offset += int(elem.Schema.FixedSize())
+		}
+		var err error
+		var tail []byte
+		for _, elem := range v.Elems {
+			if elem.Schema.IsFixed() {
+				if dst, err = elem.MarshalSSZTo(dst); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if dst, err = SafeWriteOffset(dst, offset+len(tail)); err != nil {
+				return nil, err
+			}
+			if tail, err = elem.MarshalSSZTo(tail); err != nil {
+				return nil, err
+			}
+		}
+		return append(dst, tail...), nil
+	}
+}
+
+// UnmarshalSSZ decodes the SSZ encoding into the value, which must have the schema set
+func (v *Value) UnmarshalSSZ(buf []byte) error {
+	s := v.Schema
+	if s == nil {
+		return fmt.Errorf("unmarshal of a value without schema")
+	}
+	size := uint64(len(buf))
+	v.Uint, v.Bool, v.Bytes, v.Elems, v.Selector = 0, false, nil, nil, 0
+
+	switch s.Kind {
+	case KindUint:
+		if size != s.Size {
+			return ErrSize
+		}
+		if s.Size > 8 {
+			v.Bytes = append([]byte{}, buf...)
+			return nil
+		}
+		tmp := make([]byte, 8)
+		copy(tmp, buf)
+		v.Uint = UnmarshallUint64(tmp)
+
+	case KindBool:
+		if size != 1 {
+			return ErrSize
+		}
+		if buf[0] > 1 {
+			return ErrInvalidBool
+		}
+		v.Bool = buf[0] == 1
+
+	case KindByteVector, KindByteList, KindBitVector, KindBitList:
+		v.Bytes = append([]byte{}, buf...)
+		return v.validate()
+
+	case KindVector, KindList:
+		parts, err := s.splitElems(buf)
+		if err != nil {
+			return err
+		}
+		v.Elems = make([]*Value, len(parts))
+		for i, part := range parts {
+			if v.Elems[i], err = UnmarshalValue(s.Elem, part); err != nil {
+				return err
+			}
+		}
+
+	case KindContainer:
+		parts, err := s.splitFields(buf)
+		if err != nil {
+			return err
+		}
+		v.Elems = make([]*Value, len(parts))
+		for i, part := range parts {
+			if v.Elems[i], err = UnmarshalValue(s.Fields[i].Schema, part); err != nil {
+				return err
+			}
+		}
+
+	case KindUnion:
+		if size == 0 {
+			return ErrSize
+		}
+		opt, err := s.option(uint64(buf[0]))
+		if err != nil {
+			return err
+		}
+		v.Selector = buf[0]
+		if opt == nil {
+			if size != 1 {
+				return ErrSize
+			}
+			return nil
+		}
+		elem, err := UnmarshalValue(opt, buf[1:])
+		if err != nil {
+			return err
+		}
+		v.Elems = []*Value{elem}
+	}
+	return nil
+}
+
+// HashTreeRoot returns the root of the value
+func (v *Value) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith appends the root of the value to the Hasher
+func (v *Value) HashTreeRootWith(hh *Hasher) error {
+	if err := v.validate(); err != nil {
+		return err
+	}
+	s := v.Schema
+	switch s.Kind {
+	case KindUint:
+		switch s.Size {
+		case 1:
+			hh.PutUint8(uint8(v.Uint))
+		case 2:
+			hh.PutUint16(uint16(v.Uint))
+		case 4:
+			hh.PutUint32(uint32(v.Uint))
+		case 8:
+			hh.PutUint64(v.Uint)
+		default:
+			hh.PutBytes(v.Bytes)
+		}
+
+	case KindBool:
+		hh.PutBool(v.Bool)
+
+	case KindByteVector, KindBitVector:
+		hh.PutBytes(v.Bytes)
+
+	case KindByteList:
+		indx := hh.Index()
+		hh.Append(v.Bytes)
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(indx, uint64(len(v.Bytes)), s.ChunkCount())
+
+	case KindBitList:
+		if s.Progressive {
+			hh.PutProgressiveBitlist(v.Bytes)
+		} else {
+			hh.PutBitlist(v.Bytes, s.Max)
+		}
+
+	case KindUnion:
+		// the root of the value mixed in with the selector
+		indx := hh.Index()
+		if len(v.Elems) == 0 {
+			hh.PutBytes(zeroBytes)
+		} else if err := v.Elems[0].HashTreeRootWith(hh); err != nil {
+			return err
+		}
+		hh.MerkleizeWithMixin(indx, uint64(v.Selector), 0)
+
+	default:
+		indx := hh.Index()
+		if s.isPacked() {
+			for _, elem := range v.Elems {
+				if err := elem.validate(); err != nil {
+					return err
+				}
+				if elem.Schema.Kind == KindBool {
+					hh.Append(MarshalBool(nil, elem.Bool))
+				} else if elem.Schema.Size > 8 {
+					hh.Append(elem.Bytes)
+				} else {
+					hh.Append(MarshalUint64(nil, elem.Uint)[:elem.Schema.Size])
+				}
+			}
+			hh.FillUpTo32()
+		} else {
+			for _, elem := range v.Elems {
+				if err := elem.HashTreeRootWith(hh); err != nil {
+					return err
+				}
+			}
+		}
+		if s.Progressive {
+			hh.MerkleizeProgressiveWithMixin(indx, uint64(len(v.Elems)))
+		} else if s.Kind == KindList {
+			hh.MerkleizeWithMixin(indx, uint64(len(v.Elems)), s.ChunkCount())
+		} else {
+			hh.Merkleize(indx)
+		}
+	}
+	return nil
+}
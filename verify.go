@@ -0,0 +1,26 @@
+package ssz
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ErrNonCanonical is returned when the input decodes correctly but it is not the canonical encoding of the object
+var ErrNonCanonical = fmt.Errorf("non canonical encoding")
+
+// UnmarshalVerify unmarshals buf into obj and checks that buf is the canonical encoding
+// of the decoded object by marshaling it again. obj should be a new object since the
+// unmarshal functions append to the existing slices.
+func UnmarshalVerify(obj MarshalUnmarshaler, buf []byte) error {
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return err
+	}
+	dst, err := obj.MarshalSSZTo(make([]byte, 0, len(buf)))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(dst, buf) {
+		return ErrNonCanonical
+	}
+	return nil
+}
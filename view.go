@@ -0,0 +1,800 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+var (
+	// ErrSize is returned when the input does not have the size of the type
+	ErrSize = fmt.Errorf("incorrect size")
+	// ErrInvalidBool is returned when a boolean is not encoded as 0 or 1
+	ErrInvalidBool = fmt.Errorf("incorrect bool")
+	// ErrIndexOutOfRange is returned when an element after the end of a vector or a list is accessed
+	ErrIndexOutOfRange = fmt.Errorf("index out of range")
+	// ErrUintOverflow is returned when a value does not fit in the size of an uint
+	ErrUintOverflow = fmt.Errorf("value overflows the uint")
+)
+
+// View is a typed accessor to a value stored in a backing Merkle tree. The views of the
+// fields and elements of a value share its tree, any change to them changes the root of the
+// value. Since the nodes are immutable, the copies of a view share the nodes that did not change.
+type View struct {
+	schema *Schema
+	tree   *backing
+	// generalized index of the node of the value in the tree
+	gindex uint64
+	// packed is set for the uint and bool elements of the vectors and lists,
+	// which are stored in the chunk of the node at 'offset'
+	packed bool
+	offset uint64
+}
+
+type backing struct {
+	root *Node
+}
+
+// NewView returns a view of the default value of the schema
+func NewView(s *Schema) *View {
+	return NewViewFromNode(s, s.defaultNode())
+}
+
+// NewViewFromNode returns a view of the value with the schema stored in the tree
+func NewViewFromNode(s *Schema, node *Node) *View {
+	return &View{schema: s, tree: &backing{root: node}, gindex: 1}
+}
+
+// NewViewFromSSZ decodes the SSZ encoding of a value with the schema into a tree
+func NewViewFromSSZ(s *Schema, buf []byte) (*View, error) {
+	node, err := s.nodeFromSSZ(buf)
+	if err != nil {
+		return nil, err
+	}
+	return NewViewFromNode(s, node), nil
+}
+
+// Schema returns the schema of the value
+func (v *View) Schema() *Schema {
+	return v.schema
+}
+
+// Node returns the tree of the value. For the packed uint and bool
+// elements, it is the chunk that includes the value.
+func (v *View) Node() (*Node, error) {
+	return v.tree.root.Get(v.gindex)
+}
+
+// Gindex returns the generalized index of the node of the value in the tree of the view it comes from
+func (v *View) Gindex() uint64 {
+	return v.gindex
+}
+
+func (v *View) setNode(node *Node) error {
+	root, err := v.tree.root.Set(v.gindex, node)
+	if err != nil {
+		return err
+	}
+	v.tree.root = root
+	return nil
+}
+
+// Copy returns a view of the value with its own tree. The changes to the
+// copy do not change the original value and the other way around.
+func (v *View) Copy() (*View, error) {
+	node, err := v.Node()
+	if err != nil {
+		return nil, err
+	}
+	if v.packed {
+		node = LeafNode(node.value[v.offset : v.offset+v.schema.Size])
+	}
+	return NewViewFromNode(v.schema, node), nil
+}
+
+// HashTreeRoot returns the root of the value
+func (v *View) HashTreeRoot() ([32]byte, error) {
+	node, err := v.Node()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if v.packed {
+		return LeafNode(node.value[v.offset : v.offset+v.schema.Size]).Root(), nil
+	}
+	return node.Root(), nil
+}
+
+// MarshalSSZ returns the SSZ encoding of the value
+func (v *View) MarshalSSZ() ([]byte, error) {
+	node, err := v.Node()
+	if err != nil {
+		return nil, err
+	}
+	if v.packed {
+		return append([]byte{}, node.value[v.offset:v.offset+v.schema.Size]...), nil
+	}
+	return v.schema.marshalNode(nil, node)
+}
+
+// Field returns the view of a field of a container
+func (v *View) Field(name string) (*View, error) {
+	if v.schema.Kind != KindContainer {
+		return nil, fmt.Errorf("field %s of a %s", name, v.schema.Kind)
+	}
+	indx, ok := v.schema.FieldIndex(name)
+	if !ok {
+		return nil, fmt.Errorf("field %s not found in %s", name, v.schema.Name)
+	}
+	gindex, err := childGindex(v.gindex, v.schema.depth(), uint64(indx))
+	if err != nil {
+		return nil, err
+	}
+	return &View{schema: v.schema.Fields[indx].Schema, tree: v.tree, gindex: gindex}, nil
+}
+
+// Len returns the number of elements of a vector or a list, the bytes of
+// a byte vector or a byte list and the bits of a bitvector or a bitlist
+func (v *View) Len() (uint64, error) {
+	switch v.schema.Kind {
+	case KindVector, KindByteVector, KindBitVector:
+		return v.schema.Size, nil
+	case KindList, KindByteList, KindBitList:
+		node, err := v.Node()
+		if err != nil {
+			return 0, err
+		}
+		return mixinLength(node)
+	default:
+		return 0, fmt.Errorf("length of a %s", v.schema.Kind)
+	}
+}
+
+// Index returns the view of an element of a vector or a list
+func (v *View) Index(i uint64) (*View, error) {
+	if v.schema.Kind != KindVector && v.schema.Kind != KindList {
+		return nil, fmt.Errorf("index of a %s", v.schema.Kind)
+	}
+	size, err := v.Len()
+	if err != nil {
+		return nil, err
+	}
+	if i >= size {
+		return nil, ErrIndexOutOfRange
+	}
+	return v.elem(i)
+}
+
+// elem returns the view of the element i without checking the length
+func (v *View) elem(i uint64) (*View, error) {
+	contents := v.gindex
+	if v.schema.Kind == KindList {
+		// the contents are the left child of the length mixin
+		if _, err := childGindex(v.gindex, 1, 0); err != nil {
+			return nil, err
+		}
+		contents = v.gindex * 2
+	}
+	elem := &View{schema: v.schema.Elem, tree: v.tree}
+
+	chunk := i
+	if v.schema.isPacked() {
+		pos := i * v.schema.Elem.Size
+		chunk, elem.offset, elem.packed = pos/32, pos%32, true
+	}
+	gindex, err := v.schema.chunkGindex(contents, chunk)
+	if err != nil {
+		return nil, err
+	}
+	elem.gindex = gindex
+	return elem, nil
+}
+
+// basic returns the bytes of an uint or a bool
+func (v *View) basic(kind Kind) ([]byte, error) {
+	if v.schema.Kind != kind {
+		return nil, fmt.Errorf("%s value of a %s", kind, v.schema.Kind)
+	}
+	node, err := v.Node()
+	if err != nil {
+		return nil, err
+	}
+	if !node.IsLeaf() {
+		return nil, ErrInvalidGindex
+	}
+	return node.value[v.offset : v.offset+v.schema.Size], nil
+}
+
+func (v *View) setBasic(buf []byte) error {
+	node, err := v.Node()
+	if err != nil {
+		return err
+	}
+	leaf := LeafNode(node.value)
+	copy(leaf.value[v.offset:], buf)
+	return v.setNode(leaf)
+}
+
+// Uint returns the value of an uint
+func (v *View) Uint() (uint64, error) {
+	buf, err := v.basic(KindUint)
+	if err != nil {
+		return 0, err
+	}
+	return uintValue(buf)
+}
+
+// uintValue decodes a little endian uint of any size, which fails if it does not fit in an uint64
+func uintValue(buf []byte) (uint64, error) {
+	for i := 8; i < len(buf); i++ {
+		if buf[i] != 0 {
+			return 0, ErrUintOverflow
+		}
+	}
+	tmp := make([]byte, 8)
+	copy(tmp, buf)
+	return binary.LittleEndian.Uint64(tmp), nil
+}
+
+// SetUint sets the value of an uint
+func (v *View) SetUint(i uint64) error {
+	if _, err := v.basic(KindUint); err != nil {
+		return err
+	}
+	if v.schema.Size < 8 && i>>(8*v.schema.Size) != 0 {
+		return ErrUintOverflow
+	}
+	tmp := make([]byte, 32)
+	binary.LittleEndian.PutUint64(tmp, i)
+	return v.setBasic(tmp[:v.schema.Size])
+}
+
+// Bool returns the value of a bool
+func (v *View) Bool() (bool, error) {
+	buf, err := v.basic(KindBool)
+	if err != nil {
+		return false, err
+	}
+	return buf[0] == 1, nil
+}
+
+// SetBool sets the value of a bool
+func (v *View) SetBool(b bool) error {
+	if _, err := v.basic(KindBool); err != nil {
+		return err
+	}
+	if b {
+		return v.setBasic([]byte{1})
+	}
+	return v.setBasic([]byte{0})
+}
+
+// Bytes returns the SSZ encoding of a byte vector, a byte list, a bitvector or a bitlist
+func (v *View) Bytes() ([]byte, error) {
+	switch v.schema.Kind {
+	case KindByteVector, KindByteList, KindBitVector, KindBitList:
+		return v.MarshalSSZ()
+	default:
+		return nil, fmt.Errorf("bytes of a %s", v.schema.Kind)
+	}
+}
+
+// SetBytes sets the value of a byte vector, a byte list, a bitvector or a bitlist from its SSZ encoding
+func (v *View) SetBytes(buf []byte) error {
+	switch v.schema.Kind {
+	case KindByteVector, KindByteList, KindBitVector, KindBitList:
+		node, err := v.schema.nodeFromSSZ(buf)
+		if err != nil {
+			return err
+		}
+		return v.setNode(node)
+	default:
+		return fmt.Errorf("bytes of a %s", v.schema.Kind)
+	}
+}
+
+// Set replaces the value with the value of another view with the same schema
+func (v *View) Set(o *View) error {
+	if !v.schema.Equal(o.schema) {
+		return fmt.Errorf("cannot set a %s with a %s", v.schema.Kind, o.schema.Kind)
+	}
+	if v.packed || o.packed {
+		buf, err := o.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if v.packed {
+			return v.setBasic(buf)
+		}
+		return v.setNode(LeafNode(buf))
+	}
+	node, err := o.Node()
+	if err != nil {
+		return err
+	}
+	return v.setNode(node)
+}
+
+// Variant returns the selector of an union and the view of the selected option, which is nil for None
+func (v *View) Variant() (uint8, *View, error) {
+	if v.schema.Kind != KindUnion {
+		return 0, nil, fmt.Errorf("variant of a %s", v.schema.Kind)
+	}
+	node, err := v.Node()
+	if err != nil {
+		return 0, nil, err
+	}
+	selector, err := mixinLength(node)
+	if err != nil {
+		return 0, nil, err
+	}
+	opt, err := v.schema.option(selector)
+	if err != nil {
+		return 0, nil, err
+	}
+	if opt == nil {
+		return uint8(selector), nil, nil
+	}
+	gindex, err := childGindex(v.gindex, 1, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	return uint8(selector), &View{schema: opt, tree: v.tree, gindex: gindex}, nil
+}
+
+// SetVariant selects the option of an union with its default value and returns its view
+func (v *View) SetVariant(selector uint8) (*View, error) {
+	if v.schema.Kind != KindUnion {
+		return nil, fmt.Errorf("variant of a %s", v.schema.Kind)
+	}
+	node, err := v.schema.unionNode(uint64(selector))
+	if err != nil {
+		return nil, err
+	}
+	if err := v.setNode(node); err != nil {
+		return nil, err
+	}
+	_, elem, err := v.Variant()
+	return elem, err
+}
+
+// Append adds an element with the default value at the end of a list and returns its view
+func (v *View) Append() (*View, error) {
+	if v.schema.Kind != KindList {
+		return nil, fmt.Errorf("append to a %s", v.schema.Kind)
+	}
+	size, err := v.Len()
+	if err != nil {
+		return nil, err
+	}
+	if size >= v.schema.limit() {
+		return nil, ErrListTooBig
+	}
+	node, err := v.Node()
+	if err != nil {
+		return nil, err
+	}
+	if node, err = node.Set(3, lengthNode(size+1)); err != nil {
+		return nil, err
+	}
+	if err := v.setNode(node); err != nil {
+		return nil, err
+	}
+
+	elem, err := v.elem(size)
+	if err != nil {
+		return nil, err
+	}
+	if !elem.packed {
+		// the packed elements after the length are already zero
+		if err := elem.setNode(elem.schema.defaultNode()); err != nil {
+			return nil, err
+		}
+	}
+	return elem, nil
+}
+
+// defaultNode returns the tree of the default value of the type
+func (s *Schema) defaultNode() *Node {
+	switch s.Kind {
+	case KindUint, KindBool, KindByteVector, KindBitVector:
+		return zeroNodes[s.depth()]
+
+	case KindByteList, KindBitList, KindList:
+		return mixinNode(s.contentsTree(nil), 0)
+
+	case KindVector:
+		if s.Elem.IsBasic() {
+			return zeroNodes[s.depth()]
+		}
+		elem := s.Elem.defaultNode()
+		nodes := make([]*Node, s.Size)
+		for i := range nodes {
+			nodes[i] = elem
+		}
+		return treeFromNodes(nodes, s.depth())
+
+	case KindContainer:
+		nodes := make([]*Node, len(s.Fields))
+		for i, f := range s.Fields {
+			nodes[i] = f.Schema.defaultNode()
+		}
+		return treeFromNodes(nodes, s.depth())
+
+	case KindUnion:
+		node, err := s.unionNode(0)
+		if err != nil {
+			panic(fmt.Errorf("union without options"))
+		}
+		return node
+
+	default:
+		panic(fmt.Errorf("default value not implemented for %s", s.Kind))
+	}
+}
+
+// unionNode returns the tree of an union with the default value of the option,
+// which is the root of the value mixed in with the selector like the length of a list
+func (s *Schema) unionNode(selector uint64) (*Node, error) {
+	opt, err := s.option(selector)
+	if err != nil {
+		return nil, err
+	}
+	if opt == nil {
+		return mixinNode(zeroNodes[0], selector), nil
+	}
+	return mixinNode(opt.defaultNode(), selector), nil
+}
+
+// nodeFromSSZ decodes the SSZ encoding into the tree of the value
+func (s *Schema) nodeFromSSZ(buf []byte) (*Node, error) {
+	size := uint64(len(buf))
+
+	switch s.Kind {
+	case KindUint:
+		if size != s.Size {
+			return nil, ErrSize
+		}
+		return LeafNode(buf), nil
+
+	case KindBool:
+		if size != 1 {
+			return nil, ErrSize
+		}
+		if buf[0] > 1 {
+			return nil, ErrInvalidBool
+		}
+		return LeafNode(buf), nil
+
+	case KindByteVector:
+		if size != s.Size {
+			return nil, ErrSize
+		}
+		return treeFromNodes(chunkNodes(buf), s.depth()), nil
+
+	case KindByteList:
+		if size > s.Max {
+			return nil, ErrListTooBig
+		}
+		return mixinNode(treeFromNodes(chunkNodes(buf), s.depth()), size), nil
+
+	case KindBitVector:
+		if err := ValidateBitvector(buf, s.Size); err != nil {
+			return nil, err
+		}
+		return treeFromNodes(chunkNodes(buf), s.depth()), nil
+
+	case KindBitList:
+		if err := ValidateBitlist(buf, s.Max); err != nil {
+			return nil, err
+		}
+		bits, num := parseBitlist(nil, buf)
+		return mixinNode(s.contentsTree(chunkNodes(bits)), num), nil
+
+	case KindVector, KindList:
+		nodes, num, err := s.elemNodesFromSSZ(buf)
+		if err != nil {
+			return nil, err
+		}
+		contents := s.contentsTree(nodes)
+		if s.Kind == KindList {
+			return mixinNode(contents, num), nil
+		}
+		return contents, nil
+
+	case KindContainer:
+		parts, err := s.splitFields(buf)
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]*Node, len(s.Fields))
+		for i, f := range s.Fields {
+			if nodes[i], err = f.Schema.nodeFromSSZ(parts[i]); err != nil {
+				return nil, err
+			}
+		}
+		return treeFromNodes(nodes, s.depth()), nil
+
+	case KindUnion:
+		if size == 0 {
+			return nil, ErrSize
+		}
+		opt, err := s.option(uint64(buf[0]))
+		if err != nil {
+			return nil, err
+		}
+		if opt == nil {
+			if size != 1 {
+				return nil, ErrSize
+			}
+			return mixinNode(zeroNodes[0], 0), nil
+		}
+		node, err := opt.nodeFromSSZ(buf[1:])
+		if err != nil {
+			return nil, err
+		}
+		return mixinNode(node, uint64(buf[0])), nil
+
+	default:
+		panic(fmt.Errorf("decode not implemented for %s", s.Kind))
+	}
+}
+
+// elemNodesFromSSZ returns the leaves of the tree of the contents of a vector or a
+// list, either the packed chunks or the trees of the elements, and the number of elements.
+func (s *Schema) elemNodesFromSSZ(buf []byte) ([]*Node, uint64, error) {
+	parts, err := s.splitElems(buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	num := uint64(len(parts))
+	if s.Elem.IsBasic() {
+		if s.Elem.Kind == KindBool {
+			for _, b := range buf {
+				if b > 1 {
+					return nil, 0, ErrInvalidBool
+				}
+			}
+		}
+		return chunkNodes(buf), num, nil
+	}
+	nodes := make([]*Node, num)
+	for i, part := range parts {
+		if nodes[i], err = s.Elem.nodeFromSSZ(part); err != nil {
+			return nil, 0, err
+		}
+	}
+	return nodes, num, nil
+}
+
+// splitElems splits the SSZ encoding of a vector or a list in the encodings of its elements
+func (s *Schema) splitElems(buf []byte) ([][]byte, error) {
+	size := uint64(len(buf))
+	max := s.limit()
+	if s.Kind == KindVector {
+		max = s.Size
+	}
+
+	var parts [][]byte
+	if s.Elem.IsFixed() {
+		elemSize := s.Elem.FixedSize()
+		if elemSize == 0 || size%elemSize != 0 {
+			return nil, ErrSize
+		}
+		num := size / elemSize
+		if num > max {
+			if s.Kind == KindVector {
+				return nil, ErrSize
+			}
+			return nil, ErrListTooBig
+		}
+		parts = make([][]byte, num)
+		for i := range parts {
+			parts[i] = buf[uint64(i)*elemSize : uint64(i+1)*elemSize]
+		}
+	} else {
+		num, err := DecodeDynamicLength(buf, int(max))
+		if err != nil {
+			return nil, err
+		}
+		parts = make([][]byte, num)
+		err = UnmarshalDynamic(buf, num, func(indx int, b []byte) error {
+			parts[indx] = b
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if s.Kind == KindVector && uint64(len(parts)) != s.Size {
+		return nil, ErrSize
+	}
+	return parts, nil
+}
+
+// splitFields splits the SSZ encoding of a container in the encodings of its fields
+func (s *Schema) splitFields(buf []byte) ([][]byte, error) {
+	size := uint64(len(buf))
+	fixed := s.FixedSize()
+	if s.IsFixed() {
+		if size != fixed {
+			return nil, ErrSize
+		}
+	} else {
+		fixed = 0
+		for _, f := range s.Fields {
+			fixed += f.Schema.FixedSize()
+		}
+		if size < fixed {
+			return nil, ErrSize
+		}
+	}
+
+	parts := make([][]byte, len(s.Fields))
+	offsets := []int{}
+	pos := uint64(0)
+	prev := fixed
+	for i, f := range s.Fields {
+		if f.Schema.IsFixed() {
+			parts[i] = buf[pos : pos+f.Schema.FixedSize()]
+			pos += f.Schema.FixedSize()
+			continue
+		}
+		offset := ReadOffset(buf[pos : pos+4])
+		pos += 4
+		// the first offset is the end of the fixed part and the others are in order
+		if offset > size || offset < prev || (len(offsets) == 0 && offset != fixed) {
+			return nil, ErrOffset
+		}
+		if len(offsets) != 0 {
+			last := offsets[len(offsets)-1]
+			parts[last] = buf[prev:offset]
+		}
+		offsets = append(offsets, i)
+		prev = offset
+	}
+	if len(offsets) != 0 {
+		parts[offsets[len(offsets)-1]] = buf[prev:]
+	}
+	return parts, nil
+}
+
+// marshalNode appends the SSZ encoding of the value stored in the tree
+func (s *Schema) marshalNode(dst []byte, node *Node) ([]byte, error) {
+	switch s.Kind {
+	case KindUint, KindBool:
+		if !node.IsLeaf() {
+			return nil, ErrInvalidGindex
+		}
+		return append(dst, node.value[:s.Size]...), nil
+
+	case KindByteVector, KindBitVector:
+		chunks, err := node.nodesAt(s.depth(), s.ChunkCount())
+		if err != nil {
+			return nil, err
+		}
+		return appendChunks(dst, chunks, s.FixedSize())
+
+	case KindByteList:
+		contents, size, err := listContents(node)
+		if err != nil {
+			return nil, err
+		}
+		chunks, err := contents.nodesAt(s.depth(), (size+31)/32)
+		if err != nil {
+			return nil, err
+		}
+		return appendChunks(dst, chunks, size)
+
+	case KindBitList:
+		contents, size, err := listContents(node)
+		if err != nil {
+			return nil, err
+		}
+		chunks, err := s.contentsNodes(contents, (size+255)/256)
+		if err != nil {
+			return nil, err
+		}
+		buf, err := appendChunks(nil, chunks, (size+7)/8)
+		if err != nil {
+			return nil, err
+		}
+		// add the length bit
+		buf = append(buf, make([]byte, size/8+1-uint64(len(buf)))...)
+		buf[size/8] |= 1 << (size % 8)
+		return append(dst, buf...), nil
+
+	case KindVector, KindList:
+		contents, num := node, s.Size
+		if s.Kind == KindList {
+			var err error
+			if contents, num, err = listContents(node); err != nil {
+				return nil, err
+			}
+		}
+		if s.Elem.IsBasic() {
+			size := num * s.Elem.Size
+			chunks, err := s.contentsNodes(contents, (size+31)/32)
+			if err != nil {
+				return nil, err
+			}
+			return appendChunks(dst, chunks, size)
+		}
+		elems, err := s.contentsNodes(contents, num)
+		if err != nil {
+			return nil, err
+		}
+		schemas := make([]*Schema, len(elems))
+		for i := range schemas {
+			schemas[i] = s.Elem
+		}
+		return marshalParts(dst, schemas, elems)
+
+	case KindContainer:
+		fields, err := node.nodesAt(s.depth(), uint64(len(s.Fields)))
+		if err != nil {
+			return nil, err
+		}
+		schemas := make([]*Schema, len(s.Fields))
+		for i, f := range s.Fields {
+			schemas[i] = f.Schema
+		}
+		return marshalParts(dst, schemas, fields)
+
+	case KindUnion:
+		contents, selector, err := listContents(node)
+		if err != nil {
+			return nil, err
+		}
+		opt, err := s.option(selector)
+		if err != nil {
+			return nil, err
+		}
+		dst = append(dst, uint8(selector))
+		if opt == nil {
+			return dst, nil
+		}
+		return opt.marshalNode(dst, contents)
+
+	default:
+		panic(fmt.Errorf("encode not implemented for %s", s.Kind))
+	}
+}
+
+// listContents returns the tree of the contents and the length of a list
+func listContents(node *Node) (*Node, uint64, error) {
+	size, err := mixinLength(node)
+	if err != nil {
+		return nil, 0, err
+	}
+	left, _, err := node.children()
+	if err != nil {
+		return nil, 0, err
+	}
+	return left, size, nil
+}
+
+// marshalParts appends the encodings of the elements of a vector, a list or a container.
+// The fixed elements are encoded in place and the dynamic ones after the fixed part.
+func marshalParts(dst []byte, schemas []*Schema, nodes []*Node) ([]byte, error) {
+	offset := 0
+	for _, s := range schemas {
+		offset += int(s.FixedSize())
+	}
+
+	var err error
+	var tail []byte
+	for i, s := range schemas {
+		if s.IsFixed() {
+			if dst, err = s.marshalNode(dst, nodes[i]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if dst, err = SafeWriteOffset(dst, offset+len(tail)); err != nil {
+			return nil, err
+		}
+		if tail, err = s.marshalNode(tail, nodes[i]); err != nil {
+			return nil, err
+		}
+	}
+	return append(dst, tail...), nil
+}